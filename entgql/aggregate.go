@@ -0,0 +1,66 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import (
+	"fmt"
+	"time"
+)
+
+// NullTime is a nullable time.Time scanned from the results of SQL aggregate
+// functions. Unlike sql.NullTime, it also accepts the textual representation
+// of times that some drivers, such as SQLite, return for aggregated columns.
+type NullTime struct {
+	Time  time.Time
+	Valid bool
+}
+
+// timeLayouts are the textual representations of times returned by SQL drivers.
+var timeLayouts = []string{
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02T15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02",
+}
+
+// Scan implements the sql.Scanner interface.
+func (t *NullTime) Scan(v any) error {
+	*t = NullTime{}
+	switch v := v.(type) {
+	case nil:
+		return nil
+	case time.Time:
+		t.Time, t.Valid = v, true
+		return nil
+	case []byte:
+		return t.parse(string(v))
+	case string:
+		return t.parse(v)
+	default:
+		return fmt.Errorf("entgql: unexpected time type %T", v)
+	}
+}
+
+// parse parses the textual representation of the time.
+func (t *NullTime) parse(s string) error {
+	for _, layout := range timeLayouts {
+		if v, err := time.ParseInLocation(layout, s, time.UTC); err == nil {
+			t.Time, t.Valid = v, true
+			return nil
+		}
+	}
+	return fmt.Errorf("entgql: unexpected time format %q", s)
+}
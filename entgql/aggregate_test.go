@@ -0,0 +1,43 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql_test
+
+import (
+	"testing"
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNullTime_Scan(t *testing.T) {
+	want := time.Date(2024, 5, 1, 10, 30, 0, 500, time.UTC)
+	for _, v := range []any{
+		want,
+		"2024-05-01 10:30:00.0000005+00:00",
+		[]byte("2024-05-01T10:30:00.0000005Z"),
+		"2024-05-01 10:30:00.0000005",
+	} {
+		var nt entgql.NullTime
+		require.NoError(t, nt.Scan(v))
+		require.True(t, nt.Valid)
+		require.True(t, want.Equal(nt.Time), "got %v for %v", nt.Time, v)
+	}
+	var nt entgql.NullTime
+	require.NoError(t, nt.Scan(nil))
+	require.False(t, nt.Valid)
+	require.EqualError(t, nt.Scan("yesterday"), `entgql: unexpected time format "yesterday"`)
+	require.EqualError(t, nt.Scan(1), "entgql: unexpected time type int")
+}
@@ -16,6 +16,7 @@ package entgql
 
 import (
 	"encoding/json"
+	"slices"
 
 	"entgo.io/ent/entc/gen"
 	"entgo.io/ent/schema"
@@ -29,6 +30,14 @@ type (
 		OrderField string `json:"OrderField,omitempty"`
 		// MultiOrder indicates that orderBy should accept a list of OrderField terms.
 		MultiOrder bool `json:"MultiOrder,omitempty"`
+		// MaxPageSize is the maximum number of nodes a connection of the type can return.
+		MaxPageSize int `json:"MaxPageSize,omitempty"`
+		// Aggregate includes the field in the aggregate field of the type connection.
+		Aggregate bool `json:"Aggregate,omitempty"`
+		// GroupBy allows grouping the aggregations of the type connection by the field.
+		GroupBy bool `json:"GroupBy,omitempty"`
+		// WhereOps enables additional WhereInput predicates for JSON, slice and text fields.
+		WhereOps WhereOp `json:"WhereOps,omitempty"`
 		// Unbind implies the edge field name in GraphQL schema is not equivalent
 		// to the name used in ent schema. That means, by default, edges with this
 		// annotation will not be eager-loaded on Paginate calls. See the `MapsTo`
@@ -43,14 +52,25 @@ type (
 		// RelayConnection enables the Relay Connection specification for the entity.
 		// It's also can apply on an edge to create the Relay-style filter.
 		RelayConnection bool `json:"RelayConnection,omitempty"`
+		// OffsetPagination adds an offset-based page field for the entity.
+		// It's also can apply on an edge to create a page field for the edge.
+		OffsetPagination bool `json:"OffsetPagination,omitempty"`
 		// Implements defines a list of interfaces implemented by the type.
 		Implements []string `json:"Implements,omitempty"`
 		// Directives to add on the field/type.
 		Directives []Directive `json:"Directives,omitempty"`
+		// Authorize adds the @authorize directive with the given policies on the field/type.
+		Authorize *AuthorizeConfig `json:"Authorize,omitempty"`
+		// FederationKeys holds the fields of the Apollo Federation keys of the type.
+		FederationKeys [][]string `json:"FederationKeys,omitempty"`
 		// QueryField exposes the generated type with the given string under the Query object.
 		QueryField *FieldConfig `json:"QueryField,omitempty"`
 		// MutationInputs defines the input types for the mutation.
 		MutationInputs []MutationConfig `json:"MutationInputs,omitempty"`
+		// NestedCreate adds a create<Edge> field to the Create<Type>Input for the edge.
+		NestedCreate bool `json:"NestedCreate,omitempty"`
+		// Subscriptions exposes the created, updated and deleted events of the type under the Subscription object.
+		Subscriptions bool `json:"Subscriptions,omitempty"`
 		// CollectedFor indicates that this field should be collected when any of the specified GraphQL field names are queried.
 		// This is useful for resolver fields that depend on this field's value.
 		CollectedFor []string `json:"CollectedFor,omitempty"`
@@ -65,6 +85,9 @@ type (
 	// SkipMode is a bit flag for the Skip annotation.
 	SkipMode int
 
+	// WhereOp is a bit flag for the WhereOps annotation.
+	WhereOp int
+
 	FieldConfig struct {
 		// Name is the name of the field in the Query object.
 		Name string `json:"Name,omitempty"`
@@ -76,9 +99,17 @@ type (
 		Directives []Directive `json:"Directives,omitempty"`
 	}
 
+	// AuthorizeConfig holds the policies of the Authorize annotation.
+	AuthorizeConfig struct {
+		Policies []string `json:"Policies,omitempty"`
+	}
+
 	// MutationConfig hold config for mutation
 	MutationConfig struct {
 		IsCreate    bool   `json:"IsCreate,omitempty"`
+		IsDelete    bool   `json:"IsDelete,omitempty"`
+		IsUpsert    bool   `json:"IsUpsert,omitempty"`
+		IsBulk      bool   `json:"IsBulk,omitempty"`
 		Description string `json:"Description,omitempty"`
 	}
 )
@@ -109,6 +140,24 @@ const (
 		SkipMutationUpdateInput
 )
 
+const (
+	// WhereHasKey generates the <field>HasKey predicate for JSON fields,
+	// which checks that a non-null value exists at a dot-separated path.
+	WhereHasKey WhereOp = 1 << iota
+	// WhereValueEQ generates the <field>ValueEQ predicate for JSON fields,
+	// which compares the value at a path with the given one.
+	WhereValueEQ
+	// WhereContains generates the <field>Contains predicate for JSON fields.
+	// For slice fields, it checks that the slice contains the given element,
+	// and for other JSON fields, that the value at a path contains the given one.
+	WhereContains
+	// WhereContainsAny generates the <field>ContainsAny predicate for slice
+	// fields, which checks that the slice contains any of the given elements.
+	WhereContainsAny
+	// WhereSearch generates the <field>Search full-text predicate for string fields.
+	WhereSearch
+)
+
 // Name implements ent.Annotation interface.
 func (Annotation) Name() string {
 	return "EntGQL"
@@ -145,6 +194,85 @@ func MultiOrder() Annotation {
 	return Annotation{MultiOrder: true}
 }
 
+// MaxPageSize limits the page size of the connections of the annotated type.
+// The generated Paginate rejects first or last arguments that exceed it, and
+// returns at most n nodes for connections that are queried without them.
+//
+//	func (Todo) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entgql.RelayConnection(),
+//			entgql.MaxPageSize(100),
+//		}
+//	}
+func MaxPageSize(n int) Annotation {
+	return Annotation{MaxPageSize: n}
+}
+
+// Aggregate includes the annotated numeric or time field in the aggregate
+// field of the type connection. The sum and average of numeric fields, and
+// the minimum and maximum of both are computed with the connection filter.
+//
+//	field.Int("priority").
+//		Annotations(
+//			entgql.Aggregate(),
+//		)
+//
+// The generated GraphQL schema will be:
+//
+//	type TodoConnection {
+//		aggregate(groupBy: [TodoGroupByField!]): [TodoAggregate!]!
+//	}
+//
+//	type TodoAggregate {
+//		count: Int!
+//		sum: TodoAggregateSum!
+//		avg: TodoAggregateAvg!
+//		min: TodoAggregateMinMax!
+//		max: TodoAggregateMinMax!
+//	}
+func Aggregate() Annotation {
+	return Annotation{Aggregate: true}
+}
+
+// GroupBy allows grouping the aggregations of the type connection by the
+// annotated field. The values of the group are set in the TodoAggregate
+// fields named after the grouped fields.
+//
+//	field.Enum("status").
+//		Values("IN_PROGRESS", "COMPLETED").
+//		Annotations(
+//			entgql.GroupBy(),
+//		)
+func GroupBy() Annotation {
+	return Annotation{GroupBy: true}
+}
+
+// WhereOps enables the given predicates in the WhereInput of the annotated
+// field. These predicates are implemented using the sqljson package, and
+// therefore, are supported by SQLite, MySQL and PostgreSQL.
+//
+//	field.JSON("metadata", map[string]any{}).
+//		Annotations(
+//			entgql.WhereOps(entgql.WhereHasKey, entgql.WhereValueEQ),
+//		)
+//
+//	field.Strings("labels").
+//		Annotations(
+//			entgql.WhereOps(entgql.WhereContains | entgql.WhereContainsAny),
+//		)
+//
+// The value predicates of JSON fields accept a JSONPathValue input, which holds
+// a dot-separated path (e.g. "a.b[1].c") and a value of the builtin Any scalar.
+// The WhereSearch predicate requires a FULLTEXT index on the column in MySQL,
+// and falls back to a case-insensitive match of all words in SQLite.
+func WhereOps(ops ...WhereOp) Annotation {
+	var op WhereOp
+	for _, o := range ops {
+		op |= o
+	}
+	return Annotation{WhereOps: op}
+}
+
 // Bind returns a binding annotation.
 //
 // No-op function to avoid breaking the existing schema.
@@ -302,6 +430,84 @@ func RelayConnection() Annotation {
 	return Annotation{RelayConnection: true}
 }
 
+// OffsetPagination returns an annotation indicating that the node should support
+// offset-based pagination, for example, for page-number navigation, where the page
+// number is derived from the offset and the page size of the page info. It generates the
+// <T>Page type, and the <field>Page field on the Query type in case the QueryField
+// annotation is also set:
+//
+//	func (Todo) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entgql.QueryField(),
+//			entgql.OffsetPagination(),
+//		}
+//	}
+//
+// The generated GraphQL schema will be:
+//
+//	todosPage(offset: Int, limit: Int, orderBy: TodoOrder, where: TodoWhereInput): TodoPage!
+//
+// Setting this annotation on a non-unique edge adds the <edge>Page field to the type,
+// alongside the list or the connection field of the edge. The type of the edge must
+// be annotated with OffsetPagination as well.
+//
+//	children(first: Int, last: Int, after: Cursor, before: Cursor): TodoConnection!
+//	childrenPage(offset: Int, limit: Int, orderBy: TodoOrder, where: TodoWhereInput): TodoPage!
+func OffsetPagination() Annotation {
+	return Annotation{OffsetPagination: true}
+}
+
+// Subscriptions returns an annotation for exposing the events of the type under
+// the Subscription object. The generated SubscriptionHook publishes the mutations
+// of the type to an entgql.PubSub, and the SubscriptionResolver delivers them to
+// the subscribers. It requires the entgql.WithWhereInputs option.
+//
+//	func (Todo) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entgql.Subscriptions(),
+//		}
+//	}
+//
+// The generated GraphQL schema will be:
+//
+//	type Subscription {
+//		todoCreated(where: TodoWhereInput): Todo!
+//		todoUpdated(where: TodoWhereInput): Todo!
+//		todoDeleted: ID!
+//	}
+//
+// Deleted nodes can no longer be matched against a filter, and therefore,
+// the deleted events are delivered with their IDs and without a filter.
+func Subscriptions() Annotation {
+	return Annotation{Subscriptions: true}
+}
+
+// NestedCreate returns an annotation for creating the nodes of an edge together
+// with the node holding it. The Create<Type>Input of the node gets a create<Edge>
+// field holding the Create<Edge>Input of the edge nodes, and its SetInput method
+// creates them in the same transaction as the node.
+//
+//	func (Todo) Edges() []ent.Edge {
+//		return []ent.Edge{
+//			edge.To("children", Todo.Type).
+//				Annotations(entgql.NestedCreate()).
+//				From("parent").
+//				Unique(),
+//		}
+//	}
+//
+// The generated GraphQL schema will be:
+//
+//	input CreateTodoInput {
+//		childIDs: [ID!]
+//		createChildren: [CreateTodoInput!]
+//	}
+//
+// Nested inputs can be nested themselves, up to the depth set by entgql.WithMaxNestedDepth.
+func NestedCreate() Annotation {
+	return Annotation{NestedCreate: true}
+}
+
 // Implements returns an Implements annotation.
 // The Implements() annotation is used to
 // add implements interfaces to a GraphQL type.
@@ -362,6 +568,61 @@ func Directives(directives ...Directive) Annotation {
 	return Annotation{Directives: directives}
 }
 
+// Authorize returns an annotation that adds the `@authorize` directive with the
+// given policies to a GraphQL type, field or edge. The directive is implemented by
+// the entgql.Authorizer extension, which resolves the field only if its policy
+// function grants access, and CollectFields skips loading denied fields and edges.
+//
+// Annotating a type adds the directive to the type, which gqlgen runs on the fields
+// returning it, and to its Query field and the edges pointing to it. The node and
+// nodes queries are not covered, and can be guarded by the entgql.AuthorizeRule
+// privacy rule.
+//
+//	field.JSON("metadata", map[string]any{}).
+//		Annotations(
+//			entgql.Authorize("ADMIN"),
+//		),
+//
+// and the GraphQL type will be generated with the directive.
+//
+//	type User {
+//		metadata: Map @authorize(policies: ["ADMIN"])
+//	}
+func Authorize(policies ...string) Annotation {
+	return Annotation{Authorize: &AuthorizeConfig{Policies: policies}}
+}
+
+// FederationKey returns an annotation that adds the Apollo Federation `@key` directive
+// with the given fields to a GraphQL type, and the `@link` import of the Federation v2
+// directives to the schema. The annotation can be used multiple times to define several
+// keys, and the id field is used if no fields are given.
+//
+//	func (Todo) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entgql.FederationKey("id"),
+//			entgql.FederationKey("text", "status"),
+//		}
+//	}
+//
+// The generated GraphQL type will be:
+//
+//	type Todo implements Node @key(fields: "id") @key(fields: "text status") @entityResolver(multi: true) {
+//		...
+//	}
+//
+// The fields are the names of the ent fields, and gqlgen resolves the representations of
+// an entity with the first key whose fields they hold. Hence, keys should be ordered from the
+// most to the least specific. The generated EntityResolver has a batched FindMany<T>By<Key>
+// method for each key, that the gqlgen entity resolvers can delegate to. Keys are resolved
+// using Noders in case of the id field, and using a Where predicate matching all the
+// representations otherwise.
+func FederationKey(fields ...string) Annotation {
+	if len(fields) == 0 {
+		fields = []string{"id"}
+	}
+	return Annotation{FederationKeys: [][]string{fields}}
+}
+
 type queryFieldAnnotation struct {
 	Annotation
 }
@@ -389,6 +650,9 @@ func (a queryFieldAnnotation) Description(text string) queryFieldAnnotation {
 
 type MutationOption interface {
 	IsCreate() bool
+	IsDelete() bool
+	IsUpsert() bool
+	IsBulk() bool
 	GetDescription() string
 
 	// Description allows you to customize the comment of the auto-generated Mutation Input
@@ -407,15 +671,45 @@ type MutationOption interface {
 	//  	"""fields omitted"""
 	//  }
 	Description(string) MutationOption
+
+	// Bulk adds the bulk variant of the mutation to the Mutation fields
+	// generated by the WithMutationFields option. Bulk updates and deletes
+	// select the affected rows using the <T>WhereInput type.
+	//
+	// For example,
+	//
+	//   entgql.Mutations(
+	//       entgql.MutationCreate().Bulk(),
+	//       entgql.MutationUpdate().Bulk(),
+	//       entgql.MutationDelete().Bulk(),
+	//   ),
+	//
+	// Generates
+	//
+	//  createManyTodo(input: [CreateTodoInput!]!): CreateManyTodoPayload!
+	//  updateTodos(where: TodoWhereInput!, input: UpdateTodoInput!): UpdateTodosPayload!
+	//  deleteTodos(where: TodoWhereInput!): DeleteTodosPayload!
+	//
+	// in addition to the createTodo, updateTodo and deleteTodo fields.
+	Bulk() MutationOption
 }
 
 type builtinMutation struct {
 	description string
 	isCreate    bool
+	isDelete    bool
+	isUpsert    bool
+	isBulk      bool
 }
 
 func (v builtinMutation) IsCreate() bool { return v.isCreate }
 
+func (v builtinMutation) IsDelete() bool { return v.isDelete }
+
+func (v builtinMutation) IsUpsert() bool { return v.isUpsert }
+
+func (v builtinMutation) IsBulk() bool { return v.isBulk }
+
 func (v builtinMutation) GetDescription() string { return v.description }
 
 func (v builtinMutation) Description(desc string) MutationOption {
@@ -423,6 +717,11 @@ func (v builtinMutation) Description(desc string) MutationOption {
 	return v
 }
 
+func (v builtinMutation) Bulk() MutationOption {
+	v.isBulk = true
+	return v
+}
+
 func MutationCreate() MutationOption {
 	return builtinMutation{isCreate: true}
 }
@@ -431,6 +730,29 @@ func MutationUpdate() MutationOption {
 	return builtinMutation{isCreate: false}
 }
 
+// MutationDelete returns an option for generating the delete<T> field of the
+// Mutation type. Unlike MutationCreate and MutationUpdate, it does not have an
+// input type, and is used only by the WithMutationFields option.
+func MutationDelete() MutationOption {
+	return builtinMutation{isDelete: true}
+}
+
+// MutationUpsert returns an option for generating the Upsert<T>Input of a type,
+// and its upsert<T> field of the Mutation type if WithMutationFields is enabled.
+// The input holds the Create<T>Input of the node, a conflict target derived from
+// the unique fields and indexes of the type, and an optional list of fields to
+// update on conflict. It requires the MutationCreate option and the sql/upsert
+// feature flag.
+//
+//	input UpsertTodoInput {
+//		create: CreateTodoInput!
+//		conflictTarget: TodoConflictTarget!
+//		update: [TodoUpsertField!]
+//	}
+func MutationUpsert() MutationOption {
+	return builtinMutation{isUpsert: true}
+}
+
 // Mutations returns an annotation for generate input types for mutation.
 func Mutations(inputs ...MutationOption) Annotation {
 	if len(inputs) == 0 {
@@ -441,6 +763,9 @@ func Mutations(inputs ...MutationOption) Annotation {
 	for _, f := range inputs {
 		a = append(a, MutationConfig{
 			IsCreate:    f.IsCreate(),
+			IsDelete:    f.IsDelete(),
+			IsUpsert:    f.IsUpsert(),
+			IsBulk:      f.IsBulk(),
 			Description: f.GetDescription(),
 		})
 	}
@@ -472,6 +797,18 @@ func (a Annotation) Merge(other schema.Annotation) schema.Annotation {
 	if ant.MultiOrder {
 		a.MultiOrder = true
 	}
+	if ant.MaxPageSize != 0 {
+		a.MaxPageSize = ant.MaxPageSize
+	}
+	if ant.Aggregate {
+		a.Aggregate = true
+	}
+	if ant.GroupBy {
+		a.GroupBy = true
+	}
+	if ant.WhereOps != 0 {
+		a.WhereOps |= ant.WhereOps
+	}
 	if ant.Unbind {
 		a.Unbind = true
 	}
@@ -490,12 +827,33 @@ func (a Annotation) Merge(other schema.Annotation) schema.Annotation {
 	if ant.RelayConnection {
 		a.RelayConnection = true
 	}
+	if ant.OffsetPagination {
+		a.OffsetPagination = true
+	}
+	if ant.NestedCreate {
+		a.NestedCreate = true
+	}
+	if ant.Subscriptions {
+		a.Subscriptions = true
+	}
 	if len(ant.Implements) > 0 {
 		a.Implements = append(a.Implements, ant.Implements...)
 	}
 	if len(ant.Directives) > 0 {
 		a.Directives = append(a.Directives, ant.Directives...)
 	}
+	if ant.Authorize != nil {
+		var policies []string
+		if a.Authorize != nil {
+			policies = a.Authorize.Policies
+		}
+		a.Authorize = &AuthorizeConfig{Policies: append(slices.Clone(policies), ant.Authorize.Policies...)}
+	}
+	for _, k := range ant.FederationKeys {
+		if !slices.ContainsFunc(a.FederationKeys, func(f []string) bool { return slices.Equal(f, k) }) {
+			a.FederationKeys = append(a.FederationKeys, k)
+		}
+	}
 	if ant.QueryField != nil {
 		if a.QueryField == nil {
 			a.QueryField = &FieldConfig{}
@@ -527,6 +885,11 @@ func (f SkipMode) Is(mode SkipMode) bool {
 	return f&mode != 0
 }
 
+// Is checks if the where annotation has a specific flag.
+func (f WhereOp) Is(op WhereOp) bool {
+	return f&op != 0
+}
+
 func (c FieldConfig) fieldName(gqlType string) string {
 	if c.Name != "" {
 		return c.Name
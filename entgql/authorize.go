@@ -0,0 +1,143 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import (
+	"context"
+	"errors"
+
+	"entgo.io/ent"
+	"entgo.io/ent/privacy"
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// AuthorizeFunc is the policy function of the Authorize annotations. It is called with
+// the policies of the annotated type, field or edge, and returns nil or privacy.Allow
+// if access is granted. Any other error, including privacy.Deny and privacy.Skip, denies
+// access and is returned to the client.
+type AuthorizeFunc func(ctx context.Context, policies []string) error
+
+// Authorizer is a gqlgen extension that enforces the Authorize annotations. Its Directive
+// method implements the generated @authorize directive, and the extension makes the policy
+// available to the generated CollectFields, which skips loading fields and edges that the
+// policy denies.
+//
+//	authz := &entgql.Authorizer{Policy: policy}
+//	srv := handler.NewDefaultServer(gen.NewExecutableSchema(gen.Config{
+//		Resolvers:  &gen.Resolver{Client: client},
+//		Directives: gen.DirectiveRoot{Authorize: authz.Directive},
+//	}))
+//	srv.Use(authz)
+type Authorizer struct {
+	Policy AuthorizeFunc
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.ResponseInterceptor
+} = (*Authorizer)(nil)
+
+// ExtensionName returns the extension name.
+func (*Authorizer) ExtensionName() string {
+	return "EntGQLAuthorizer"
+}
+
+// Validate is called when adding an extension to the server, it allows validation against the servers schema.
+func (a *Authorizer) Validate(graphql.ExecutableSchema) error {
+	if a.Policy == nil {
+		return errors.New("entgql: authorize policy is nil")
+	}
+	return nil
+}
+
+// InterceptResponse makes the policy available to the field resolvers of the operation.
+func (a *Authorizer) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	return next(NewAuthorizeContext(ctx, a.Policy))
+}
+
+// Directive implements the @authorize directive. It resolves the field
+// only if the policy grants access to the policies of the directive.
+func (a *Authorizer) Directive(ctx context.Context, _ any, next graphql.Resolver, policies []string) (any, error) {
+	if err := authorize(ctx, a.Policy, policies); err != nil {
+		return nil, err
+	}
+	return next(ctx)
+}
+
+type authorizeCtxKey struct{}
+
+// NewAuthorizeContext returns a new context with the given policy function attached.
+func NewAuthorizeContext(parent context.Context, f AuthorizeFunc) context.Context {
+	return context.WithValue(parent, authorizeCtxKey{}, f)
+}
+
+// Authorized reports if the policy function in the context grants access to the given
+// policies. It is used by the generated CollectFields to skip loading denied fields and
+// edges, and reports true if the context has no policy function.
+func Authorized(ctx context.Context, policies ...string) bool {
+	f, _ := ctx.Value(authorizeCtxKey{}).(AuthorizeFunc)
+	return f == nil || authorize(ctx, f, policies) == nil
+}
+
+// AuthorizeRule returns an ent privacy rule that evaluates the policy function in
+// the context with the given policies. The rule denies queries and mutations if the
+// policy denies access, and skips otherwise, or if the context has no policy function.
+//
+//	func (User) Policy() ent.Policy {
+//		return privacy.Policy{
+//			Query: privacy.QueryPolicy{entgql.AuthorizeRule("ADMIN")},
+//		}
+//	}
+func AuthorizeRule(policies ...string) privacy.QueryMutationRule {
+	return authorizeRule(policies)
+}
+
+type authorizeRule []string
+
+// EvalQuery evaluates the policy of a query.
+func (r authorizeRule) EvalQuery(ctx context.Context, _ ent.Query) error {
+	return r.eval(ctx)
+}
+
+// EvalMutation evaluates the policy of a mutation.
+func (r authorizeRule) EvalMutation(ctx context.Context, _ ent.Mutation) error {
+	return r.eval(ctx)
+}
+
+func (r authorizeRule) eval(ctx context.Context) error {
+	f, _ := ctx.Value(authorizeCtxKey{}).(AuthorizeFunc)
+	if f == nil {
+		return privacy.Skip
+	}
+	if err := authorize(ctx, f, r); err != nil {
+		if errors.Is(err, privacy.Deny) {
+			return err
+		}
+		return privacy.Denyf("entgql: %v", err)
+	}
+	return privacy.Skip
+}
+
+// authorize returns nil if the policy function grants access to the given policies.
+func authorize(ctx context.Context, f AuthorizeFunc, policies []string) error {
+	switch err := f(ctx, policies); {
+	case err == nil, errors.Is(err, privacy.Allow):
+		return nil
+	case errors.Is(err, privacy.Skip):
+		return privacy.Denyf("entgql: no policy decision for %q", policies)
+	default:
+		return err
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"entgo.io/ent/privacy"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func TestAuthorized(t *testing.T) {
+	policy := func(err error) AuthorizeFunc {
+		return func(_ context.Context, policies []string) error {
+			if len(policies) == 1 && policies[0] == "ADMIN" {
+				return err
+			}
+			return privacy.Deny
+		}
+	}
+	ctx := context.Background()
+	require.True(t, Authorized(ctx, "ADMIN"), "contexts without policy are authorized")
+
+	require.True(t, Authorized(NewAuthorizeContext(ctx, policy(nil)), "ADMIN"))
+	require.True(t, Authorized(NewAuthorizeContext(ctx, policy(privacy.Allow)), "ADMIN"))
+	require.False(t, Authorized(NewAuthorizeContext(ctx, policy(privacy.Skip)), "ADMIN"))
+	require.False(t, Authorized(NewAuthorizeContext(ctx, policy(nil)), "USER"))
+
+	a := &Authorizer{}
+	require.EqualError(t, a.Validate(nil), "entgql: authorize policy is nil")
+	a.Policy = policy(errors.New("denied"))
+	require.NoError(t, a.Validate(nil))
+	_, err := a.Directive(ctx, nil, func(context.Context) (any, error) { return "ok", nil }, []string{"ADMIN"})
+	require.EqualError(t, err, "denied")
+	a.Policy = policy(privacy.Allowf("admin"))
+	res, err := a.Directive(ctx, nil, func(context.Context) (any, error) { return "ok", nil }, []string{"ADMIN"})
+	require.NoError(t, err)
+	require.Equal(t, "ok", res)
+}
+
+func TestAuthorizeRule(t *testing.T) {
+	ctx := context.Background()
+	rule := AuthorizeRule("ADMIN")
+	require.ErrorIs(t, rule.EvalQuery(ctx, nil), privacy.Skip)
+
+	ctx = NewAuthorizeContext(ctx, func(context.Context, []string) error { return nil })
+	require.ErrorIs(t, rule.EvalQuery(ctx, nil), privacy.Skip)
+
+	ctx = NewAuthorizeContext(ctx, func(context.Context, []string) error { return errors.New("denied") })
+	err := rule.EvalMutation(ctx, nil)
+	require.ErrorIs(t, err, privacy.Deny)
+	require.ErrorContains(t, err, "denied")
+}
+
+func TestWithAuthorize(t *testing.T) {
+	list := withAuthorize(nil, &Annotation{}, &Annotation{})
+	require.Empty(t, list)
+
+	list = withAuthorize(nil, &Annotation{Authorize: &AuthorizeConfig{}})
+	require.Equal(t, ast.DirectiveList{{Name: AuthorizeDirective}}, list)
+
+	ant := Authorize("ADMIN")
+	list = withAuthorize(nil, &ant, &Annotation{Authorize: &AuthorizeConfig{Policies: []string{"ADMIN", "OWNER"}}})
+	require.Len(t, list, 1)
+	arg := list[0].Arguments.ForName("policies")
+	require.NotNil(t, arg)
+	require.Equal(t, `["ADMIN","OWNER"]`, arg.Value.String())
+}
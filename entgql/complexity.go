@@ -0,0 +1,201 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/99designs/gqlgen/complexity"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+type (
+	// ComplexityFunc returns the complexity of a field from
+	// the complexity of its selection set and its arguments.
+	ComplexityFunc func(childComplexity int, args map[string]any) int
+
+	// Complexity holds the complexity functions of GraphQL fields,
+	// keyed by the names of their object types and fields. The
+	// generated Complexity function returns the complexity of the
+	// connections and list edges of the ent schema.
+	Complexity map[string]map[string]ComplexityFunc
+)
+
+// DefaultListSize is the estimated number of nodes returned by list fields,
+// and by connections that were queried without the first or last arguments
+// and have no maximum page size.
+const DefaultListSize = 100
+
+// ConnectionComplexity returns the complexity function of a connection field,
+// where the complexity of its selection set is multiplied by the page size.
+// The page size is taken from the first or last arguments, and defaults to
+// the given maximum page size, or DefaultListSize if it is zero.
+func ConnectionComplexity(maxPageSize int) ComplexityFunc {
+	size := maxPageSize
+	if size <= 0 {
+		size = DefaultListSize
+	}
+	return func(childComplexity int, args map[string]any) int {
+		n := size
+		for _, name := range []string{"first", "last"} {
+			if v, ok := intArg(args[name]); ok && v >= 0 {
+				n = v
+			}
+		}
+		return 1 + childComplexity*n
+	}
+}
+
+// ListComplexity returns the complexity function of a list field
+// of non-unique edges, where the complexity of its selection set
+// is multiplied by DefaultListSize.
+func ListComplexity() ComplexityFunc {
+	return func(childComplexity int, _ map[string]any) int {
+		return 1 + childComplexity*DefaultListSize
+	}
+}
+
+// intArg returns the integer value of a field argument. The arguments
+// are either literals of the operation, or decoded variables.
+func intArg(v any) (int, bool) {
+	switch v := v.(type) {
+	case int:
+		return v, true
+	case int64:
+		return int(v), true
+	case float64:
+		return int(v), true
+	case json.Number:
+		n, err := v.Int64()
+		return int(n), err == nil
+	default:
+		return 0, false
+	}
+}
+
+// ComplexitySchema returns an executable schema that computes the complexity
+// of fields using the given functions, and falls back to the complexity roots
+// of the wrapped schema. It allows using the generated complexity functions
+// with the gqlgen complexity extensions.
+//
+//	srv := handler.NewDefaultServer(entgql.ComplexitySchema(gen.NewSchema(client), ent.Complexity()))
+//	srv.Use(extension.FixedComplexityLimit(1000))
+func ComplexitySchema(es graphql.ExecutableSchema, c Complexity) graphql.ExecutableSchema {
+	return &complexitySchema{ExecutableSchema: es, funcs: c}
+}
+
+type complexitySchema struct {
+	graphql.ExecutableSchema
+	funcs Complexity
+}
+
+// Complexity implements the graphql.ExecutableSchema interface.
+func (s *complexitySchema) Complexity(typeName, fieldName string, childComplexity int, args map[string]any) (int, bool) {
+	if f := s.funcs[typeName][fieldName]; f != nil {
+		return f(childComplexity, args), true
+	}
+	return s.ExecutableSchema.Complexity(typeName, fieldName, childComplexity, args)
+}
+
+const (
+	errComplexityLimit = "COMPLEXITY_LIMIT_EXCEEDED"
+	errDepthLimit      = "DEPTH_LIMIT_EXCEEDED"
+)
+
+// ComplexityLimit is a gqlgen extension that rejects operations whose complexity,
+// computed using the Complexity functions, exceeds the Limit, or whose depth
+// exceeds the MaxDepth. A zero Limit or MaxDepth disables the respective check.
+//
+//	srv.Use(&entgql.ComplexityLimit{
+//		Complexity: ent.Complexity(),
+//		Limit:      1000,
+//		MaxDepth:   10,
+//	})
+type ComplexityLimit struct {
+	Complexity Complexity
+	Limit      int
+	MaxDepth   int
+	es         graphql.ExecutableSchema
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationContextMutator
+} = (*ComplexityLimit)(nil)
+
+// ExtensionName returns the extension name.
+func (*ComplexityLimit) ExtensionName() string {
+	return "EntGQLComplexityLimit"
+}
+
+// Validate is called when adding an extension to the server, it allows validation against the servers schema.
+func (c *ComplexityLimit) Validate(es graphql.ExecutableSchema) error {
+	if c.Limit < 0 || c.MaxDepth < 0 {
+		return errors.New("entgql: complexity and depth limits must be non-negative")
+	}
+	c.es = ComplexitySchema(es, c.Complexity)
+	return nil
+}
+
+// MutateOperationContext rejects operations that exceed the complexity or depth limits.
+func (c *ComplexityLimit) MutateOperationContext(_ context.Context, oc *graphql.OperationContext) *gqlerror.Error {
+	if oc.Operation == nil {
+		return nil
+	}
+	if c.MaxDepth > 0 {
+		if d := selectionDepth(oc.Operation.SelectionSet, map[string]bool{}); d > c.MaxDepth {
+			err := gqlerror.Errorf("operation has depth %d, which exceeds the limit of %d", d, c.MaxDepth)
+			errcode.Set(err, errDepthLimit)
+			return err
+		}
+	}
+	if c.Limit > 0 {
+		if n := complexity.Calculate(c.es, oc.Operation, oc.Variables); n > c.Limit {
+			err := gqlerror.Errorf("operation has complexity %d, which exceeds the limit of %d", n, c.Limit)
+			errcode.Set(err, errComplexityLimit)
+			return err
+		}
+	}
+	return nil
+}
+
+// selectionDepth returns the number of nested fields in the selection set. Fragments
+// do not add to the depth, and visited guards against cyclic fragment spreads.
+func selectionDepth(set ast.SelectionSet, visited map[string]bool) int {
+	var depth int
+	for _, s := range set {
+		var d int
+		switch s := s.(type) {
+		case *ast.Field:
+			d = 1 + selectionDepth(s.SelectionSet, visited)
+		case *ast.InlineFragment:
+			d = selectionDepth(s.SelectionSet, visited)
+		case *ast.FragmentSpread:
+			if s.Definition == nil || visited[s.Name] {
+				continue
+			}
+			visited[s.Name] = true
+			d = selectionDepth(s.Definition.SelectionSet, visited)
+			delete(visited, s.Name)
+		}
+		depth = max(depth, d)
+	}
+	return depth
+}
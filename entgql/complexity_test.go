@@ -0,0 +1,57 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+func TestConnectionComplexity(t *testing.T) {
+	c := ConnectionComplexity(0)
+	require.Equal(t, 1+2*DefaultListSize, c(2, map[string]any{}))
+	require.Equal(t, 21, c(2, map[string]any{"first": 10}))
+	require.Equal(t, 21, c(2, map[string]any{"last": int64(10)}))
+	require.Equal(t, 21, c(2, map[string]any{"first": json.Number("10")}))
+	require.Equal(t, 1+2*DefaultListSize, c(2, map[string]any{"first": nil}))
+
+	c = ConnectionComplexity(25)
+	require.Equal(t, 51, c(2, map[string]any{}))
+	require.Equal(t, 11, c(2, map[string]any{"first": float64(5)}))
+
+	require.Equal(t, 1+3*DefaultListSize, ListComplexity()(3, nil))
+}
+
+func TestSelectionDepth(t *testing.T) {
+	frag := &ast.FragmentDefinition{Name: "f"}
+	frag.SelectionSet = ast.SelectionSet{
+		&ast.Field{Name: "a", SelectionSet: ast.SelectionSet{
+			&ast.FragmentSpread{Name: "f", Definition: frag},
+		}},
+	}
+	set := ast.SelectionSet{
+		&ast.Field{Name: "x"},
+		&ast.Field{Name: "y", SelectionSet: ast.SelectionSet{
+			&ast.InlineFragment{SelectionSet: ast.SelectionSet{
+				&ast.Field{Name: "z"},
+			}},
+		}},
+	}
+	require.Equal(t, 2, selectionDepth(set, map[string]bool{}))
+	require.Equal(t, 1, selectionDepth(ast.SelectionSet{&ast.FragmentSpread{Name: "f", Definition: frag}}, map[string]bool{}))
+}
@@ -25,3 +25,19 @@ func ErrNodeNotFound(id interface{}) *gqlerror.Error {
 	errcode.Set(err, "NOT_FOUND")
 	return err
 }
+
+// ErrBulkLimitExceeded creates a graphql error for bulk mutations
+// that affect more rows than the given limit.
+func ErrBulkLimitExceeded(limit int) *gqlerror.Error {
+	err := gqlerror.Errorf("Bulk mutation exceeds the limit of %d affected rows", limit)
+	errcode.Set(err, "BULK_LIMIT_EXCEEDED")
+	return err
+}
+
+// ErrNestedDepthExceeded creates a graphql error for mutation
+// inputs that are nested deeper than the given limit.
+func ErrNestedDepthExceeded(limit int) *gqlerror.Error {
+	err := gqlerror.Errorf("Nested mutation input exceeds the maximum depth of %d", limit)
+	errcode.Set(err, "NESTED_DEPTH_EXCEEDED")
+	return err
+}
@@ -27,3 +27,17 @@ func TestErrNodeNotFound(t *testing.T) {
 	require.EqualError(t, err, "input: Could not resolve to a node with the global id of '42'")
 	require.Equal(t, "NOT_FOUND", err.Extensions["code"])
 }
+
+func TestErrBulkLimitExceeded(t *testing.T) {
+	t.Parallel()
+	err := entgql.ErrBulkLimitExceeded(100)
+	require.EqualError(t, err, "input: Bulk mutation exceeds the limit of 100 affected rows")
+	require.Equal(t, "BULK_LIMIT_EXCEEDED", err.Extensions["code"])
+}
+
+func TestErrNestedDepthExceeded(t *testing.T) {
+	t.Parallel()
+	err := entgql.ErrNestedDepthExceeded(3)
+	require.EqualError(t, err, "input: Nested mutation input exceeds the maximum depth of 3")
+	require.Equal(t, "NESTED_DEPTH_EXCEEDED", err.Extensions["code"])
+}
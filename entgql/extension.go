@@ -159,6 +159,68 @@ func WithNodeDescriptor(b bool) ExtensionOption {
 	}
 }
 
+// WithGlobalID configures the extension to either add or remove
+// the GlobalIDTemplate from the code generation templates.
+//
+// In case this option is enabled, the IDs of the nodes are exposed as opaque
+// global IDs, that encode their GraphQL type and their local ID, and are
+// resolved by Noder and Noders without relying on the ID ranges of the tables.
+// The GraphQL ID scalar should be mapped in the gqlgen.yml config file to the
+// generated GlobalID and NodeID marshalers, in this order:
+//
+//	models:
+//	  ID:
+//	    model:
+//	      - <package>/ent.GlobalID
+//	      - <package>/ent.NodeID
+//
+// The arguments of the resolvers, like the id of the node query, are passed
+// as global IDs, as they may identify nodes of any type, and are decoded to
+// local IDs using the generated UnmarshalNodeIDOf. The ID fields of the ent
+// types and inputs are decoded to their local IDs by the NodeID marshaler.
+//
+// The types with a composite ID, like the edge schemas, are exposed as nodes
+// whose global IDs encode the IDs of the two nodes they connect.
+func WithGlobalID(b bool) ExtensionOption {
+	return func(ex *Extension) error {
+		ex.globalID = b
+		i, exists := ex.hasTemplate(GlobalIDTemplate)
+		if b && !exists {
+			ex.templates = append(ex.templates, GlobalIDTemplate)
+		} else if !b && exists && len(ex.templates) > 0 {
+			ex.templates = append(ex.templates[:i], ex.templates[i+1:]...)
+		}
+		return nil
+	}
+}
+
+// WithMutationFields configures the extension to either add or remove
+// the MutationTemplate from the code generation templates.
+//
+// In case this option is enabled, EntGQL generates the create<T>, update<T>
+// and delete<T> fields of the Mutation type, and their payloads, for the
+// MutationCreate, MutationUpdate and MutationDelete options of the
+// entgql.Mutations annotation, and their bulk variants if enabled.
+// The MutationTemplate generates a MutationResolver implementing them on
+// top of the ent client, that the gqlgen resolvers can delegate to, or
+// replace per type.
+//
+//	func (r *mutationResolver) CreateTodo(ctx context.Context, input ent.CreateTodoInput) (*ent.CreateTodoPayload, error) {
+//		return (&ent.MutationResolver{Client: r.client}).CreateTodo(ctx, input)
+//	}
+func WithMutationFields(b bool) ExtensionOption {
+	return func(ex *Extension) error {
+		ex.genMutationFields = b
+		i, exists := ex.hasTemplate(MutationTemplate)
+		if b && !exists {
+			ex.templates = append(ex.templates, MutationTemplate)
+		} else if !b && exists && len(ex.templates) > 0 {
+			ex.templates = append(ex.templates[:i], ex.templates[i+1:]...)
+		}
+		return nil
+	}
+}
+
 // WithRelaySpec enables or disables generating the Relay Node interface.
 func WithRelaySpec(enabled bool) ExtensionOption {
 	return func(e *Extension) error {
@@ -0,0 +1,171 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// ToGlobalID returns the opaque global ID of a node, that encodes its GraphQL
+// type and its local ID as the base64 encoding of "Type:ID". IDs are formatted
+// using their encoding.TextMarshaler or graphql.Marshaler implementation, if
+// they have one, and their default format otherwise.
+func ToGlobalID(typ string, id any) (string, error) {
+	if typ == "" {
+		return "", fmt.Errorf("entgql: missing type of global id %v", id)
+	}
+	local, err := formatID(id)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString([]byte(typ + ":" + local)), nil
+}
+
+// FromGlobalID decodes a global ID created by ToGlobalID, and returns the
+// GraphQL type of the node and its local ID parsed as T.
+//
+//	typ, id, err := entgql.FromGlobalID[int](gid)
+func FromGlobalID[T any](gid string) (typ string, id T, err error) {
+	typ, local, err := decodeGlobalID(gid)
+	if err != nil {
+		return "", id, err
+	}
+	if id, err = parseID[T](local); err != nil {
+		return "", id, fmt.Errorf("entgql: invalid global id %q: %w", gid, err)
+	}
+	return typ, id, nil
+}
+
+// ToCompositeGlobalID returns the opaque global ID of a node with a composite
+// ID, like the edge schemas, that is identified by the IDs of the two nodes it
+// connects. The local ID is encoded as a JSON array of their formatted IDs.
+func ToCompositeGlobalID(typ string, id1, id2 any) (string, error) {
+	var (
+		local [2]string
+		err   error
+	)
+	if local[0], err = formatID(id1); err != nil {
+		return "", err
+	}
+	if local[1], err = formatID(id2); err != nil {
+		return "", err
+	}
+	b, err := json.Marshal(local)
+	if err != nil {
+		return "", err
+	}
+	return ToGlobalID(typ, string(b))
+}
+
+// FromCompositeGlobalID decodes a global ID created by ToCompositeGlobalID,
+// and returns the GraphQL type of the node and the two parts of its ID.
+//
+//	typ, userID, groupID, err := entgql.FromCompositeGlobalID[int, int](gid)
+func FromCompositeGlobalID[T1, T2 any](gid string) (typ string, id1 T1, id2 T2, err error) {
+	typ, local, err := decodeGlobalID(gid)
+	if err != nil {
+		return "", id1, id2, err
+	}
+	var parts [2]string
+	if err := json.Unmarshal([]byte(local), &parts); err != nil {
+		return "", id1, id2, fmt.Errorf("entgql: invalid composite global id %q: %w", gid, err)
+	}
+	if id1, err = parseID[T1](parts[0]); err != nil {
+		return "", id1, id2, fmt.Errorf("entgql: invalid composite global id %q: %w", gid, err)
+	}
+	if id2, err = parseID[T2](parts[1]); err != nil {
+		return "", id1, id2, fmt.Errorf("entgql: invalid composite global id %q: %w", gid, err)
+	}
+	return typ, id1, id2, nil
+}
+
+// GlobalIDType returns the GraphQL type encoded in a global ID.
+func GlobalIDType(gid string) (string, error) {
+	typ, _, err := decodeGlobalID(gid)
+	return typ, err
+}
+
+// decodeGlobalID returns the type and the formatted local ID of a global ID.
+func decodeGlobalID(gid string) (typ, local string, err error) {
+	b, err := base64.StdEncoding.DecodeString(gid)
+	if err != nil {
+		return "", "", fmt.Errorf("entgql: invalid global id %q: %w", gid, err)
+	}
+	typ, local, ok := strings.Cut(string(b), ":")
+	if !ok || typ == "" {
+		return "", "", fmt.Errorf("entgql: invalid global id %q: missing type", gid)
+	}
+	return typ, local, nil
+}
+
+// formatID formats a local ID to its string representation.
+func formatID(id any) (string, error) {
+	switch id := id.(type) {
+	case string:
+		return id, nil
+	case encoding.TextMarshaler:
+		b, err := id.MarshalText()
+		return string(b), err
+	case graphql.Marshaler:
+		var b bytes.Buffer
+		id.MarshalGQL(&b)
+		if s, err := strconv.Unquote(b.String()); err == nil {
+			return s, nil
+		}
+		return b.String(), nil
+	default:
+		return fmt.Sprint(id), nil
+	}
+}
+
+// parseID parses a local ID from its string representation.
+func parseID[T any](s string) (id T, err error) {
+	switch v := any(&id).(type) {
+	case *string:
+		*v = s
+	case encoding.TextUnmarshaler:
+		err = v.UnmarshalText([]byte(s))
+	case graphql.Unmarshaler:
+		err = v.UnmarshalGQL(s)
+	default:
+		rv := reflect.ValueOf(&id).Elem()
+		switch rv.Kind() {
+		case reflect.String:
+			rv.SetString(s)
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			var n int64
+			if n, err = strconv.ParseInt(s, 10, rv.Type().Bits()); err == nil {
+				rv.SetInt(n)
+			}
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			var n uint64
+			if n, err = strconv.ParseUint(s, 10, rv.Type().Bits()); err == nil {
+				rv.SetUint(n)
+			}
+		default:
+			err = fmt.Errorf("unsupported id type %T", id)
+		}
+	}
+	return id, err
+}
@@ -0,0 +1,87 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGlobalID(t *testing.T) {
+	gid, err := ToGlobalID("Todo", 42)
+	require.NoError(t, err)
+	require.Equal(t, "VG9kbzo0Mg==", gid)
+	typ, id, err := FromGlobalID[int](gid)
+	require.NoError(t, err)
+	require.Equal(t, "Todo", typ)
+	require.Equal(t, 42, id)
+
+	type name string
+	gid, err = ToGlobalID("User", name("a:b"))
+	require.NoError(t, err)
+	typ, n, err := FromGlobalID[name](gid)
+	require.NoError(t, err)
+	require.Equal(t, "User", typ)
+	require.Equal(t, name("a:b"), n)
+
+	u := uuid.New()
+	gid, err = ToGlobalID("Group", u)
+	require.NoError(t, err)
+	typ, uid, err := FromGlobalID[uuid.UUID](gid)
+	require.NoError(t, err)
+	require.Equal(t, "Group", typ)
+	require.Equal(t, u, uid)
+
+	_, err = ToGlobalID("", 1)
+	require.EqualError(t, err, "entgql: missing type of global id 1")
+	_, _, err = FromGlobalID[int]("42")
+	require.ErrorContains(t, err, `entgql: invalid global id "42"`)
+	_, _, err = FromGlobalID[int]("VG9kbw==")
+	require.EqualError(t, err, `entgql: invalid global id "VG9kbw==": missing type`)
+	_, _, err = FromGlobalID[uint8]("VG9kbzo0Mg==")
+	require.NoError(t, err)
+	_, _, err = FromGlobalID[int](gid)
+	require.ErrorContains(t, err, "invalid syntax")
+	_, _, err = FromGlobalID[struct{}](gid)
+	require.ErrorContains(t, err, "unsupported id type struct {}")
+}
+
+func TestCompositeGlobalID(t *testing.T) {
+	u := uuid.New()
+	gid, err := ToCompositeGlobalID("Membership", u, "a:b")
+	require.NoError(t, err)
+	typ, err := GlobalIDType(gid)
+	require.NoError(t, err)
+	require.Equal(t, "Membership", typ)
+	typ, id1, id2, err := FromCompositeGlobalID[uuid.UUID, string](gid)
+	require.NoError(t, err)
+	require.Equal(t, "Membership", typ)
+	require.Equal(t, u, id1)
+	require.Equal(t, "a:b", id2)
+
+	// Global IDs of nodes with non-composite IDs are rejected.
+	gid, err = ToGlobalID("Todo", 42)
+	require.NoError(t, err)
+	_, _, _, err = FromCompositeGlobalID[int, int](gid)
+	require.ErrorContains(t, err, `entgql: invalid composite global id "VG9kbzo0Mg=="`)
+	gid, err = ToCompositeGlobalID("Membership", 1, "a")
+	require.NoError(t, err)
+	_, _, _, err = FromCompositeGlobalID[int, int](gid)
+	require.ErrorContains(t, err, "invalid syntax")
+	_, err = GlobalIDType("42")
+	require.ErrorContains(t, err, `entgql: invalid global id "42"`)
+}
@@ -0,0 +1,146 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/contrib/entgql/internal/edgeschema/ent/group"
+	"entgo.io/contrib/entgql/internal/edgeschema/ent/user"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// edgeLoaderKey is the key of the batch loader of the edges that resolve
+// the same GraphQL field of the nodes that are stored in the same table.
+type edgeLoaderKey struct {
+	table string
+	field *ast.Field
+}
+
+// batchLoad returns a copy of the node, with the edge of the resolved field loaded
+// by a batch loader with the edges of the other nodes that resolve the same field.
+// The node itself is returned in case the context does not hold batch loaders.
+func (gr *Group) batchLoad(ctx context.Context) (*Group, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return gr, nil
+	}
+	key := edgeLoaderKey{table: group.Table, field: fc.Field.Field}
+	l := entgql.BatchLoader(ctx, key, func(ctx context.Context, ids []int) (map[int]*Group, error) {
+		query := (&GroupClient{config: gr.config}).Query().
+			Where(group.IDIn(ids...))
+		parent := graphql.CollectedField{Field: &ast.Field{}, Selections: ast.SelectionSet{fc.Field.Field}}
+		ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{Field: parent})
+		if err := query.collectField(ctx, false, graphql.GetOperationContext(ctx), parent, nil, groupImplementors...); err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		loaded := make(map[int]*Group, len(nodes))
+		for _, node := range nodes {
+			loaded[node.ID] = node
+		}
+		return loaded, nil
+	})
+	if l == nil {
+		return gr, nil
+	}
+	node, err := l.Load(ctx, gr.ID)
+	if node == nil && err == nil {
+		return gr, nil
+	}
+	return node, err
+}
+
+func (gr *Group) Users(ctx context.Context) (result []*User, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = gr.NamedUsers(graphql.GetFieldContext(ctx).Field.Alias)
+		if IsNotLoaded(err) {
+			var node *Group
+			if node, err = gr.batchLoad(ctx); err != nil {
+				return nil, err
+			}
+			result, err = node.NamedUsers(fc.Field.Alias)
+		}
+	} else {
+		result, err = gr.Edges.UsersOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = gr.QueryUsers().All(ctx)
+	}
+	return result, err
+}
+
+// batchLoad returns a copy of the node, with the edge of the resolved field loaded
+// by a batch loader with the edges of the other nodes that resolve the same field.
+// The node itself is returned in case the context does not hold batch loaders.
+func (u *User) batchLoad(ctx context.Context) (*User, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return u, nil
+	}
+	key := edgeLoaderKey{table: user.Table, field: fc.Field.Field}
+	l := entgql.BatchLoader(ctx, key, func(ctx context.Context, ids []int) (map[int]*User, error) {
+		query := (&UserClient{config: u.config}).Query().
+			Where(user.IDIn(ids...))
+		parent := graphql.CollectedField{Field: &ast.Field{}, Selections: ast.SelectionSet{fc.Field.Field}}
+		ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{Field: parent})
+		if err := query.collectField(ctx, false, graphql.GetOperationContext(ctx), parent, nil, userImplementors...); err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		loaded := make(map[int]*User, len(nodes))
+		for _, node := range nodes {
+			loaded[node.ID] = node
+		}
+		return loaded, nil
+	})
+	if l == nil {
+		return u, nil
+	}
+	node, err := l.Load(ctx, u.ID)
+	if node == nil && err == nil {
+		return u, nil
+	}
+	return node, err
+}
+
+func (u *User) Groups(ctx context.Context) (result []*Group, err error) {
+	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
+		result, err = u.NamedGroups(graphql.GetFieldContext(ctx).Field.Alias)
+		if IsNotLoaded(err) {
+			var node *User
+			if node, err = u.batchLoad(ctx); err != nil {
+				return nil, err
+			}
+			result, err = node.NamedGroups(fc.Field.Alias)
+		}
+	} else {
+		result, err = u.Edges.GroupsOrErr()
+	}
+	if IsNotLoaded(err) {
+		result, err = u.QueryGroups().All(ctx)
+	}
+	return result, err
+}
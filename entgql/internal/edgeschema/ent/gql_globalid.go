@@ -0,0 +1,195 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"entgo.io/contrib/entgql/internal/edgeschema/ent/group"
+	"entgo.io/contrib/entgql/internal/edgeschema/ent/membership"
+	"entgo.io/contrib/entgql/internal/edgeschema/ent/user"
+
+	"entgo.io/contrib/entgql"
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// nodeTables maps the GraphQL types of the nodes to their tables.
+var nodeTables = map[string]string{
+	"Group": group.Table,
+	"User":  user.Table,
+}
+
+// nodeIDFields maps the ID fields of the GraphQL types that hold
+// the IDs of other nodes to the GraphQL types of these nodes.
+var nodeIDFields = map[string]map[string]string{
+	"Membership": {
+		"groupID": "Group",
+		"userID":  "User",
+	},
+}
+
+// MarshalGlobalID marshals a global ID, as returned by the NodeID marshaler.
+// It is used for the ID arguments of the resolvers, that are resolved using
+// Noder and Noders, or decoded using entgql.FromGlobalID.
+func MarshalGlobalID(id string) graphql.Marshaler {
+	return graphql.MarshalID(id)
+}
+
+// UnmarshalGlobalID unmarshals a global ID without decoding it.
+func UnmarshalGlobalID(v any) (string, error) {
+	return graphql.UnmarshalID(v)
+}
+
+// MarshalNodeID marshals the ID of a node to its global ID. The type of the node is
+// resolved from the GraphQL field that is marshaled, which is either the id field of
+// the node, or a field holding the ID of another node, like the edge-fields.
+func MarshalNodeID(id int) graphql.ContextMarshaler {
+	return graphql.ContextWriterFunc(func(ctx context.Context, w io.Writer) error {
+		typ, err := nodeIDType(ctx)
+		if err != nil {
+			return err
+		}
+		gid, err := entgql.ToGlobalID(typ, id)
+		if err != nil {
+			return err
+		}
+		graphql.MarshalID(gid).MarshalGQL(w)
+		return nil
+	})
+}
+
+// UnmarshalNodeID unmarshals a global ID to the ID of the node.
+func UnmarshalNodeID(_ context.Context, v any) (int, error) {
+	_, id, err := unmarshalNodeID(v)
+	return id, err
+}
+
+// UnmarshalNodeIDOf unmarshals the global ID of a node of the given GraphQL type to
+// its ID, like the NodeID marshaler does for the ID fields of the inputs. The ID
+// arguments of the resolvers are passed as global IDs, and are decoded using it.
+//
+//	func (r *mutationResolver) UpdateTodo(ctx context.Context, id string, input ent.UpdateTodoInput) (*ent.Todo, error) {
+//		uid, err := ent.UnmarshalNodeIDOf(ctx, "Todo", id)
+//		if err != nil {
+//			return nil, err
+//		}
+//		return ent.FromContext(ctx).Todo.UpdateOneID(uid).SetInput(input).Save(ctx)
+//	}
+func UnmarshalNodeIDOf(_ context.Context, typ string, v any) (int, error) {
+	t, id, err := unmarshalNodeID(v)
+	if err == nil && t != typ {
+		err = fmt.Errorf("global id %q is of type %q, expected %q", v, t, typ)
+	}
+	return id, err
+}
+
+// unmarshalNodeID unmarshals a global ID to the type and the ID of the node.
+func unmarshalNodeID(v any) (string, int, error) {
+	var id int
+	gid, err := graphql.UnmarshalID(v)
+	if err != nil {
+		return "", id, err
+	}
+	typ, id, err := entgql.FromGlobalID[int](gid)
+	if err != nil {
+		return "", id, err
+	}
+	if _, ok := nodeTables[typ]; !ok {
+		return "", id, fmt.Errorf("unknown node type %q of global id %q", typ, gid)
+	}
+	return typ, id, nil
+}
+
+// nodeIDType returns the GraphQL type of the node whose ID is marshaled in the field context.
+func nodeIDType(ctx context.Context) (string, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return "", fmt.Errorf("missing field context of global id")
+	}
+	if typ, ok := nodeIDFields[fc.Object][fc.Field.Name]; ok {
+		return typ, nil
+	}
+	if _, ok := nodeTables[fc.Object]; !ok {
+		return "", fmt.Errorf("cannot resolve the node type of global id field %s.%s", fc.Object, fc.Field.Name)
+	}
+	return fc.Object, nil
+}
+
+// globalID returns the table and the ID of the node with the given global ID.
+// The table is resolved by the NodeType option, if it was provided.
+func (o *nodeOptions) globalID(ctx context.Context, gid string) (string, int, error) {
+	typ, id, err := entgql.FromGlobalID[int](gid)
+	if err != nil {
+		return "", id, fmt.Errorf("cannot resolve table from global id %q: %w", gid, errNodeInvalidID)
+	}
+	if o.nodeType != nil {
+		table, err := o.nodeType(ctx, id)
+		return table, id, err
+	}
+	table, ok := nodeTables[typ]
+	if !ok {
+		return "", id, fmt.Errorf("cannot resolve table from global id %q: %w", gid, errNodeInvalidID)
+	}
+	return table, id, nil
+}
+
+// ID returns the global ID of the Membership, that encodes its composite ID.
+func (m *Membership) ID() (string, error) {
+	return entgql.ToCompositeGlobalID("Membership", m.UserID, m.GroupID)
+}
+
+// User returns the user edge of the Membership.
+func (m *Membership) User(ctx context.Context) (*User, error) {
+	result, err := m.Edges.UserOrErr()
+	if IsNotLoaded(err) {
+		result, err = m.QueryUser().Only(ctx)
+	}
+	return result, err
+}
+
+// Group returns the group edge of the Membership.
+func (m *Membership) Group(ctx context.Context) (*Group, error) {
+	result, err := m.Edges.GroupOrErr()
+	if IsNotLoaded(err) {
+		result, err = m.QueryGroup().Only(ctx)
+	}
+	return result, err
+}
+
+// compositeNoder returns the node of a type with a composite ID by its global id.
+// It reports false if the global id is not of such a type.
+func (c *Client) compositeNoder(ctx context.Context, gid string) (Noder, bool, error) {
+	typ, err := entgql.GlobalIDType(gid)
+	if err != nil {
+		return nil, false, nil
+	}
+	switch typ {
+	case "Membership":
+		_, id1, id2, err := entgql.FromCompositeGlobalID[int, int](gid)
+		if err != nil {
+			return nil, true, fmt.Errorf("cannot resolve node from global id %q: %w", gid, errNodeInvalidID)
+		}
+		node, err := c.Membership.Query().
+			Where(membership.UserID(id1), membership.GroupID(id2)).
+			Only(ctx)
+		return node, true, err
+	default:
+		return nil, false, nil
+	}
+}
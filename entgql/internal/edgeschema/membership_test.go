@@ -0,0 +1,106 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package edgeschema_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/contrib/entgql/internal/edgeschema"
+	"entgo.io/contrib/entgql/internal/edgeschema/ent/enttest"
+	"entgo.io/ent/dialect"
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/stretchr/testify/require"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestCompositeGlobalID(t *testing.T) {
+	ctx := context.Background()
+	ec := enttest.Open(
+		t, dialect.SQLite,
+		fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()),
+	)
+	u := ec.User.Create().SetName("a8m").SaveX(ctx)
+	g := ec.Group.Create().SetName("ent").SaveX(ctx)
+	ec.Membership.Create().SetUser(u).SetGroup(g).SetRole("admin").SaveX(ctx)
+	srv := handler.NewDefaultServer(edgeschema.NewSchema(ec))
+	srv.Use(entgql.Transactioner{TxOpener: ec})
+	gqlc := client.New(srv)
+
+	gid, err := entgql.ToCompositeGlobalID("Membership", u.ID, g.ID)
+	require.NoError(t, err)
+	var rsp struct {
+		Node struct {
+			ID      string
+			Role    string
+			UserID  string
+			GroupID string
+			User    struct{ ID, Name string }
+			Group   struct{ ID, Name string }
+		}
+	}
+	gqlc.MustPost(`query ($id: ID!) {
+		node(id: $id) {
+			... on Membership { id role userID groupID user { id name } group { id name } }
+		}
+	}`, &rsp, client.Var("id", gid))
+	require.Equal(t, gid, rsp.Node.ID)
+	require.Equal(t, "admin", rsp.Node.Role)
+	require.Equal(t, "a8m", rsp.Node.User.Name)
+	require.Equal(t, "ent", rsp.Node.Group.Name)
+
+	// The edge-fields of the composite ID hold the global IDs of the nodes.
+	require.Equal(t, rsp.Node.User.ID, rsp.Node.UserID)
+	require.Equal(t, rsp.Node.Group.ID, rsp.Node.GroupID)
+	typ, id, err := entgql.FromGlobalID[int](rsp.Node.UserID)
+	require.NoError(t, err)
+	require.Equal(t, "User", typ)
+	require.Equal(t, u.ID, id)
+	typ, id1, id2, err := entgql.FromCompositeGlobalID[int, int](rsp.Node.ID)
+	require.NoError(t, err)
+	require.Equal(t, "Membership", typ)
+	require.Equal(t, u.ID, id1)
+	require.Equal(t, g.ID, id2)
+
+	// Composite and regular global IDs are resolved together.
+	var nodes struct {
+		Nodes []struct {
+			Typename string `json:"__typename"`
+			ID       string
+		}
+	}
+	gqlc.MustPost(`query ($ids: [ID!]!) { nodes(ids: $ids) { __typename id } }`, &nodes,
+		client.Var("ids", []string{rsp.Node.UserID, gid, rsp.Node.GroupID}))
+	require.Len(t, nodes.Nodes, 3)
+	require.Equal(t, "User", nodes.Nodes[0].Typename)
+	require.Equal(t, "Membership", nodes.Nodes[1].Typename)
+	require.Equal(t, gid, nodes.Nodes[1].ID)
+	require.Equal(t, "Group", nodes.Nodes[2].Typename)
+
+	// A composite global ID that does not exist.
+	missing, err := entgql.ToCompositeGlobalID("Membership", u.ID, g.ID+1)
+	require.NoError(t, err)
+	err = gqlc.Post(`query ($id: ID!) { node(id: $id) { id } }`, &rsp, client.Var("id", missing))
+	require.ErrorContains(t, err, "Could not resolve to a node")
+	// A global ID of the Membership type that does not encode a composite ID.
+	invalid, err := entgql.ToGlobalID("Membership", u.ID)
+	require.NoError(t, err)
+	err = gqlc.Post(`query ($id: ID!) { node(id: $id) { id } }`, &rsp, client.Var("id", invalid))
+	require.ErrorContains(t, err, "Could not resolve to a node")
+}
@@ -0,0 +1,41 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package todo
+
+import (
+	"context"
+	"slices"
+
+	"entgo.io/ent/privacy"
+)
+
+type rolesCtxKey struct{}
+
+// WithRoles returns a new context with the roles of the viewer attached.
+func WithRoles(ctx context.Context, roles ...string) context.Context {
+	return context.WithValue(ctx, rolesCtxKey{}, roles)
+}
+
+// Authorize is the policy of the entgql.Authorize annotations. It
+// grants access if the viewer has one of the given policies as role.
+func Authorize(ctx context.Context, policies []string) error {
+	roles, _ := ctx.Value(rolesCtxKey{}).([]string)
+	for _, p := range policies {
+		if slices.Contains(roles, p) {
+			return privacy.Allow
+		}
+	}
+	return privacy.Denyf("viewer is missing one of the roles %q", policies)
+}
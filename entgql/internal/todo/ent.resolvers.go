@@ -74,6 +74,15 @@ func (r *queryResolver) Todos(ctx context.Context, after *entgql.Cursor[int], fi
 		)
 }
 
+// TodosPage is the resolver for the todosPage field.
+func (r *queryResolver) TodosPage(ctx context.Context, offset *int, limit *int, orderBy []*ent.TodoOrder, where *ent.TodoWhereInput) (*ent.TodoPage, error) {
+	return r.client.Todo.Query().
+		PaginateOffset(ctx, offset, limit,
+			ent.WithTodoOrder(orderBy),
+			ent.WithTodoFilter(where.Filter),
+		)
+}
+
 // Users is the resolver for the users field.
 func (r *queryResolver) Users(ctx context.Context, after *entgql.Cursor[int], first *int, before *entgql.Cursor[int], last *int, orderBy *ent.UserOrder, where *ent.UserWhereInput) (*ent.UserConnection, error) {
 	return r.client.User.Query().
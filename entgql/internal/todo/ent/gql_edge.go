@@ -19,9 +19,62 @@ package ent
 import (
 	"context"
 
+	"entgo.io/contrib/entgql"
+	"entgo.io/contrib/entgql/internal/todo/ent/category"
+	"entgo.io/contrib/entgql/internal/todo/ent/friendship"
+	"entgo.io/contrib/entgql/internal/todo/ent/group"
+	"entgo.io/contrib/entgql/internal/todo/ent/onetomany"
+	"entgo.io/contrib/entgql/internal/todo/ent/project"
+	"entgo.io/contrib/entgql/internal/todo/ent/todo"
+	"entgo.io/contrib/entgql/internal/todo/ent/user"
 	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
 )
 
+// edgeLoaderKey is the key of the batch loader of the edges that resolve
+// the same GraphQL field of the nodes that are stored in the same table.
+type edgeLoaderKey struct {
+	table string
+	field *ast.Field
+}
+
+// batchLoad returns a copy of the node, with the edge of the resolved field loaded
+// by a batch loader with the edges of the other nodes that resolve the same field.
+// The node itself is returned in case the context does not hold batch loaders.
+func (c *Category) batchLoad(ctx context.Context) (*Category, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return c, nil
+	}
+	key := edgeLoaderKey{table: category.Table, field: fc.Field.Field}
+	l := entgql.BatchLoader(ctx, key, func(ctx context.Context, ids []int) (map[int]*Category, error) {
+		query := (&CategoryClient{config: c.config}).Query().
+			Where(category.IDIn(ids...))
+		parent := graphql.CollectedField{Field: &ast.Field{}, Selections: ast.SelectionSet{fc.Field.Field}}
+		ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{Field: parent})
+		if err := query.collectField(ctx, false, graphql.GetOperationContext(ctx), parent, nil, categoryImplementors...); err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		loaded := make(map[int]*Category, len(nodes))
+		for _, node := range nodes {
+			loaded[node.ID] = node
+		}
+		return loaded, nil
+	})
+	if l == nil {
+		return c, nil
+	}
+	node, err := l.Load(ctx, c.ID)
+	if node == nil && err == nil {
+		return c, nil
+	}
+	return node, err
+}
+
 func (c *Category) Todos(
 	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy []*TodoOrder, where *TodoWhereInput,
 ) (*TodoConnection, error) {
@@ -30,29 +83,64 @@ func (c *Category) Todos(
 		WithTodoFilter(where.Filter),
 	}
 	alias := graphql.GetFieldContext(ctx).Field.Alias
-	totalCount, hasTotalCount := c.Edges.totalCount[0][alias]
-	if nodes, err := c.NamedTodos(alias); err == nil || hasTotalCount {
+	node := c
+	if _, err := node.NamedTodos(alias); err != nil {
+		if _, ok := node.Edges.totalCount[0][alias]; !ok {
+			if node, err = c.batchLoad(ctx); err != nil {
+				return nil, err
+			}
+		}
+	}
+	totalCount, hasTotalCount := node.Edges.totalCount[0][alias]
+	if nodes, err := node.NamedTodos(alias); err == nil || hasTotalCount {
 		pager, err := newTodoPager(opts, last != nil)
 		if err != nil {
 			return nil, err
 		}
 		conn := &TodoConnection{Edges: []*TodoEdge{}, TotalCount: totalCount}
+		if hasCollectedField(ctx, aggregateField) {
+			if conn.query, err = pager.applyFilter(c.QueryTodos()); err != nil {
+				return nil, err
+			}
+		}
 		conn.build(nodes, pager, after, first, before, last)
 		return conn, nil
 	}
 	return c.QueryTodos().Paginate(ctx, after, first, before, last, opts...)
 }
 
+func (c *Category) TodosPage(
+	ctx context.Context, offset *int, limit *int, orderBy []*TodoOrder, where *TodoWhereInput,
+) (*TodoPage, error) {
+	opts := []TodoPaginateOption{
+		WithTodoOrder(orderBy),
+		WithTodoFilter(where.Filter),
+	}
+	return c.QueryTodos().PaginateOffset(ctx, offset, limit, opts...)
+}
+
 func (c *Category) SubCategories(
 	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy []*CategoryOrder, where *CategoryWhereInput,
 ) (*CategoryConnection, error) {
+	first, err := validateMaxPageSize(first, last, 25)
+	if err != nil {
+		return nil, err
+	}
 	opts := []CategoryPaginateOption{
 		WithCategoryOrder(orderBy),
 		WithCategoryFilter(where.Filter),
 	}
 	alias := graphql.GetFieldContext(ctx).Field.Alias
-	totalCount, hasTotalCount := c.Edges.totalCount[1][alias]
-	if nodes, err := c.NamedSubCategories(alias); err == nil || hasTotalCount {
+	node := c
+	if _, err := node.NamedSubCategories(alias); err != nil {
+		if _, ok := node.Edges.totalCount[1][alias]; !ok {
+			if node, err = c.batchLoad(ctx); err != nil {
+				return nil, err
+			}
+		}
+	}
+	totalCount, hasTotalCount := node.Edges.totalCount[1][alias]
+	if nodes, err := node.NamedSubCategories(alias); err == nil || hasTotalCount {
 		pager, err := newCategoryPager(opts, last != nil)
 		if err != nil {
 			return nil, err
@@ -64,8 +152,52 @@ func (c *Category) SubCategories(
 	return c.QuerySubCategories().Paginate(ctx, after, first, before, last, opts...)
 }
 
+// batchLoad returns a copy of the node, with the edge of the resolved field loaded
+// by a batch loader with the edges of the other nodes that resolve the same field.
+// The node itself is returned in case the context does not hold batch loaders.
+func (f *Friendship) batchLoad(ctx context.Context) (*Friendship, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return f, nil
+	}
+	key := edgeLoaderKey{table: friendship.Table, field: fc.Field.Field}
+	l := entgql.BatchLoader(ctx, key, func(ctx context.Context, ids []int) (map[int]*Friendship, error) {
+		query := (&FriendshipClient{config: f.config}).Query().
+			Where(friendship.IDIn(ids...))
+		parent := graphql.CollectedField{Field: &ast.Field{}, Selections: ast.SelectionSet{fc.Field.Field}}
+		ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{Field: parent})
+		if err := query.collectField(ctx, false, graphql.GetOperationContext(ctx), parent, nil, friendshipImplementors...); err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		loaded := make(map[int]*Friendship, len(nodes))
+		for _, node := range nodes {
+			loaded[node.ID] = node
+		}
+		return loaded, nil
+	})
+	if l == nil {
+		return f, nil
+	}
+	node, err := l.Load(ctx, f.ID)
+	if node == nil && err == nil {
+		return f, nil
+	}
+	return node, err
+}
+
 func (f *Friendship) User(ctx context.Context) (*User, error) {
 	result, err := f.Edges.UserOrErr()
+	if IsNotLoaded(err) {
+		var node *Friendship
+		if node, err = f.batchLoad(ctx); err != nil {
+			return nil, err
+		}
+		result, err = node.Edges.UserOrErr()
+	}
 	if IsNotLoaded(err) {
 		result, err = f.QueryUser().Only(ctx)
 	}
@@ -74,12 +206,56 @@ func (f *Friendship) User(ctx context.Context) (*User, error) {
 
 func (f *Friendship) Friend(ctx context.Context) (*User, error) {
 	result, err := f.Edges.FriendOrErr()
+	if IsNotLoaded(err) {
+		var node *Friendship
+		if node, err = f.batchLoad(ctx); err != nil {
+			return nil, err
+		}
+		result, err = node.Edges.FriendOrErr()
+	}
 	if IsNotLoaded(err) {
 		result, err = f.QueryFriend().Only(ctx)
 	}
 	return result, err
 }
 
+// batchLoad returns a copy of the node, with the edge of the resolved field loaded
+// by a batch loader with the edges of the other nodes that resolve the same field.
+// The node itself is returned in case the context does not hold batch loaders.
+func (gr *Group) batchLoad(ctx context.Context) (*Group, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return gr, nil
+	}
+	key := edgeLoaderKey{table: group.Table, field: fc.Field.Field}
+	l := entgql.BatchLoader(ctx, key, func(ctx context.Context, ids []int) (map[int]*Group, error) {
+		query := (&GroupClient{config: gr.config}).Query().
+			Where(group.IDIn(ids...))
+		parent := graphql.CollectedField{Field: &ast.Field{}, Selections: ast.SelectionSet{fc.Field.Field}}
+		ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{Field: parent})
+		if err := query.collectField(ctx, false, graphql.GetOperationContext(ctx), parent, nil, groupImplementors...); err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		loaded := make(map[int]*Group, len(nodes))
+		for _, node := range nodes {
+			loaded[node.ID] = node
+		}
+		return loaded, nil
+	})
+	if l == nil {
+		return gr, nil
+	}
+	node, err := l.Load(ctx, gr.ID)
+	if node == nil && err == nil {
+		return gr, nil
+	}
+	return node, err
+}
+
 func (gr *Group) Users(
 	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *UserOrder, where *UserWhereInput,
 ) (*UserConnection, error) {
@@ -88,8 +264,16 @@ func (gr *Group) Users(
 		WithUserFilter(where.Filter),
 	}
 	alias := graphql.GetFieldContext(ctx).Field.Alias
-	totalCount, hasTotalCount := gr.Edges.totalCount[0][alias]
-	if nodes, err := gr.NamedUsers(alias); err == nil || hasTotalCount {
+	node := gr
+	if _, err := node.NamedUsers(alias); err != nil {
+		if _, ok := node.Edges.totalCount[0][alias]; !ok {
+			if node, err = gr.batchLoad(ctx); err != nil {
+				return nil, err
+			}
+		}
+	}
+	totalCount, hasTotalCount := node.Edges.totalCount[0][alias]
+	if nodes, err := node.NamedUsers(alias); err == nil || hasTotalCount {
 		pager, err := newUserPager(opts, last != nil)
 		if err != nil {
 			return nil, err
@@ -101,8 +285,52 @@ func (gr *Group) Users(
 	return gr.QueryUsers().Paginate(ctx, after, first, before, last, opts...)
 }
 
+// batchLoad returns a copy of the node, with the edge of the resolved field loaded
+// by a batch loader with the edges of the other nodes that resolve the same field.
+// The node itself is returned in case the context does not hold batch loaders.
+func (otm *OneToMany) batchLoad(ctx context.Context) (*OneToMany, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return otm, nil
+	}
+	key := edgeLoaderKey{table: onetomany.Table, field: fc.Field.Field}
+	l := entgql.BatchLoader(ctx, key, func(ctx context.Context, ids []int) (map[int]*OneToMany, error) {
+		query := (&OneToManyClient{config: otm.config}).Query().
+			Where(onetomany.IDIn(ids...))
+		parent := graphql.CollectedField{Field: &ast.Field{}, Selections: ast.SelectionSet{fc.Field.Field}}
+		ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{Field: parent})
+		if err := query.collectField(ctx, false, graphql.GetOperationContext(ctx), parent, nil, onetomanyImplementors...); err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		loaded := make(map[int]*OneToMany, len(nodes))
+		for _, node := range nodes {
+			loaded[node.ID] = node
+		}
+		return loaded, nil
+	})
+	if l == nil {
+		return otm, nil
+	}
+	node, err := l.Load(ctx, otm.ID)
+	if node == nil && err == nil {
+		return otm, nil
+	}
+	return node, err
+}
+
 func (otm *OneToMany) Parent(ctx context.Context) (*OneToMany, error) {
 	result, err := otm.Edges.ParentOrErr()
+	if IsNotLoaded(err) {
+		var node *OneToMany
+		if node, err = otm.batchLoad(ctx); err != nil {
+			return nil, err
+		}
+		result, err = node.Edges.ParentOrErr()
+	}
 	if IsNotLoaded(err) {
 		result, err = otm.QueryParent().Only(ctx)
 	}
@@ -112,6 +340,13 @@ func (otm *OneToMany) Parent(ctx context.Context) (*OneToMany, error) {
 func (otm *OneToMany) Children(ctx context.Context) (result []*OneToMany, err error) {
 	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
 		result, err = otm.NamedChildren(graphql.GetFieldContext(ctx).Field.Alias)
+		if IsNotLoaded(err) {
+			var node *OneToMany
+			if node, err = otm.batchLoad(ctx); err != nil {
+				return nil, err
+			}
+			result, err = node.NamedChildren(fc.Field.Alias)
+		}
 	} else {
 		result, err = otm.Edges.ChildrenOrErr()
 	}
@@ -121,6 +356,43 @@ func (otm *OneToMany) Children(ctx context.Context) (result []*OneToMany, err er
 	return result, err
 }
 
+// batchLoad returns a copy of the node, with the edge of the resolved field loaded
+// by a batch loader with the edges of the other nodes that resolve the same field.
+// The node itself is returned in case the context does not hold batch loaders.
+func (pr *Project) batchLoad(ctx context.Context) (*Project, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return pr, nil
+	}
+	key := edgeLoaderKey{table: project.Table, field: fc.Field.Field}
+	l := entgql.BatchLoader(ctx, key, func(ctx context.Context, ids []int) (map[int]*Project, error) {
+		query := (&ProjectClient{config: pr.config}).Query().
+			Where(project.IDIn(ids...))
+		parent := graphql.CollectedField{Field: &ast.Field{}, Selections: ast.SelectionSet{fc.Field.Field}}
+		ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{Field: parent})
+		if err := query.collectField(ctx, false, graphql.GetOperationContext(ctx), parent, nil, projectImplementors...); err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		loaded := make(map[int]*Project, len(nodes))
+		for _, node := range nodes {
+			loaded[node.ID] = node
+		}
+		return loaded, nil
+	})
+	if l == nil {
+		return pr, nil
+	}
+	node, err := l.Load(ctx, pr.ID)
+	if node == nil && err == nil {
+		return pr, nil
+	}
+	return node, err
+}
+
 func (pr *Project) Todos(
 	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy []*TodoOrder, where *TodoWhereInput,
 ) (*TodoConnection, error) {
@@ -129,21 +401,78 @@ func (pr *Project) Todos(
 		WithTodoFilter(where.Filter),
 	}
 	alias := graphql.GetFieldContext(ctx).Field.Alias
-	totalCount, hasTotalCount := pr.Edges.totalCount[0][alias]
-	if nodes, err := pr.NamedTodos(alias); err == nil || hasTotalCount {
+	node := pr
+	if _, err := node.NamedTodos(alias); err != nil {
+		if _, ok := node.Edges.totalCount[0][alias]; !ok {
+			if node, err = pr.batchLoad(ctx); err != nil {
+				return nil, err
+			}
+		}
+	}
+	totalCount, hasTotalCount := node.Edges.totalCount[0][alias]
+	if nodes, err := node.NamedTodos(alias); err == nil || hasTotalCount {
 		pager, err := newTodoPager(opts, last != nil)
 		if err != nil {
 			return nil, err
 		}
 		conn := &TodoConnection{Edges: []*TodoEdge{}, TotalCount: totalCount}
+		if hasCollectedField(ctx, aggregateField) {
+			if conn.query, err = pager.applyFilter(pr.QueryTodos()); err != nil {
+				return nil, err
+			}
+		}
 		conn.build(nodes, pager, after, first, before, last)
 		return conn, nil
 	}
 	return pr.QueryTodos().Paginate(ctx, after, first, before, last, opts...)
 }
 
+// batchLoad returns a copy of the node, with the edge of the resolved field loaded
+// by a batch loader with the edges of the other nodes that resolve the same field.
+// The node itself is returned in case the context does not hold batch loaders.
+func (t *Todo) batchLoad(ctx context.Context) (*Todo, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return t, nil
+	}
+	key := edgeLoaderKey{table: todo.Table, field: fc.Field.Field}
+	l := entgql.BatchLoader(ctx, key, func(ctx context.Context, ids []int) (map[int]*Todo, error) {
+		query := (&TodoClient{config: t.config}).Query().
+			Where(todo.IDIn(ids...))
+		parent := graphql.CollectedField{Field: &ast.Field{}, Selections: ast.SelectionSet{fc.Field.Field}}
+		ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{Field: parent})
+		if err := query.collectField(ctx, false, graphql.GetOperationContext(ctx), parent, nil, todoImplementors...); err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		loaded := make(map[int]*Todo, len(nodes))
+		for _, node := range nodes {
+			loaded[node.ID] = node
+		}
+		return loaded, nil
+	})
+	if l == nil {
+		return t, nil
+	}
+	node, err := l.Load(ctx, t.ID)
+	if node == nil && err == nil {
+		return t, nil
+	}
+	return node, err
+}
+
 func (t *Todo) Parent(ctx context.Context) (*Todo, error) {
 	result, err := t.Edges.ParentOrErr()
+	if IsNotLoaded(err) {
+		var node *Todo
+		if node, err = t.batchLoad(ctx); err != nil {
+			return nil, err
+		}
+		result, err = node.Edges.ParentOrErr()
+	}
 	if IsNotLoaded(err) {
 		result, err = t.QueryParent().Only(ctx)
 	}
@@ -158,13 +487,26 @@ func (t *Todo) Children(
 		WithTodoFilter(where.Filter),
 	}
 	alias := graphql.GetFieldContext(ctx).Field.Alias
-	totalCount, hasTotalCount := t.Edges.totalCount[1][alias]
-	if nodes, err := t.NamedChildren(alias); err == nil || hasTotalCount {
+	node := t
+	if _, err := node.NamedChildren(alias); err != nil {
+		if _, ok := node.Edges.totalCount[1][alias]; !ok {
+			if node, err = t.batchLoad(ctx); err != nil {
+				return nil, err
+			}
+		}
+	}
+	totalCount, hasTotalCount := node.Edges.totalCount[1][alias]
+	if nodes, err := node.NamedChildren(alias); err == nil || hasTotalCount {
 		pager, err := newTodoPager(opts, last != nil)
 		if err != nil {
 			return nil, err
 		}
 		conn := &TodoConnection{Edges: []*TodoEdge{}, TotalCount: totalCount}
+		if hasCollectedField(ctx, aggregateField) {
+			if conn.query, err = pager.applyFilter(t.QueryChildren()); err != nil {
+				return nil, err
+			}
+		}
 		conn.build(nodes, pager, after, first, before, last)
 		return conn, nil
 	}
@@ -173,12 +515,56 @@ func (t *Todo) Children(
 
 func (t *Todo) Category(ctx context.Context) (*Category, error) {
 	result, err := t.Edges.CategoryOrErr()
+	if IsNotLoaded(err) {
+		var node *Todo
+		if node, err = t.batchLoad(ctx); err != nil {
+			return nil, err
+		}
+		result, err = node.Edges.CategoryOrErr()
+	}
 	if IsNotLoaded(err) {
 		result, err = t.QueryCategory().Only(ctx)
 	}
 	return result, MaskNotFound(err)
 }
 
+// batchLoad returns a copy of the node, with the edge of the resolved field loaded
+// by a batch loader with the edges of the other nodes that resolve the same field.
+// The node itself is returned in case the context does not hold batch loaders.
+func (u *User) batchLoad(ctx context.Context) (*User, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return u, nil
+	}
+	key := edgeLoaderKey{table: user.Table, field: fc.Field.Field}
+	l := entgql.BatchLoader(ctx, key, func(ctx context.Context, ids []int) (map[int]*User, error) {
+		query := (&UserClient{config: u.config}).Query().
+			Where(user.IDIn(ids...))
+		parent := graphql.CollectedField{Field: &ast.Field{}, Selections: ast.SelectionSet{fc.Field.Field}}
+		ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{Field: parent})
+		if err := query.collectField(ctx, false, graphql.GetOperationContext(ctx), parent, nil, userImplementors...); err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		loaded := make(map[int]*User, len(nodes))
+		for _, node := range nodes {
+			loaded[node.ID] = node
+		}
+		return loaded, nil
+	})
+	if l == nil {
+		return u, nil
+	}
+	node, err := l.Load(ctx, u.ID)
+	if node == nil && err == nil {
+		return u, nil
+	}
+	return node, err
+}
+
 func (u *User) Groups(
 	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, where *GroupWhereInput,
 ) (*GroupConnection, error) {
@@ -186,8 +572,16 @@ func (u *User) Groups(
 		WithGroupFilter(where.Filter),
 	}
 	alias := graphql.GetFieldContext(ctx).Field.Alias
-	totalCount, hasTotalCount := u.Edges.totalCount[0][alias]
-	if nodes, err := u.NamedGroups(alias); err == nil || hasTotalCount {
+	node := u
+	if _, err := node.NamedGroups(alias); err != nil {
+		if _, ok := node.Edges.totalCount[0][alias]; !ok {
+			if node, err = u.batchLoad(ctx); err != nil {
+				return nil, err
+			}
+		}
+	}
+	totalCount, hasTotalCount := node.Edges.totalCount[0][alias]
+	if nodes, err := node.NamedGroups(alias); err == nil || hasTotalCount {
 		pager, err := newGroupPager(opts, last != nil)
 		if err != nil {
 			return nil, err
@@ -207,8 +601,16 @@ func (u *User) Friends(
 		WithUserFilter(where.Filter),
 	}
 	alias := graphql.GetFieldContext(ctx).Field.Alias
-	totalCount, hasTotalCount := u.Edges.totalCount[1][alias]
-	if nodes, err := u.NamedFriends(alias); err == nil || hasTotalCount {
+	node := u
+	if _, err := node.NamedFriends(alias); err != nil {
+		if _, ok := node.Edges.totalCount[1][alias]; !ok {
+			if node, err = u.batchLoad(ctx); err != nil {
+				return nil, err
+			}
+		}
+	}
+	totalCount, hasTotalCount := node.Edges.totalCount[1][alias]
+	if nodes, err := node.NamedFriends(alias); err == nil || hasTotalCount {
 		pager, err := newUserPager(opts, last != nil)
 		if err != nil {
 			return nil, err
@@ -227,8 +629,16 @@ func (u *User) Friendships(
 		WithFriendshipFilter(where.Filter),
 	}
 	alias := graphql.GetFieldContext(ctx).Field.Alias
-	totalCount, hasTotalCount := u.Edges.totalCount[2][alias]
-	if nodes, err := u.NamedFriendships(alias); err == nil || hasTotalCount {
+	node := u
+	if _, err := node.NamedFriendships(alias); err != nil {
+		if _, ok := node.Edges.totalCount[2][alias]; !ok {
+			if node, err = u.batchLoad(ctx); err != nil {
+				return nil, err
+			}
+		}
+	}
+	totalCount, hasTotalCount := node.Edges.totalCount[2][alias]
+	if nodes, err := node.NamedFriendships(alias); err == nil || hasTotalCount {
 		pager, err := newFriendshipPager(opts, last != nil)
 		if err != nil {
 			return nil, err
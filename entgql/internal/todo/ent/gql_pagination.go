@@ -45,6 +45,7 @@ type (
 	Cursor         = entgql.Cursor[int]
 	PageInfo       = entgql.PageInfo[int]
 	OrderDirection = entgql.OrderDirection
+	OffsetPageInfo = entgql.OffsetPageInfo
 )
 
 func orderFunc(o OrderDirection, field string) func(*sql.Selector) {
@@ -76,6 +77,30 @@ func validateFirstLast(first, last *int) (err *gqlerror.Error) {
 	return err
 }
 
+// validateMaxPageSize validates that first and last do not exceed the maximum
+// page size of a connection, and returns the first argument to paginate with,
+// which defaults to the maximum page size in case both were not set.
+func validateMaxPageSize(first, last *int, size int) (*int, error) {
+	if first != nil && *first > size {
+		err := &gqlerror.Error{
+			Message: fmt.Sprintf("`first` on a connection cannot exceed %d.", size),
+		}
+		errcode.Set(err, errInvalidPagination)
+		return nil, err
+	}
+	if last != nil && *last > size {
+		err := &gqlerror.Error{
+			Message: fmt.Sprintf("`last` on a connection cannot exceed %d.", size),
+		}
+		errcode.Set(err, errInvalidPagination)
+		return nil, err
+	}
+	if first == nil && last == nil {
+		first = &size
+	}
+	return first, nil
+}
+
 func collectedField(ctx context.Context, path ...string) *graphql.CollectedField {
 	fc := graphql.GetFieldContext(ctx)
 	if fc == nil {
@@ -108,8 +133,41 @@ const (
 	nodeField       = "node"
 	pageInfoField   = "pageInfo"
 	totalCountField = "totalCount"
+	nodesField      = "nodes"
 )
 
+// validateOffsetLimit validates the offset and limit arguments of a page, and returns
+// the limit to paginate with, which defaults to the maximum page size if it is set.
+func validateOffsetLimit(offset, limit *int, size int) (*int, error) {
+	if offset != nil && *offset < 0 {
+		err := &gqlerror.Error{
+			Message: "`offset` on a page cannot be less than zero.",
+		}
+		errcode.Set(err, errInvalidPagination)
+		return nil, err
+	}
+	if limit != nil && *limit < 0 {
+		err := &gqlerror.Error{
+			Message: "`limit` on a page cannot be less than zero.",
+		}
+		errcode.Set(err, errInvalidPagination)
+		return nil, err
+	}
+	if size > 0 {
+		if limit != nil && *limit > size {
+			err := &gqlerror.Error{
+				Message: fmt.Sprintf("`limit` on a page cannot exceed %d.", size),
+			}
+			errcode.Set(err, errInvalidPagination)
+			return nil, err
+		}
+		if limit == nil {
+			limit = &size
+		}
+	}
+	return limit, nil
+}
+
 func paginateLimit(first, last *int) int {
 	var limit int
 	if first != nil {
@@ -581,6 +639,10 @@ func (c *CategoryQuery) Paginate(
 	if err := validateFirstLast(first, last); err != nil {
 		return nil, err
 	}
+	first, err := validateMaxPageSize(first, last, 25)
+	if err != nil {
+		return nil, err
+	}
 	pager, err := newCategoryPager(opts, last != nil)
 	if err != nil {
 		return nil, err
@@ -1899,6 +1961,9 @@ type TodoConnection struct {
 	Edges      []*TodoEdge `json:"edges"`
 	PageInfo   PageInfo    `json:"pageInfo"`
 	TotalCount int         `json:"totalCount"`
+	// query holds the filtered query of the connection
+	// in case its aggregate field was collected.
+	query *TodoQuery
 }
 
 func (c *TodoConnection) build(nodes []*Todo, pager *todoPager, after *Cursor, first *int, before *Cursor, last *int) {
@@ -2108,6 +2173,9 @@ func (t *TodoQuery) Paginate(
 		return nil, err
 	}
 	conn := &TodoConnection{Edges: []*TodoEdge{}}
+	if hasCollectedField(ctx, aggregateField) {
+		conn.query = t.Clone()
+	}
 	ignoredEdges := !hasCollectedField(ctx, edgesField)
 	if hasCollectedField(ctx, totalCountField) || hasCollectedField(ctx, pageInfoField) {
 		hasPagination := after != nil || first != nil || before != nil || last != nil
@@ -2145,6 +2213,64 @@ func (t *TodoQuery) Paginate(
 	return conn, nil
 }
 
+// TodoPage is an offset-based page of Todo.
+type TodoPage struct {
+	Nodes      []*Todo        `json:"nodes"`
+	TotalCount int            `json:"totalCount"`
+	PageInfo   OffsetPageInfo `json:"pageInfo"`
+}
+
+// PaginateOffset executes the query and returns the page of Todo at the given offset.
+func (t *TodoQuery) PaginateOffset(
+	ctx context.Context, offset, limit *int, opts ...TodoPaginateOption,
+) (*TodoPage, error) {
+	limit, err := validateOffsetLimit(offset, limit, 0)
+	if err != nil {
+		return nil, err
+	}
+	pager, err := newTodoPager(opts, false)
+	if err != nil {
+		return nil, err
+	}
+	if t, err = pager.applyFilter(t); err != nil {
+		return nil, err
+	}
+	page := &TodoPage{Nodes: []*Todo{}}
+	if hasCollectedField(ctx, totalCountField) {
+		c := t.Clone()
+		c.ctx.Fields = nil
+		if page.TotalCount, err = c.Count(ctx); err != nil {
+			return nil, err
+		}
+	}
+	if (limit != nil && *limit == 0) || (!hasCollectedField(ctx, nodesField) && !hasCollectedField(ctx, pageInfoField)) {
+		page.PageInfo = entgql.NewOffsetPageInfo(offset, limit, 0, false)
+		return page, nil
+	}
+	if offset != nil {
+		t.Offset(*offset)
+	}
+	if limit != nil {
+		t.Limit(*limit + 1)
+	}
+	if field := collectedField(ctx, nodesField); field != nil {
+		if err := t.collectField(ctx, false, graphql.GetOperationContext(ctx), *field, []string{nodesField}); err != nil {
+			return nil, err
+		}
+	}
+	nodes, err := pager.applyOrder(t).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	hasNext := limit != nil && len(nodes) > *limit
+	if hasNext {
+		nodes = nodes[:*limit]
+	}
+	page.Nodes = append(page.Nodes, nodes...)
+	page.PageInfo = entgql.NewOffsetPageInfo(offset, limit, len(nodes), hasNext)
+	return page, nil
+}
+
 var (
 	// TodoOrderFieldCreatedAt orders Todo by created_at.
 	TodoOrderFieldCreatedAt = &TodoOrderField{
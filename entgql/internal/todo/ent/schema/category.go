@@ -77,7 +77,10 @@ func (Category) Fields() []ent.Field {
 			),
 		field.Strings("strings").
 			Optional().
-			Deprecated("use `string` instead"),
+			Deprecated("use `string` instead").
+			Annotations(
+				entgql.WhereOps(entgql.WhereContains, entgql.WhereContainsAny),
+			),
 	}
 }
 
@@ -88,6 +91,7 @@ func (Category) Edges() []ent.Edge {
 			Annotations(
 				entgql.RelayConnection(),
 				entgql.OrderField("TODOS_COUNT"),
+				entgql.OffsetPagination(),
 			),
 		edge.To("sub_categories", Category.Type).
 			Annotations(entgql.RelayConnection()),
@@ -99,7 +103,8 @@ func (Category) Annotations() []schema.Annotation {
 	return []schema.Annotation{
 		entgql.QueryField(),
 		entgql.RelayConnection(),
-		entgql.Mutations(entgql.MutationCreate(), entgql.MutationUpdate()),
+		entgql.Mutations(entgql.MutationCreate(), entgql.MutationUpdate(), entgql.MutationDelete()),
 		entgql.MultiOrder(),
+		entgql.MaxPageSize(25),
 	}
 }
@@ -60,5 +60,6 @@ func (Friendship) Annotations() []schema.Annotation {
 		entgql.Mutations(
 			entgql.MutationUpdate(),
 		),
+		entgql.Authorize("ADMIN"),
 	}
 }
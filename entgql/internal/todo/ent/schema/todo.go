@@ -39,6 +39,7 @@ func (Todo) Fields() []ent.Field {
 			Annotations(
 				entgql.OrderField("CREATED_AT"),
 				entgql.Skip(entgql.SkipMutationCreateInput),
+				entgql.Aggregate(),
 			),
 		field.Enum("status").
 			NamedValues(
@@ -48,17 +49,20 @@ func (Todo) Fields() []ent.Field {
 			).
 			Annotations(
 				entgql.OrderField("STATUS"),
+				entgql.GroupBy(),
 			),
 		field.Int("priority").
 			Default(0).
 			Annotations(
 				entgql.OrderField("PRIORITY_ORDER"),
 				entgql.MapsTo("priorityOrder"),
+				entgql.Aggregate(),
 			),
 		field.Text("text").
 			NotEmpty().
 			Annotations(
 				entgql.OrderField("TEXT"),
+				entgql.WhereOps(entgql.WhereSearch),
 			),
 		field.String("name").
 			Optional().
@@ -78,7 +82,10 @@ func (Todo) Fields() []ent.Field {
 			),
 		field.JSON("init", map[string]any{}).
 			Optional().
-			Annotations(entgql.Type("Map")),
+			Annotations(
+				entgql.Type("Map"),
+				entgql.WhereOps(entgql.WhereHasKey, entgql.WhereValueEQ, entgql.WhereContains),
+			),
 		field.JSON("custom", []customstruct.Custom{}).
 			Annotations(
 				entgql.Skip(entgql.SkipMutationCreateInput),
@@ -105,6 +112,7 @@ func (Todo) Edges() []ent.Edge {
 				// For non-unique edges, the order field can be only on edge count.
 				// The convention is "UPPER(<edge-name>)_COUNT".
 				entgql.OrderField("CHILDREN_COUNT"),
+				entgql.NestedCreate(),
 			).
 			From("parent").
 			Annotations(
@@ -131,7 +139,12 @@ func (Todo) Annotations() []schema.Annotation {
 	return []schema.Annotation{
 		entgql.RelayConnection(),
 		entgql.QueryField().Description("This is the todo item"),
-		entgql.Mutations(entgql.MutationCreate(), entgql.MutationUpdate()),
+		entgql.Mutations(
+			entgql.MutationCreate().Bulk(),
+			entgql.MutationUpdate().Bulk(),
+			entgql.MutationDelete().Bulk(),
+		),
 		entgql.MultiOrder(),
+		entgql.OffsetPagination(),
 	}
 }
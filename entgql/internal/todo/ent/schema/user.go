@@ -40,7 +40,10 @@ func (User) Fields() []ent.Field {
 			Optional(),
 		field.JSON("required_metadata", map[string]any{}),
 		field.JSON("metadata", map[string]any{}).
-			Optional(),
+			Optional().
+			Annotations(
+				entgql.Authorize("ADMIN"),
+			),
 	}
 }
 
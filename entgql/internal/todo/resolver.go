@@ -15,6 +15,7 @@
 package todo
 
 import (
+	"entgo.io/contrib/entgql"
 	"entgo.io/contrib/entgql/internal/todo/ent"
 	"github.com/99designs/gqlgen/graphql"
 )
@@ -27,6 +28,7 @@ func NewSchema(client *ent.Client) graphql.ExecutableSchema {
 	return NewExecutableSchema(Config{
 		Resolvers: &Resolver{client},
 		Directives: DirectiveRoot{
+			Authorize:      (&entgql.Authorizer{Policy: Authorize}).Directive,
 			HasPermissions: HasPermission(),
 		},
 	})
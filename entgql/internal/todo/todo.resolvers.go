@@ -87,6 +87,14 @@ func (r *queryResolver) Ping(ctx context.Context) (string, error) {
 	return "pong", nil
 }
 
+// TopTodos is the resolver for the topTodos field.
+func (r *queryResolver) TopTodos(ctx context.Context, limit int) ([]*ent.Todo, error) {
+	return r.client.Todo.Query().
+		Order(todo.ByPriority(sql.OrderDesc()), todo.ByID()).
+		Limit(limit).
+		All(ctx)
+}
+
 // TodosWithJoins is the resolver for the todosWithJoins field.
 func (r *queryResolver) TodosWithJoins(ctx context.Context, after *entgql.Cursor[int], first *int, before *entgql.Cursor[int], last *int, orderBy []*ent.TodoOrder, where *ent.TodoWhereInput) (*ent.TodoConnection, error) {
 	return r.client.Todo.Query().
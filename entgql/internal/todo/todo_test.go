@@ -1954,6 +1954,85 @@ func TestMutation_CreateCategory(t *testing.T) {
 	require.Equal(t, "c1.t2", n.Edges[1].Node.Text)
 }
 
+func TestMutation_CreateNestedTodos(t *testing.T) {
+	ec := enttest.Open(t, dialect.SQLite,
+		fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()),
+		enttest.WithMigrateOptions(migrate.WithGlobalUniqueID(true)),
+	)
+	srv := handler.NewDefaultServer(gen.NewSchema(ec))
+	srv.Use(entgql.Transactioner{TxOpener: ec})
+	gqlc := client.New(srv)
+
+	var rsp struct {
+		CreateTodo struct {
+			Text     string
+			Children struct {
+				Edges []struct {
+					Node struct {
+						Text     string
+						Children struct {
+							TotalCount int
+						}
+					}
+				}
+			}
+		}
+	}
+	err := gqlc.Post(`
+	mutation createTodo {
+		createTodo(input: {
+			status: IN_PROGRESS
+			text: "t0"
+			createChildren: [
+				{ status: IN_PROGRESS, text: "t1.1", createChildren: [{ status: COMPLETED, text: "t2.1" }] },
+				{ status: COMPLETED, text: "t1.2" }
+			]
+		}) {
+			text
+			children {
+				edges {
+					node {
+						text
+						children {
+							totalCount
+						}
+					}
+				}
+			}
+		}
+	}
+	`, &rsp)
+	require.NoError(t, err)
+	require.Equal(t, "t0", rsp.CreateTodo.Text)
+	n := rsp.CreateTodo.Children
+	require.Len(t, n.Edges, 2)
+	require.Equal(t, "t1.1", n.Edges[0].Node.Text)
+	require.Equal(t, 1, n.Edges[0].Node.Children.TotalCount)
+	require.Equal(t, "t1.2", n.Edges[1].Node.Text)
+	require.Equal(t, 4, ec.Todo.Query().CountX(context.Background()))
+
+	// Inputs nested deeper than entgql.DefaultMaxNestedDepth are rejected, and the transaction is rolled back.
+	err = gqlc.Post(`
+	mutation createTodo {
+		createTodo(input: {
+			status: IN_PROGRESS
+			text: "t0"
+			createChildren: [{
+				status: IN_PROGRESS, text: "t1", createChildren: [{
+					status: IN_PROGRESS, text: "t2", createChildren: [{
+						status: IN_PROGRESS, text: "t3", createChildren: [{ status: IN_PROGRESS, text: "t4" }]
+					}]
+				}]
+			}]
+		}) {
+			text
+		}
+	}
+	`, &rsp)
+	require.EqualError(t, err, `[{"message":"Nested mutation input exceeds the maximum depth of 3","path":["createTodo"],"extensions":{"code":"NESTED_DEPTH_EXCEEDED"}}]`)
+	require.Equal(t, 4, ec.Todo.Query().CountX(context.Background()))
+}
+
 func TestMutation_ClearChildren(t *testing.T) {
 	ec := enttest.Open(t, dialect.SQLite,
 		fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()),
@@ -3178,3 +3257,458 @@ func TestPrivateFieldSelectionForPagination(t *testing.T) {
 		"SELECT `todos`.`id`, `todos`.`text`, `todos`.`status` FROM `todos` LEFT JOIN `categories` AS `t1` ON `todos`.`category_id` = `t1`.`id` WHERE `todos`.`status` < ? OR (`todos`.`status` = ? AND `todos`.`id` > ?) GROUP BY `todos`.`id` ORDER BY `todos`.`status` DESC, `todos`.`id` LIMIT 3",
 	}, rec.queries)
 }
+
+func TestAggregate(t *testing.T) {
+	ctx := context.Background()
+	ec := enttest.Open(
+		t, dialect.SQLite,
+		fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()),
+		enttest.WithMigrateOptions(migrate.WithGlobalUniqueID(true)),
+	)
+	now := time.Now().UTC().Truncate(time.Second)
+	cat := ec.Category.Create().SetText("c1").SetStatus(category.StatusEnabled).SaveX(ctx)
+	ec.Todo.CreateBulk(
+		ec.Todo.Create().SetText("t1").SetStatus(todo.StatusInProgress).SetPriority(1).SetCreatedAt(now.Add(-time.Hour)).SetCategory(cat),
+		ec.Todo.Create().SetText("t2").SetStatus(todo.StatusInProgress).SetPriority(2).SetCreatedAt(now).SetCategory(cat),
+		ec.Todo.Create().SetText("t3").SetStatus(todo.StatusCompleted).SetPriority(3).SetCreatedAt(now.Add(time.Hour)),
+		ec.Todo.Create().SetText("t4").SetStatus(todo.StatusCompleted).SetPriority(6).SetCreatedAt(now.Add(2*time.Hour)),
+	).ExecX(ctx)
+	gqlc := client.New(handler.NewDefaultServer(gen.NewSchema(ec)))
+
+	type aggregate struct {
+		Count int
+		Sum   struct{ Priority *int }
+		Avg   struct{ Priority *float64 }
+		Min   struct {
+			CreatedAt *string
+			Priority  *int
+		}
+		Max struct {
+			CreatedAt *string
+			Priority  *int
+		}
+		Status *todo.Status
+	}
+	t.Run("Total", func(t *testing.T) {
+		var rsp struct {
+			Todos struct {
+				TotalCount int
+				Edges      []struct{ Node struct{ ID string } }
+				Aggregate  []aggregate
+			}
+		}
+		// The aggregations ignore the pagination arguments.
+		gqlc.MustPost(`query {
+			todos(first: 1) {
+				totalCount
+				edges { node { id } }
+				aggregate { count sum { priority } avg { priority } min { createdAt priority } max { createdAt priority } }
+			}
+		}`, &rsp)
+		require.Len(t, rsp.Todos.Edges, 1)
+		require.Len(t, rsp.Todos.Aggregate, 1)
+		agg := rsp.Todos.Aggregate[0]
+		require.Equal(t, 4, agg.Count)
+		require.Equal(t, 12, *agg.Sum.Priority)
+		require.Equal(t, 3.0, *agg.Avg.Priority)
+		require.Equal(t, 1, *agg.Min.Priority)
+		require.Equal(t, 6, *agg.Max.Priority)
+		require.Equal(t, now.Add(-time.Hour).Format(time.RFC3339), *agg.Min.CreatedAt)
+		require.Equal(t, now.Add(2*time.Hour).Format(time.RFC3339), *agg.Max.CreatedAt)
+		require.Nil(t, agg.Status)
+	})
+
+	t.Run("GroupBy", func(t *testing.T) {
+		var rsp struct {
+			Todos struct {
+				Aggregate []aggregate
+			}
+		}
+		gqlc.MustPost(`query {
+			todos(where: {priorityGT: 1}) {
+				aggregate(groupBy: [STATUS]) { count status sum { priority } }
+			}
+		}`, &rsp)
+		require.Len(t, rsp.Todos.Aggregate, 2)
+		sums := make(map[todo.Status][2]int)
+		for _, agg := range rsp.Todos.Aggregate {
+			require.NotNil(t, agg.Status)
+			sums[*agg.Status] = [2]int{agg.Count, *agg.Sum.Priority}
+		}
+		require.Equal(t, map[todo.Status][2]int{
+			todo.StatusInProgress: {1, 2},
+			todo.StatusCompleted:  {2, 9},
+		}, sums)
+	})
+
+	t.Run("Edge", func(t *testing.T) {
+		var rsp struct {
+			Node struct {
+				Todos struct {
+					Aggregate []aggregate
+				}
+			}
+		}
+		gqlc.MustPost(`query($id: ID!) {
+			node(id: $id) {
+				... on Category {
+					todos { aggregate { count max { priority } } }
+				}
+			}
+		}`, &rsp, client.Var("id", cat.ID))
+		require.Len(t, rsp.Node.Todos.Aggregate, 1)
+		require.Equal(t, 2, rsp.Node.Todos.Aggregate[0].Count)
+		require.Equal(t, 2, *rsp.Node.Todos.Aggregate[0].Max.Priority)
+	})
+}
+
+func TestWhereOps(t *testing.T) {
+	ctx := context.Background()
+	ec := enttest.Open(
+		t, dialect.SQLite,
+		fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()),
+		enttest.WithMigrateOptions(migrate.WithGlobalUniqueID(true)),
+	)
+	ec.Todo.CreateBulk(
+		ec.Todo.Create().SetText("Buy milk").SetStatus(todo.StatusInProgress).SetInit(map[string]any{"a": map[string]any{"b": 1}, "tags": []string{"x", "y"}}),
+		ec.Todo.Create().SetText("Walk the dog").SetStatus(todo.StatusInProgress).SetInit(map[string]any{"a": map[string]any{"b": "c"}}),
+		ec.Todo.Create().SetText("buy dog food").SetStatus(todo.StatusCompleted),
+	).ExecX(ctx)
+	ec.Category.CreateBulk(
+		ec.Category.Create().SetText("c1").SetStatus(category.StatusEnabled).SetStrings([]string{"a", "b"}),
+		ec.Category.Create().SetText("c2").SetStatus(category.StatusEnabled).SetStrings([]string{"c"}),
+		ec.Category.Create().SetText("c3").SetStatus(category.StatusEnabled),
+	).ExecX(ctx)
+	gqlc := client.New(handler.NewDefaultServer(gen.NewSchema(ec)))
+
+	todos := func(t *testing.T, where map[string]any) []string {
+		var rsp struct {
+			Todos struct {
+				Edges []struct{ Node struct{ Text string } }
+			}
+		}
+		gqlc.MustPost(`query($where: TodoWhereInput) {
+			todos(where: $where, orderBy: {field: TEXT}) { edges { node { text } } }
+		}`, &rsp, client.Var("where", where))
+		texts := make([]string, 0, len(rsp.Todos.Edges))
+		for _, e := range rsp.Todos.Edges {
+			texts = append(texts, e.Node.Text)
+		}
+		return texts
+	}
+	t.Run("JSON", func(t *testing.T) {
+		require.Equal(t, []string{"Buy milk", "Walk the dog"}, todos(t, map[string]any{"initHasKey": "a.b"}))
+		require.Equal(t, []string{"Buy milk"}, todos(t, map[string]any{"initHasKey": "tags"}))
+		require.Equal(t, []string{"Buy milk"}, todos(t, map[string]any{"initValueEQ": map[string]any{"path": "a.b", "value": 1}}))
+		require.Equal(t, []string{"Walk the dog"}, todos(t, map[string]any{"initValueEQ": map[string]any{"path": "a.b", "value": "c"}}))
+		require.Equal(t, []string{"Buy milk"}, todos(t, map[string]any{"initContains": map[string]any{"path": "tags", "value": "y"}}))
+		require.Empty(t, todos(t, map[string]any{"initContains": map[string]any{"path": "tags", "value": "z"}}))
+
+		var rsp struct{}
+		err := gqlc.Post(`query { todos(where: {initHasKey: "a[b]"}) { totalCount } }`, &rsp)
+		require.ErrorContains(t, err, "invalid JSON path")
+	})
+
+	t.Run("Search", func(t *testing.T) {
+		require.Equal(t, []string{"Buy milk", "buy dog food"}, todos(t, map[string]any{"textSearch": "BUY"}))
+		require.Equal(t, []string{"Walk the dog", "buy dog food"}, todos(t, map[string]any{"textSearch": "dog"}))
+		require.Equal(t, []string{"buy dog food"}, todos(t, map[string]any{"textSearch": "dog buy"}))
+	})
+
+	t.Run("Slice", func(t *testing.T) {
+		categories := func(where map[string]any) []string {
+			var rsp struct {
+				Categories struct {
+					Edges []struct{ Node struct{ Text string } }
+				}
+			}
+			gqlc.MustPost(`query($where: CategoryWhereInput) {
+				categories(where: $where, orderBy: [{field: TEXT}]) { edges { node { text } } }
+			}`, &rsp, client.Var("where", where))
+			texts := make([]string, 0, len(rsp.Categories.Edges))
+			for _, e := range rsp.Categories.Edges {
+				texts = append(texts, e.Node.Text)
+			}
+			return texts
+		}
+		require.Equal(t, []string{"c1"}, categories(map[string]any{"stringsContains": "b"}))
+		require.Equal(t, []string{"c1", "c2"}, categories(map[string]any{"stringsContainsAny": []string{"a", "c"}}))
+		require.Empty(t, categories(map[string]any{"stringsContainsAny": []string{"d"}}))
+	})
+}
+
+func TestComplexityLimit(t *testing.T) {
+	ec := enttest.Open(
+		t, dialect.SQLite,
+		fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()),
+		enttest.WithMigrateOptions(migrate.WithGlobalUniqueID(true)),
+	)
+	newClient := func(limit, depth int) *client.Client {
+		srv := handler.NewDefaultServer(gen.NewSchema(ec))
+		srv.Use(&entgql.ComplexityLimit{Complexity: ent.Complexity(), Limit: limit, MaxDepth: depth})
+		return client.New(srv)
+	}
+	const query = `query($first: Int) {
+		todos(first: $first) { edges { node { text children(first: 5) { totalCount } } } }
+	}`
+	var rsp map[string]any
+	// The complexity of the query is 1 + 10 * (edges + node + text + 1 + 5 * totalCount).
+	require.NoError(t, newClient(91, 0).Post(query, &rsp, client.Var("first", 10)))
+	err := newClient(90, 0).Post(query, &rsp, client.Var("first", 10))
+	require.ErrorContains(t, err, "operation has complexity 91, which exceeds the limit of 90")
+	// Connections queried without first or last are estimated by entgql.DefaultListSize.
+	err = newClient(100, 0).Post(query, &rsp)
+	require.ErrorContains(t, err, fmt.Sprintf("operation has complexity %d", 1+9*entgql.DefaultListSize))
+
+	require.NoError(t, newClient(0, 5).Post(query, &rsp))
+	err = newClient(0, 4).Post(query, &rsp)
+	require.ErrorContains(t, err, "operation has depth 5, which exceeds the limit of 4")
+	err = newClient(0, 4).Post(`query {
+		todos { ...todos }
+	}
+	fragment todos on TodoConnection { edges { node { children { totalCount } } } }`, &rsp)
+	require.ErrorContains(t, err, "operation has depth 5, which exceeds the limit of 4")
+}
+
+func TestMaxPageSize(t *testing.T) {
+	ctx := context.Background()
+	ec := enttest.Open(
+		t, dialect.SQLite,
+		fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()),
+		enttest.WithMigrateOptions(migrate.WithGlobalUniqueID(true)),
+	)
+	builders := make([]*ent.CategoryCreate, 30)
+	for i := range builders {
+		builders[i] = ec.Category.Create().SetText(strconv.Itoa(i)).SetStatus(category.StatusEnabled)
+	}
+	ec.Category.CreateBulk(builders...).ExecX(ctx)
+	gqlc := client.New(handler.NewDefaultServer(gen.NewSchema(ec)))
+
+	var rsp struct {
+		Categories struct {
+			TotalCount int
+			Edges      []struct{ Node struct{ ID string } }
+			PageInfo   struct{ HasNextPage bool }
+		}
+	}
+	gqlc.MustPost(`query { categories { totalCount edges { node { id } } pageInfo { hasNextPage } } }`, &rsp)
+	require.Equal(t, 30, rsp.Categories.TotalCount)
+	require.Len(t, rsp.Categories.Edges, 25)
+	require.True(t, rsp.Categories.PageInfo.HasNextPage)
+
+	gqlc.MustPost(`query { categories(last: 25) { totalCount edges { node { id } } pageInfo { hasNextPage } } }`, &rsp)
+	require.Len(t, rsp.Categories.Edges, 25)
+
+	err := gqlc.Post(`query { categories(first: 26) { totalCount } }`, &rsp)
+	require.ErrorContains(t, err, "`first` on a connection cannot exceed 25.")
+	err = gqlc.Post(`query { categories(last: 26) { totalCount } }`, &rsp)
+	require.ErrorContains(t, err, "`last` on a connection cannot exceed 25.")
+	err = gqlc.Post(`query { categories(first: 1) { edges { node { subCategories(first: 26) { totalCount } } } } }`, &rsp)
+	require.ErrorContains(t, err, "`first` on a connection cannot exceed 25.")
+}
+
+func TestOffsetPagination(t *testing.T) {
+	ctx := context.Background()
+	ec := enttest.Open(
+		t, dialect.SQLite,
+		fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()),
+		enttest.WithMigrateOptions(migrate.WithGlobalUniqueID(true)),
+	)
+	cat := ec.Category.Create().SetText("c").SetStatus(category.StatusEnabled).SaveX(ctx)
+	builders := make([]*ent.TodoCreate, 10)
+	for i := range builders {
+		builders[i] = ec.Todo.Create().SetText(strconv.Itoa(i)).SetStatus(todo.StatusInProgress)
+		if i%2 == 0 {
+			builders[i].SetCategory(cat)
+		}
+	}
+	ec.Todo.CreateBulk(builders...).ExecX(ctx)
+	gqlc := client.New(handler.NewDefaultServer(gen.NewSchema(ec)))
+
+	type page struct {
+		Nodes      []struct{ Text string }
+		TotalCount int
+		PageInfo   struct {
+			Offset   int
+			PageSize int
+			HasNext  bool
+		}
+	}
+	texts := func(p page) []string {
+		var ts []string
+		for _, n := range p.Nodes {
+			ts = append(ts, n.Text)
+		}
+		return ts
+	}
+	var rsp struct{ TodosPage page }
+	const query = `query($offset: Int, $limit: Int) {
+		todosPage(offset: $offset, limit: $limit, orderBy: [{field: TEXT, direction: DESC}]) {
+			nodes { text }
+			totalCount
+			pageInfo { offset pageSize hasNext }
+		}
+	}`
+	gqlc.MustPost(query, &rsp, client.Var("offset", 0), client.Var("limit", 4))
+	require.Equal(t, []string{"9", "8", "7", "6"}, texts(rsp.TodosPage))
+	require.Equal(t, 10, rsp.TodosPage.TotalCount)
+	require.Zero(t, rsp.TodosPage.PageInfo.Offset)
+	require.Equal(t, 4, rsp.TodosPage.PageInfo.PageSize)
+	require.True(t, rsp.TodosPage.PageInfo.HasNext)
+
+	gqlc.MustPost(query, &rsp, client.Var("offset", 8), client.Var("limit", 4))
+	require.Equal(t, []string{"1", "0"}, texts(rsp.TodosPage))
+	require.Equal(t, 8, rsp.TodosPage.PageInfo.Offset)
+	require.False(t, rsp.TodosPage.PageInfo.HasNext)
+
+	gqlc.MustPost(query, &rsp)
+	require.Len(t, rsp.TodosPage.Nodes, 10)
+	require.Zero(t, rsp.TodosPage.PageInfo.Offset)
+	require.Equal(t, 10, rsp.TodosPage.PageInfo.PageSize)
+	require.False(t, rsp.TodosPage.PageInfo.HasNext)
+
+	var catRsp struct {
+		Node struct{ TodosPage page }
+	}
+	gqlc.MustPost(`query($id: ID!) {
+		node(id: $id) {
+			... on Category {
+				todosPage(offset: 1, limit: 2, orderBy: [{field: TEXT}], where: {textNEQ: "8"}) {
+					nodes { text }
+					totalCount
+					pageInfo { offset pageSize hasNext }
+				}
+			}
+		}
+	}`, &catRsp, client.Var("id", cat.ID))
+	require.Equal(t, []string{"2", "4"}, texts(catRsp.Node.TodosPage))
+	require.Equal(t, 4, catRsp.Node.TodosPage.TotalCount)
+	require.Equal(t, 1, catRsp.Node.TodosPage.PageInfo.Offset)
+	require.True(t, catRsp.Node.TodosPage.PageInfo.HasNext)
+
+	err := gqlc.Post(query, &rsp, client.Var("offset", -1))
+	require.ErrorContains(t, err, "`offset` on a page cannot be less than zero.")
+	err = gqlc.Post(query, &rsp, client.Var("limit", -1))
+	require.ErrorContains(t, err, "`limit` on a page cannot be less than zero.")
+}
+
+func TestAuthorize(t *testing.T) {
+	ctx := context.Background()
+	drv, err := sql.Open(dialect.SQLite, fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	require.NoError(t, err)
+	count := &queryCount{Driver: drv}
+	ec := enttest.NewClient(t,
+		enttest.WithOptions(ent.Driver(count)),
+		enttest.WithMigrateOptions(migrate.WithGlobalUniqueID(true)),
+	)
+	user := ec.User.Create().SetRequiredMetadata(map[string]any{}).SetMetadata(map[string]any{"a": "b"}).SaveX(ctx)
+	ec.User.Create().SetRequiredMetadata(map[string]any{}).AddFriends(user).ExecX(ctx)
+	srv := handler.NewDefaultServer(gen.NewSchema(ec))
+	srv.Use(&entgql.Authorizer{Policy: gen.Authorize})
+	gqlc := client.New(srv)
+	withRoles := func(roles ...string) client.Option {
+		return func(r *client.Request) {
+			r.HTTP = r.HTTP.WithContext(gen.WithRoles(r.HTTP.Context(), roles...))
+		}
+	}
+
+	var rsp struct {
+		Node struct {
+			Metadata map[string]any
+		}
+	}
+	const query = `query($id: ID!) { node(id: $id) { ... on User { metadata } } }`
+	err = gqlc.Post(query, &rsp, client.Var("id", user.ID))
+	require.ErrorContains(t, err, `viewer is missing one of the roles [\"ADMIN\"]`)
+	require.Nil(t, rsp.Node.Metadata)
+	gqlc.MustPost(query, &rsp, client.Var("id", user.ID), withRoles("ADMIN"))
+	require.Equal(t, map[string]any{"a": "b"}, rsp.Node.Metadata)
+
+	const friendships = `query { users { edges { node { friendships { totalCount } } } } }`
+	count.reset()
+	err = gqlc.Post(friendships, &rsp, withRoles("USER"))
+	require.ErrorContains(t, err, `viewer is missing one of the roles [\"ADMIN\"]`)
+	// Denied edges are not loaded by CollectFields.
+	require.EqualValues(t, 1, count.value())
+
+	var users struct {
+		Users struct {
+			Edges []struct {
+				Node struct {
+					Friendships struct{ TotalCount int }
+				}
+			}
+		}
+	}
+	count.reset()
+	gqlc.MustPost(friendships, &users, withRoles("ADMIN"))
+	require.EqualValues(t, 2, count.value())
+	require.Len(t, users.Users.Edges, 2)
+	for _, e := range users.Users.Edges {
+		require.Equal(t, 1, e.Node.Friendships.TotalCount)
+	}
+}
+
+func TestDataLoader(t *testing.T) {
+	ctx := context.Background()
+	drv, err := sql.Open(dialect.SQLite, fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()))
+	require.NoError(t, err)
+	count := &queryCount{Driver: drv}
+	ec := enttest.NewClient(t,
+		enttest.WithOptions(ent.Driver(count)),
+		enttest.WithMigrateOptions(migrate.WithGlobalUniqueID(true)),
+	)
+	cats := ec.Category.CreateBulk(
+		ec.Category.Create().SetText("c1").SetStatus(category.StatusEnabled),
+		ec.Category.Create().SetText("c2").SetStatus(category.StatusEnabled),
+	).SaveX(ctx)
+	for i := 0; i < 4; i++ {
+		root := ec.Todo.Create().SetText(fmt.Sprintf("t%d", i)).SetStatus(todo.StatusInProgress).SetPriority(10 + i).SetCategory(cats[i%2]).SaveX(ctx)
+		for j := 0; j < 3; j++ {
+			ec.Todo.Create().SetText(fmt.Sprintf("t%d.%d", i, j)).SetStatus(todo.StatusCompleted).SetParent(root).ExecX(ctx)
+		}
+	}
+
+	const query = `query {
+		topTodos(limit: 4) {
+			text
+			category { text }
+			children(first: 2) { totalCount edges { node { text } } }
+		}
+	}`
+	type response struct {
+		TopTodos []struct {
+			Text     string
+			Category *struct{ Text string }
+			Children struct {
+				TotalCount int
+				Edges      []struct {
+					Node struct{ Text string }
+				}
+			}
+		}
+	}
+	var (
+		rsp, batched response
+		srv          = handler.NewDefaultServer(gen.NewSchema(ec))
+	)
+	count.reset()
+	client.New(srv).MustPost(query, &rsp)
+	// 1 query for the todos, and 1 per edge for each of the 4 todos.
+	require.EqualValues(t, 1+4*3, count.value())
+
+	srv = handler.NewDefaultServer(gen.NewSchema(ec))
+	srv.Use(entgql.DataLoader{Wait: 100 * time.Millisecond})
+	count.reset()
+	client.New(srv).MustPost(query, &batched)
+	// 1 query for the todos, and 1 batch per edge.
+	require.EqualValues(t, 1+2+3, count.value())
+	require.Equal(t, rsp, batched)
+	require.Len(t, batched.TopTodos, 4)
+	for i, td := range batched.TopTodos {
+		require.Equal(t, fmt.Sprintf("t%d", 3-i), td.Text)
+		require.Equal(t, fmt.Sprintf("c%d", (3-i)%2+1), td.Category.Text)
+		require.Equal(t, 3, td.Children.TotalCount)
+		require.Len(t, td.Children.Edges, 2)
+	}
+}
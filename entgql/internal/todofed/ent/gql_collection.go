@@ -225,11 +225,6 @@ func (tq *TodoQuery) collectField(ctx context.Context, oneNode bool, opCtx *grap
 				selectedFields = append(selectedFields, todo.FieldText)
 				fieldSeen[todo.FieldText] = struct{}{}
 			}
-		case "blob":
-			if _, ok := fieldSeen[todo.FieldBlob]; !ok {
-				selectedFields = append(selectedFields, todo.FieldBlob)
-				fieldSeen[todo.FieldBlob] = struct{}{}
-			}
 		case "id":
 		case "__typename":
 		default:
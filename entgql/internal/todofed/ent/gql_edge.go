@@ -19,12 +19,67 @@ package ent
 import (
 	"context"
 
+	"entgo.io/contrib/entgql"
+	"entgo.io/contrib/entgql/internal/todofed/ent/category"
+	"entgo.io/contrib/entgql/internal/todofed/ent/todo"
 	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
 )
 
+// edgeLoaderKey is the key of the batch loader of the edges that resolve
+// the same GraphQL field of the nodes that are stored in the same table.
+type edgeLoaderKey struct {
+	table string
+	field *ast.Field
+}
+
+// batchLoad returns a copy of the node, with the edge of the resolved field loaded
+// by a batch loader with the edges of the other nodes that resolve the same field.
+// The node itself is returned in case the context does not hold batch loaders.
+func (c *Category) batchLoad(ctx context.Context) (*Category, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return c, nil
+	}
+	key := edgeLoaderKey{table: category.Table, field: fc.Field.Field}
+	l := entgql.BatchLoader(ctx, key, func(ctx context.Context, ids []int) (map[int]*Category, error) {
+		query := (&CategoryClient{config: c.config}).Query().
+			Where(category.IDIn(ids...))
+		parent := graphql.CollectedField{Field: &ast.Field{}, Selections: ast.SelectionSet{fc.Field.Field}}
+		ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{Field: parent})
+		if err := query.collectField(ctx, false, graphql.GetOperationContext(ctx), parent, nil, categoryImplementors...); err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		loaded := make(map[int]*Category, len(nodes))
+		for _, node := range nodes {
+			loaded[node.ID] = node
+		}
+		return loaded, nil
+	})
+	if l == nil {
+		return c, nil
+	}
+	node, err := l.Load(ctx, c.ID)
+	if node == nil && err == nil {
+		return c, nil
+	}
+	return node, err
+}
+
 func (c *Category) Todos(ctx context.Context) (result []*Todo, err error) {
 	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
 		result, err = c.NamedTodos(graphql.GetFieldContext(ctx).Field.Alias)
+		if IsNotLoaded(err) {
+			var node *Category
+			if node, err = c.batchLoad(ctx); err != nil {
+				return nil, err
+			}
+			result, err = node.NamedTodos(fc.Field.Alias)
+		}
 	} else {
 		result, err = c.Edges.TodosOrErr()
 	}
@@ -34,8 +89,52 @@ func (c *Category) Todos(ctx context.Context) (result []*Todo, err error) {
 	return result, err
 }
 
+// batchLoad returns a copy of the node, with the edge of the resolved field loaded
+// by a batch loader with the edges of the other nodes that resolve the same field.
+// The node itself is returned in case the context does not hold batch loaders.
+func (t *Todo) batchLoad(ctx context.Context) (*Todo, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return t, nil
+	}
+	key := edgeLoaderKey{table: todo.Table, field: fc.Field.Field}
+	l := entgql.BatchLoader(ctx, key, func(ctx context.Context, ids []int) (map[int]*Todo, error) {
+		query := (&TodoClient{config: t.config}).Query().
+			Where(todo.IDIn(ids...))
+		parent := graphql.CollectedField{Field: &ast.Field{}, Selections: ast.SelectionSet{fc.Field.Field}}
+		ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{Field: parent})
+		if err := query.collectField(ctx, false, graphql.GetOperationContext(ctx), parent, nil, todoImplementors...); err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		loaded := make(map[int]*Todo, len(nodes))
+		for _, node := range nodes {
+			loaded[node.ID] = node
+		}
+		return loaded, nil
+	})
+	if l == nil {
+		return t, nil
+	}
+	node, err := l.Load(ctx, t.ID)
+	if node == nil && err == nil {
+		return t, nil
+	}
+	return node, err
+}
+
 func (t *Todo) Parent(ctx context.Context) (*Todo, error) {
 	result, err := t.Edges.ParentOrErr()
+	if IsNotLoaded(err) {
+		var node *Todo
+		if node, err = t.batchLoad(ctx); err != nil {
+			return nil, err
+		}
+		result, err = node.Edges.ParentOrErr()
+	}
 	if IsNotLoaded(err) {
 		result, err = t.QueryParent().Only(ctx)
 	}
@@ -45,6 +144,13 @@ func (t *Todo) Parent(ctx context.Context) (*Todo, error) {
 func (t *Todo) Children(ctx context.Context) (result []*Todo, err error) {
 	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
 		result, err = t.NamedChildren(graphql.GetFieldContext(ctx).Field.Alias)
+		if IsNotLoaded(err) {
+			var node *Todo
+			if node, err = t.batchLoad(ctx); err != nil {
+				return nil, err
+			}
+			result, err = node.NamedChildren(fc.Field.Alias)
+		}
 	} else {
 		result, err = t.Edges.ChildrenOrErr()
 	}
@@ -56,6 +162,13 @@ func (t *Todo) Children(ctx context.Context) (result []*Todo, err error) {
 
 func (t *Todo) Category(ctx context.Context) (*Category, error) {
 	result, err := t.Edges.CategoryOrErr()
+	if IsNotLoaded(err) {
+		var node *Todo
+		if node, err = t.batchLoad(ctx); err != nil {
+			return nil, err
+		}
+		result, err = node.Edges.CategoryOrErr()
+	}
 	if IsNotLoaded(err) {
 		result, err = t.QueryCategory().Only(ctx)
 	}
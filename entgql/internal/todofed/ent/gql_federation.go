@@ -0,0 +1,194 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/contrib/entgql/internal/todofed/ent/category"
+	"entgo.io/contrib/entgql/internal/todofed/ent/predicate"
+	"entgo.io/contrib/entgql/internal/todofed/ent/todo"
+)
+
+// EntityResolver implements the batched resolvers of the Apollo Federation entities
+// generated by entgql. The gqlgen entity resolvers can delegate to its methods, or
+// replace them with custom implementations.
+type EntityResolver struct {
+	// Client is used in case the context does not hold a client,
+	// for example, a transactional client set by entgql.Transactioner.
+	Client *Client
+}
+
+// client returns the client to resolve the entities with.
+func (r *EntityResolver) client(ctx context.Context) *Client {
+	if c := FromContext(ctx); c != nil {
+		return c
+	}
+	return r.Client
+}
+
+// CategoryByIDsInput holds the representations of Category entities by their "id" key.
+type CategoryByIDsInput struct {
+	ID int `json:"id"`
+}
+
+// FindManyCategoryByIDs returns the Category entities of the given representations in
+// their order. Representations that do not match any entity are resolved to nil.
+func (r *EntityResolver) FindManyCategoryByIDs(ctx context.Context, reps []*CategoryByIDsInput) ([]*Category, error) {
+	if len(reps) == 0 {
+		return nil, nil
+	}
+	ids := make([]int, len(reps))
+	for i, rep := range reps {
+		ids[i] = rep.ID
+	}
+	noders, err := r.client(ctx).noders(ctx, category.Table, ids)
+	if err != nil {
+		return nil, err
+	}
+	nodes := make([]*Category, len(noders))
+	for i, noder := range noders {
+		if noder != nil {
+			nodes[i] = noder.(*Category)
+		}
+	}
+	return nodes, nil
+}
+
+// CategoryByTextAndStatussInput holds the representations of Category entities by their "text status" key.
+type CategoryByTextAndStatussInput struct {
+	Text   string          `json:"text"`
+	Status category.Status `json:"status"`
+}
+
+// FindManyCategoryByTextAndStatuss returns the Category entities of the given representations in
+// their order. Representations that do not match any entity are resolved to nil.
+func (r *EntityResolver) FindManyCategoryByTextAndStatuss(ctx context.Context, reps []*CategoryByTextAndStatussInput) ([]*Category, error) {
+	if len(reps) == 0 {
+		return nil, nil
+	}
+	preds := make([]predicate.Category, len(reps))
+	for i, rep := range reps {
+		preds[i] = category.And(
+			category.TextEQ(rep.Text),
+			category.StatusEQ(rep.Status),
+		)
+	}
+	query := r.client(ctx).Category.Query().
+		Where(category.Or(preds...))
+	query, err := query.CollectFields(ctx, categoryImplementors...)
+	if err != nil {
+		return nil, err
+	}
+	// Key fields are required for mapping the nodes to their representations.
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(category.FieldText)
+		query.ctx.AppendFieldOnce(category.FieldStatus)
+	}
+	nodes, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	idx := make(map[CategoryByTextAndStatussInput][]int, len(reps))
+	for i, rep := range reps {
+		idx[*rep] = append(idx[*rep], i)
+	}
+	entities := make([]*Category, len(reps))
+	for _, node := range nodes {
+		key := CategoryByTextAndStatussInput{
+			Text:   node.Text,
+			Status: node.Status,
+		}
+		for _, i := range idx[key] {
+			entities[i] = node
+		}
+	}
+	return entities, nil
+}
+
+// CategoryByTextsInput holds the representations of Category entities by their "text" key.
+type CategoryByTextsInput struct {
+	Text string `json:"text"`
+}
+
+// FindManyCategoryByTexts returns the Category entities of the given representations in
+// their order. Representations that do not match any entity are resolved to nil.
+func (r *EntityResolver) FindManyCategoryByTexts(ctx context.Context, reps []*CategoryByTextsInput) ([]*Category, error) {
+	if len(reps) == 0 {
+		return nil, nil
+	}
+	values := make([]string, len(reps))
+	for i, rep := range reps {
+		values[i] = rep.Text
+	}
+	query := r.client(ctx).Category.Query().
+		Where(category.TextIn(values...))
+	query, err := query.CollectFields(ctx, categoryImplementors...)
+	if err != nil {
+		return nil, err
+	}
+	// Key fields are required for mapping the nodes to their representations.
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(category.FieldText)
+	}
+	nodes, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	idx := make(map[CategoryByTextsInput][]int, len(reps))
+	for i, rep := range reps {
+		idx[*rep] = append(idx[*rep], i)
+	}
+	entities := make([]*Category, len(reps))
+	for _, node := range nodes {
+		key := CategoryByTextsInput{
+			Text: node.Text,
+		}
+		for _, i := range idx[key] {
+			entities[i] = node
+		}
+	}
+	return entities, nil
+}
+
+// TodoByIDsInput holds the representations of Todo entities by their "id" key.
+type TodoByIDsInput struct {
+	ID int `json:"id"`
+}
+
+// FindManyTodoByIDs returns the Todo entities of the given representations in
+// their order. Representations that do not match any entity are resolved to nil.
+func (r *EntityResolver) FindManyTodoByIDs(ctx context.Context, reps []*TodoByIDsInput) ([]*Todo, error) {
+	if len(reps) == 0 {
+		return nil, nil
+	}
+	ids := make([]int, len(reps))
+	for i, rep := range reps {
+		ids[i] = rep.ID
+	}
+	noders, err := r.client(ctx).noders(ctx, todo.Table, ids)
+	if err != nil {
+		return nil, err
+	}
+	nodes := make([]*Todo, len(noders))
+	for i, noder := range noders {
+		if noder != nil {
+			nodes[i] = noder.(*Todo)
+		}
+	}
+	return nodes, nil
+}
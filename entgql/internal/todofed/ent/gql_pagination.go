@@ -69,6 +69,30 @@ func validateFirstLast(first, last *int) (err *gqlerror.Error) {
 	return err
 }
 
+// validateMaxPageSize validates that first and last do not exceed the maximum
+// page size of a connection, and returns the first argument to paginate with,
+// which defaults to the maximum page size in case both were not set.
+func validateMaxPageSize(first, last *int, size int) (*int, error) {
+	if first != nil && *first > size {
+		err := &gqlerror.Error{
+			Message: fmt.Sprintf("`first` on a connection cannot exceed %d.", size),
+		}
+		errcode.Set(err, errInvalidPagination)
+		return nil, err
+	}
+	if last != nil && *last > size {
+		err := &gqlerror.Error{
+			Message: fmt.Sprintf("`last` on a connection cannot exceed %d.", size),
+		}
+		errcode.Set(err, errInvalidPagination)
+		return nil, err
+	}
+	if first == nil && last == nil {
+		first = &size
+	}
+	return first, nil
+}
+
 func collectedField(ctx context.Context, path ...string) *graphql.CollectedField {
 	fc := graphql.GetFieldContext(ctx)
 	if fc == nil {
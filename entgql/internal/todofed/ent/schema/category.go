@@ -20,6 +20,7 @@ import (
 	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 
@@ -36,6 +37,7 @@ func (Category) Fields() []ent.Field {
 	return []ent.Field{
 		field.Text("text").
 			NotEmpty().
+			Unique().
 			Annotations(
 				entgql.OrderField("TEXT"),
 			),
@@ -72,3 +74,12 @@ func (Category) Edges() []ent.Edge {
 		edge.To("todos", Todo.Type),
 	}
 }
+
+// Annotations of the Category.
+func (Category) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.FederationKey("id"),
+		entgql.FederationKey("text", "status"),
+		entgql.FederationKey("text"),
+	}
+}
@@ -19,6 +19,7 @@ import (
 
 	"entgo.io/contrib/entgql"
 	"entgo.io/ent"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 )
@@ -56,7 +57,10 @@ func (Todo) Fields() []ent.Field {
 				entgql.OrderField("TEXT"),
 			),
 		field.Bytes("blob").
-			Optional(),
+			Optional().
+			Annotations(
+				entgql.Skip(),
+			),
 	}
 }
 
@@ -77,3 +81,12 @@ func (Todo) Edges() []ent.Edge {
 			Unique(),
 	}
 }
+
+// Annotations returns todo annotations.
+func (Todo) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.RelayConnection(),
+		entgql.QueryField(),
+		entgql.FederationKey("id"),
+	}
+}
@@ -24,10 +24,24 @@ import (
 	"entgo.io/contrib/entgql/internal/todofed/ent"
 )
 
-// FindTodoByID is the resolver for the findTodoByID field.
-func (r *entityResolver) FindTodoByID(ctx context.Context, id int) (*ent.Todo, error) {
-	todo, err := r.client.Todo.Get(ctx, id)
-	return todo, ent.MaskNotFound(err)
+// FindManyCategoryByIDs is the resolver for the findManyCategoryByIDs field.
+func (r *entityResolver) FindManyCategoryByIDs(ctx context.Context, reps []*ent.CategoryByIDsInput) ([]*ent.Category, error) {
+	return (&ent.EntityResolver{Client: r.client}).FindManyCategoryByIDs(ctx, reps)
+}
+
+// FindManyCategoryByTextAndStatuss is the resolver for the findManyCategoryByTextAndStatuss field.
+func (r *entityResolver) FindManyCategoryByTextAndStatuss(ctx context.Context, reps []*ent.CategoryByTextAndStatussInput) ([]*ent.Category, error) {
+	return (&ent.EntityResolver{Client: r.client}).FindManyCategoryByTextAndStatuss(ctx, reps)
+}
+
+// FindManyCategoryByTexts is the resolver for the findManyCategoryByTexts field.
+func (r *entityResolver) FindManyCategoryByTexts(ctx context.Context, reps []*ent.CategoryByTextsInput) ([]*ent.Category, error) {
+	return (&ent.EntityResolver{Client: r.client}).FindManyCategoryByTexts(ctx, reps)
+}
+
+// FindManyTodoByIDs is the resolver for the findManyTodoByIDs field.
+func (r *entityResolver) FindManyTodoByIDs(ctx context.Context, reps []*ent.TodoByIDsInput) ([]*ent.Todo, error) {
+	return (&ent.EntityResolver{Client: r.client}).FindManyTodoByIDs(ctx, reps)
 }
 
 // Entity returns EntityResolver implementation.
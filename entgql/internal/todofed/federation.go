@@ -9,6 +9,7 @@ import (
 	"strings"
 	"sync"
 
+	"entgo.io/contrib/entgql/internal/todofed/ent"
 	"github.com/99designs/gqlgen/plugin/federation/fedruntime"
 )
 
@@ -134,6 +135,10 @@ func (ec *executionContext) resolveEntityGroup(
 
 func isMulti(typeName string) bool {
 	switch typeName {
+	case "Category":
+		return true
+	case "Todo":
+		return true
 	default:
 		return false
 	}
@@ -153,25 +158,6 @@ func (ec *executionContext) resolveEntity(
 	}()
 
 	switch typeName {
-	case "Todo":
-		resolverName, err := entityResolverNameForTodo(ctx, rep)
-		if err != nil {
-			return nil, fmt.Errorf(`finding resolver for Entity "Todo": %w`, err)
-		}
-		switch resolverName {
-
-		case "findTodoByID":
-			id0, err := ec.unmarshalNID2int(ctx, rep["id"])
-			if err != nil {
-				return nil, fmt.Errorf(`unmarshalling param 0 for findTodoByID(): %w`, err)
-			}
-			entity, err := ec.resolvers.Entity().FindTodoByID(ctx, id0)
-			if err != nil {
-				return nil, fmt.Errorf(`resolving Entity "Todo": %w`, err)
-			}
-
-			return entity, nil
-		}
 
 	}
 	return nil, fmt.Errorf("%w: %s", ErrUnknownType, typeName)
@@ -193,11 +179,233 @@ func (ec *executionContext) resolveManyEntities(
 
 	switch typeName {
 
+	case "Category":
+		resolverName, err := entityResolverNameForCategory(ctx, reps[0].entity)
+		if err != nil {
+			return fmt.Errorf(`finding resolver for Entity "Category": %w`, err)
+		}
+		switch resolverName {
+
+		case "findManyCategoryByIDs":
+			typedReps := make([]*ent.CategoryByIDsInput, len(reps))
+
+			for i, rep := range reps {
+				id0, err := ec.unmarshalNID2int(ctx, rep.entity["id"])
+				if err != nil {
+					return errors.New(fmt.Sprintf("Field %s undefined in schema.", "id"))
+				}
+
+				typedReps[i] = &ent.CategoryByIDsInput{
+					ID: id0,
+				}
+			}
+
+			entities, err := ec.resolvers.Entity().FindManyCategoryByIDs(ctx, typedReps)
+			if err != nil {
+				return err
+			}
+
+			for i, entity := range entities {
+				list[reps[i].index] = entity
+			}
+			return nil
+
+		case "findManyCategoryByTextAndStatuss":
+			typedReps := make([]*ent.CategoryByTextAndStatussInput, len(reps))
+
+			for i, rep := range reps {
+				id0, err := ec.unmarshalNString2string(ctx, rep.entity["text"])
+				if err != nil {
+					return errors.New(fmt.Sprintf("Field %s undefined in schema.", "text"))
+				}
+				id1, err := ec.unmarshalNCategoryStatus2entgoᚗioᚋcontribᚋentgqlᚋinternalᚋtodofedᚋentᚋcategoryᚐStatus(ctx, rep.entity["status"])
+				if err != nil {
+					return errors.New(fmt.Sprintf("Field %s undefined in schema.", "status"))
+				}
+
+				typedReps[i] = &ent.CategoryByTextAndStatussInput{
+					Text:   id0,
+					Status: id1,
+				}
+			}
+
+			entities, err := ec.resolvers.Entity().FindManyCategoryByTextAndStatuss(ctx, typedReps)
+			if err != nil {
+				return err
+			}
+
+			for i, entity := range entities {
+				list[reps[i].index] = entity
+			}
+			return nil
+
+		case "findManyCategoryByTexts":
+			typedReps := make([]*ent.CategoryByTextsInput, len(reps))
+
+			for i, rep := range reps {
+				id0, err := ec.unmarshalNString2string(ctx, rep.entity["text"])
+				if err != nil {
+					return errors.New(fmt.Sprintf("Field %s undefined in schema.", "text"))
+				}
+
+				typedReps[i] = &ent.CategoryByTextsInput{
+					Text: id0,
+				}
+			}
+
+			entities, err := ec.resolvers.Entity().FindManyCategoryByTexts(ctx, typedReps)
+			if err != nil {
+				return err
+			}
+
+			for i, entity := range entities {
+				list[reps[i].index] = entity
+			}
+			return nil
+
+		default:
+			return fmt.Errorf("unknown resolver: %s", resolverName)
+		}
+
+	case "Todo":
+		resolverName, err := entityResolverNameForTodo(ctx, reps[0].entity)
+		if err != nil {
+			return fmt.Errorf(`finding resolver for Entity "Todo": %w`, err)
+		}
+		switch resolverName {
+
+		case "findManyTodoByIDs":
+			typedReps := make([]*ent.TodoByIDsInput, len(reps))
+
+			for i, rep := range reps {
+				id0, err := ec.unmarshalNID2int(ctx, rep.entity["id"])
+				if err != nil {
+					return errors.New(fmt.Sprintf("Field %s undefined in schema.", "id"))
+				}
+
+				typedReps[i] = &ent.TodoByIDsInput{
+					ID: id0,
+				}
+			}
+
+			entities, err := ec.resolvers.Entity().FindManyTodoByIDs(ctx, typedReps)
+			if err != nil {
+				return err
+			}
+
+			for i, entity := range entities {
+				list[reps[i].index] = entity
+			}
+			return nil
+
+		default:
+			return fmt.Errorf("unknown resolver: %s", resolverName)
+		}
+
 	default:
 		return errors.New("unknown type: " + typeName)
 	}
 }
 
+func entityResolverNameForCategory(ctx context.Context, rep EntityRepresentation) (string, error) {
+	// we collect errors because a later entity resolver may work fine
+	// when an entity has multiple keys
+	entityResolverErrs := []error{}
+	for {
+		var (
+			m   EntityRepresentation
+			val any
+			ok  bool
+		)
+		_ = val
+		// if all of the KeyFields values for this resolver are null,
+		// we shouldn't use use it
+		allNull := true
+		m = rep
+		val, ok = m["id"]
+		if !ok {
+			entityResolverErrs = append(entityResolverErrs,
+				fmt.Errorf("%w due to missing Key Field \"id\" for Category", ErrTypeNotFound))
+			break
+		}
+		if allNull {
+			allNull = val == nil
+		}
+		if allNull {
+			entityResolverErrs = append(entityResolverErrs,
+				fmt.Errorf("%w due to all null value KeyFields for Category", ErrTypeNotFound))
+			break
+		}
+		return "findManyCategoryByIDs", nil
+	}
+	for {
+		var (
+			m   EntityRepresentation
+			val any
+			ok  bool
+		)
+		_ = val
+		// if all of the KeyFields values for this resolver are null,
+		// we shouldn't use use it
+		allNull := true
+		m = rep
+		val, ok = m["text"]
+		if !ok {
+			entityResolverErrs = append(entityResolverErrs,
+				fmt.Errorf("%w due to missing Key Field \"text\" for Category", ErrTypeNotFound))
+			break
+		}
+		if allNull {
+			allNull = val == nil
+		}
+		m = rep
+		val, ok = m["status"]
+		if !ok {
+			entityResolverErrs = append(entityResolverErrs,
+				fmt.Errorf("%w due to missing Key Field \"status\" for Category", ErrTypeNotFound))
+			break
+		}
+		if allNull {
+			allNull = val == nil
+		}
+		if allNull {
+			entityResolverErrs = append(entityResolverErrs,
+				fmt.Errorf("%w due to all null value KeyFields for Category", ErrTypeNotFound))
+			break
+		}
+		return "findManyCategoryByTextAndStatuss", nil
+	}
+	for {
+		var (
+			m   EntityRepresentation
+			val any
+			ok  bool
+		)
+		_ = val
+		// if all of the KeyFields values for this resolver are null,
+		// we shouldn't use use it
+		allNull := true
+		m = rep
+		val, ok = m["text"]
+		if !ok {
+			entityResolverErrs = append(entityResolverErrs,
+				fmt.Errorf("%w due to missing Key Field \"text\" for Category", ErrTypeNotFound))
+			break
+		}
+		if allNull {
+			allNull = val == nil
+		}
+		if allNull {
+			entityResolverErrs = append(entityResolverErrs,
+				fmt.Errorf("%w due to all null value KeyFields for Category", ErrTypeNotFound))
+			break
+		}
+		return "findManyCategoryByTexts", nil
+	}
+	return "", fmt.Errorf("%w for Category due to %v", ErrTypeNotFound,
+		errors.Join(entityResolverErrs...).Error())
+}
+
 func entityResolverNameForTodo(ctx context.Context, rep EntityRepresentation) (string, error) {
 	// we collect errors because a later entity resolver may work fine
 	// when an entity has multiple keys
@@ -227,7 +435,7 @@ func entityResolverNameForTodo(ctx context.Context, rep EntityRepresentation) (s
 				fmt.Errorf("%w due to all null value KeyFields for Todo", ErrTypeNotFound))
 			break
 		}
-		return "findTodoByID", nil
+		return "findManyTodoByIDs", nil
 	}
 	return "", fmt.Errorf("%w for Todo due to %v", ErrTypeNotFound,
 		errors.Join(entityResolverErrs...).Error())
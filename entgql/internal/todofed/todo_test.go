@@ -799,3 +799,60 @@ func (s *todoTestSuite) TestQueryJSONFields() {
 	s.Require().Equal(cat.Text, rsp.Node.Text)
 	s.Require().Equal(cat.Strings, rsp.Node.Strings)
 }
+
+func (s *todoTestSuite) TestEntities() {
+	var (
+		ctx = context.Background()
+		c1  = s.ent.Category.Create().SetText("c1").SetStatus(category.StatusEnabled).SaveX(ctx)
+		c2  = s.ent.Category.Create().SetText("c2").SetStatus(category.StatusDisabled).SaveX(ctx)
+		rsp struct {
+			Entities []*struct {
+				Typename string `json:"__typename"`
+				ID       string
+				Text     string
+			} `json:"_entities"`
+		}
+		query = `query($reps: [_Any!]!) {
+			_entities(representations: $reps) {
+				__typename
+				... on Todo { id text }
+				... on Category { id text }
+			}
+		}`
+	)
+	// Representations are resolved in their order, and missing entities are resolved to null.
+	err := s.Post(query, &rsp, client.Var("reps", []map[string]any{
+		{"__typename": "Todo", "id": strconv.Itoa(idOffset + 2)},
+		{"__typename": "Category", "id": c2.ID},
+		{"__typename": "Todo", "id": strconv.Itoa(idOffset + 1)},
+		{"__typename": "Todo", "id": 1},
+	}))
+	s.Require().NoError(err)
+	s.Require().Len(rsp.Entities, 4)
+	s.Require().Equal("Todo", rsp.Entities[0].Typename)
+	s.Require().Equal(strconv.Itoa(idOffset+2), rsp.Entities[0].Text)
+	s.Require().Equal("Category", rsp.Entities[1].Typename)
+	s.Require().Equal(c2.Text, rsp.Entities[1].Text)
+	s.Require().Equal(strconv.Itoa(idOffset+1), rsp.Entities[2].Text)
+	s.Require().Nil(rsp.Entities[3])
+
+	err = s.Post(query, &rsp, client.Var("reps", []map[string]any{
+		{"__typename": "Category", "text": "c2"},
+		{"__typename": "Category", "text": "c3"},
+		{"__typename": "Category", "text": "c1"},
+	}))
+	s.Require().NoError(err)
+	s.Require().Len(rsp.Entities, 3)
+	s.Require().Equal(strconv.Itoa(c2.ID), rsp.Entities[0].ID)
+	s.Require().Nil(rsp.Entities[1])
+	s.Require().Equal(strconv.Itoa(c1.ID), rsp.Entities[2].ID)
+
+	err = s.Post(query, &rsp, client.Var("reps", []map[string]any{
+		{"__typename": "Category", "text": "c1", "status": "DISABLED"},
+		{"__typename": "Category", "text": "c1", "status": "ENABLED"},
+	}))
+	s.Require().NoError(err)
+	s.Require().Len(rsp.Entities, 2)
+	s.Require().Nil(rsp.Entities[0])
+	s.Require().Equal(strconv.Itoa(c1.ID), rsp.Entities[1].ID)
+}
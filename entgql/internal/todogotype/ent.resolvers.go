@@ -73,6 +73,11 @@ func (r *queryResolver) Todos(ctx context.Context, after *entgql.Cursor[string],
 	panic(fmt.Errorf("not implemented"))
 }
 
+// TodosPage is the resolver for the todosPage field.
+func (r *queryResolver) TodosPage(ctx context.Context, offset *int, limit *int, orderBy []*ent.TodoOrder, where *ent.TodoWhereInput) (*ent.TodoPage, error) {
+	panic(fmt.Errorf("not implemented"))
+}
+
 // Users is the resolver for the users field.
 func (r *queryResolver) Users(ctx context.Context, after *entgql.Cursor[string], first *int, before *entgql.Cursor[string], last *int, orderBy *ent.UserOrder, where *ent.UserWhereInput) (*ent.UserConnection, error) {
 	panic(fmt.Errorf("not implemented"))
@@ -83,6 +88,11 @@ func (r *todoResolver) Status(ctx context.Context, obj *ent.Todo) (todo.Status,
 	panic(fmt.Errorf("not implemented"))
 }
 
+// Status is the resolver for the status field.
+func (r *todoAggregateResolver) Status(ctx context.Context, obj *ent.TodoAggregate) (*todo.Status, error) {
+	panic(fmt.Errorf("not implemented"))
+}
+
 // Username is the resolver for the username field.
 func (r *userResolver) Username(ctx context.Context, obj *ent.User) (string, error) {
 	panic(fmt.Errorf("not implemented"))
@@ -200,6 +210,9 @@ func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
 // Todo returns TodoResolver implementation.
 func (r *Resolver) Todo() TodoResolver { return &todoResolver{r} }
 
+// TodoAggregate returns TodoAggregateResolver implementation.
+func (r *Resolver) TodoAggregate() TodoAggregateResolver { return &todoAggregateResolver{r} }
+
 // User returns UserResolver implementation.
 func (r *Resolver) User() UserResolver { return &userResolver{r} }
 
@@ -229,6 +242,7 @@ type categoryResolver struct{ *Resolver }
 type organizationResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
 type todoResolver struct{ *Resolver }
+type todoAggregateResolver struct{ *Resolver }
 type userResolver struct{ *Resolver }
 type createCategoryInputResolver struct{ *Resolver }
 type createTodoInputResolver struct{ *Resolver }
@@ -0,0 +1,188 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/contrib/entgql/internal/todogotype/ent/todo"
+)
+
+const aggregateField = "aggregate"
+
+// TodoGroupByField defines the fields by which Todo aggregations can be grouped.
+type TodoGroupByField string
+
+// TodoGroupByField values.
+const (
+	TodoGroupByFieldStatus TodoGroupByField = "STATUS"
+)
+
+// String implements fmt.Stringer interface.
+func (e TodoGroupByField) String() string {
+	return string(e)
+}
+
+// IsValid reports if the value is a valid TodoGroupByField.
+func (e TodoGroupByField) IsValid() bool {
+	switch e {
+	case TodoGroupByFieldStatus:
+		return true
+	default:
+		return false
+	}
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e TodoGroupByField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *TodoGroupByField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("TodoGroupByField %T must be a string", v)
+	}
+	*e = TodoGroupByField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TodoGroupByField", str)
+	}
+	return nil
+}
+
+// Column returns the column of the field.
+func (e TodoGroupByField) Column() string {
+	switch e {
+	case TodoGroupByFieldStatus:
+		return todo.FieldStatus
+	default:
+		return ""
+	}
+}
+
+// TodoAggregate holds the aggregated values of a group of todos.
+type TodoAggregate struct {
+	Count  int                  `json:"count"`
+	Sum    *TodoAggregateSum    `json:"sum"`
+	Avg    *TodoAggregateAvg    `json:"avg"`
+	Min    *TodoAggregateMinMax `json:"min"`
+	Max    *TodoAggregateMinMax `json:"max"`
+	Status *todo.Status         `json:"status,omitempty"`
+}
+
+// TodoAggregateSum holds the sums of the numeric fields of todos.
+type TodoAggregateSum struct {
+	Priority *int `json:"priority"`
+}
+
+// TodoAggregateAvg holds the averages of the numeric fields of todos.
+type TodoAggregateAvg struct {
+	Priority *float64 `json:"priority"`
+}
+
+// TodoAggregateMinMax holds the minimum or maximum values of the fields of todos.
+type TodoAggregateMinMax struct {
+	CreatedAt *time.Time `json:"createdAt"`
+	Priority  *int       `json:"priority"`
+}
+
+// todoAggregateRow is a row returned by the aggregation query of todos.
+type todoAggregateRow struct {
+	Count        int             `sql:"count"`
+	SumPriority  *int            `sql:"sum_priority"`
+	AvgPriority  *float64        `sql:"avg_priority"`
+	MinCreatedAt entgql.NullTime `sql:"min_created_at"`
+	MaxCreatedAt entgql.NullTime `sql:"max_created_at"`
+	MinPriority  *int            `sql:"min_priority"`
+	MaxPriority  *int            `sql:"max_priority"`
+	Status       *todo.Status    `sql:"status"`
+}
+
+// Aggregate returns the aggregated values of the todos of the connection,
+// grouped by the given fields. The aggregations are computed with the connection filter,
+// regardless of its pagination.
+func (c *TodoConnection) Aggregate(ctx context.Context, groupBy []TodoGroupByField) ([]*TodoAggregate, error) {
+	if c.query == nil {
+		return nil, errors.New("ent: aggregate field of TodoConnection was not collected")
+	}
+	return c.query.aggregate(ctx, groupBy)
+}
+
+// aggregate computes the aggregations of the todos matching the query.
+func (t *TodoQuery) aggregate(ctx context.Context, groupBy []TodoGroupByField) ([]*TodoAggregate, error) {
+	var (
+		rows []todoAggregateRow
+		fns  = []AggregateFunc{
+			As(Count(), "count"),
+			As(Sum(todo.FieldPriority), "sum_priority"),
+			As(Mean(todo.FieldPriority), "avg_priority"),
+			As(Min(todo.FieldCreatedAt), "min_created_at"),
+			As(Max(todo.FieldCreatedAt), "max_created_at"),
+			As(Min(todo.FieldPriority), "min_priority"),
+			As(Max(todo.FieldPriority), "max_priority"),
+		}
+		query = t.Clone()
+	)
+	query.ctx.Fields, query.order = nil, nil
+	if len(groupBy) > 0 {
+		columns := make([]string, len(groupBy))
+		for i, f := range groupBy {
+			if columns[i] = f.Column(); columns[i] == "" {
+				return nil, fmt.Errorf("%s is not a valid TodoGroupByField", f)
+			}
+		}
+		if err := query.GroupBy(columns[0], columns[1:]...).Aggregate(fns...).Scan(ctx, &rows); err != nil {
+			return nil, err
+		}
+	} else if err := query.Aggregate(fns...).Scan(ctx, &rows); err != nil {
+		return nil, err
+	}
+	aggs := make([]*TodoAggregate, len(rows))
+	for i := range rows {
+		r := &rows[i]
+		aggs[i] = &TodoAggregate{
+			Count: r.Count,
+			Sum: &TodoAggregateSum{
+				Priority: r.SumPriority,
+			},
+			Avg: &TodoAggregateAvg{
+				Priority: r.AvgPriority,
+			},
+			Min: &TodoAggregateMinMax{
+				Priority: r.MinPriority,
+			},
+			Max: &TodoAggregateMinMax{
+				Priority: r.MaxPriority,
+			},
+			Status: r.Status,
+		}
+		if r.MinCreatedAt.Valid {
+			aggs[i].Min.CreatedAt = &r.MinCreatedAt.Time
+		}
+		if r.MaxCreatedAt.Valid {
+			aggs[i].Max.CreatedAt = &r.MaxCreatedAt.Time
+		}
+	}
+	return aggs, nil
+}
@@ -232,6 +232,11 @@ func (cq *CategoryQuery) collectField(ctx context.Context, oneNode bool, opCtx *
 			if err := validateFirstLast(args.first, args.last); err != nil {
 				return fmt.Errorf("validate first and last in path %q: %w", path, err)
 			}
+			first, err := validateMaxPageSize(args.first, args.last, 25)
+			if err != nil {
+				return fmt.Errorf("validate page size in path %q: %w", path, err)
+			}
+			args.first = first
 			pager, err := newCategoryPager(args.opts, args.last != nil)
 			if err != nil {
 				return fmt.Errorf("create new pager in path %q: %w", path, err)
@@ -314,6 +319,7 @@ func (cq *CategoryQuery) collectField(ctx context.Context, oneNode bool, opCtx *
 			cq.WithNamedSubCategories(alias, func(wq *CategoryQuery) {
 				*wq = *query
 			})
+		case "todosPage":
 		case "text":
 			if _, ok := fieldSeen[category.FieldText]; !ok {
 				selectedFields = append(selectedFields, category.FieldText)
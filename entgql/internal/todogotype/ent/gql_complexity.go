@@ -0,0 +1,51 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"entgo.io/contrib/entgql"
+)
+
+// Complexity returns the complexity functions of the connections and the lists
+// of nodes of the GraphQL schema. The complexity of a connection is multiplied
+// by its page size, and the complexity of a list by entgql.DefaultListSize.
+// It is used by the entgql.ComplexityLimit extension, or by gqlgen complexity
+// extensions using the entgql.ComplexitySchema wrapper.
+func Complexity() entgql.Complexity {
+	return entgql.Complexity{
+		"Query": {
+			"billProducts": entgql.ListComplexity(),
+			"categories":   entgql.ConnectionComplexity(25),
+			"todos":        entgql.ConnectionComplexity(0),
+		},
+		"Category": {
+			"todos":         entgql.ConnectionComplexity(0),
+			"subCategories": entgql.ConnectionComplexity(25),
+		},
+		"Group": {
+			"users": entgql.ListComplexity(),
+		},
+		"Todo": {
+			"children": entgql.ConnectionComplexity(0),
+		},
+		"User": {
+			"groups":      entgql.ListComplexity(),
+			"friends":     entgql.ListComplexity(),
+			"friendships": entgql.ListComplexity(),
+		},
+	}
+}
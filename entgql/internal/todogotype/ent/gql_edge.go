@@ -19,9 +19,61 @@ package ent
 import (
 	"context"
 
+	"entgo.io/contrib/entgql"
+	"entgo.io/contrib/entgql/internal/todogotype/ent/category"
+	"entgo.io/contrib/entgql/internal/todogotype/ent/friendship"
+	"entgo.io/contrib/entgql/internal/todogotype/ent/group"
+	"entgo.io/contrib/entgql/internal/todogotype/ent/schema/bigintgql"
+	"entgo.io/contrib/entgql/internal/todogotype/ent/todo"
+	"entgo.io/contrib/entgql/internal/todogotype/ent/user"
 	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
 )
 
+// edgeLoaderKey is the key of the batch loader of the edges that resolve
+// the same GraphQL field of the nodes that are stored in the same table.
+type edgeLoaderKey struct {
+	table string
+	field *ast.Field
+}
+
+// batchLoad returns a copy of the node, with the edge of the resolved field loaded
+// by a batch loader with the edges of the other nodes that resolve the same field.
+// The node itself is returned in case the context does not hold batch loaders.
+func (c *Category) batchLoad(ctx context.Context) (*Category, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return c, nil
+	}
+	key := edgeLoaderKey{table: category.Table, field: fc.Field.Field}
+	l := entgql.BatchLoader(ctx, key, func(ctx context.Context, ids []bigintgql.BigInt) (map[bigintgql.BigInt]*Category, error) {
+		query := (&CategoryClient{config: c.config}).Query().
+			Where(category.IDIn(ids...))
+		parent := graphql.CollectedField{Field: &ast.Field{}, Selections: ast.SelectionSet{fc.Field.Field}}
+		ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{Field: parent})
+		if err := query.collectField(ctx, false, graphql.GetOperationContext(ctx), parent, nil, categoryImplementors...); err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		loaded := make(map[bigintgql.BigInt]*Category, len(nodes))
+		for _, node := range nodes {
+			loaded[node.ID] = node
+		}
+		return loaded, nil
+	})
+	if l == nil {
+		return c, nil
+	}
+	node, err := l.Load(ctx, c.ID)
+	if node == nil && err == nil {
+		return c, nil
+	}
+	return node, err
+}
+
 func (c *Category) Todos(
 	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy []*TodoOrder, where *TodoWhereInput,
 ) (*TodoConnection, error) {
@@ -30,29 +82,64 @@ func (c *Category) Todos(
 		WithTodoFilter(where.Filter),
 	}
 	alias := graphql.GetFieldContext(ctx).Field.Alias
-	totalCount, hasTotalCount := c.Edges.totalCount[0][alias]
-	if nodes, err := c.NamedTodos(alias); err == nil || hasTotalCount {
+	node := c
+	if _, err := node.NamedTodos(alias); err != nil {
+		if _, ok := node.Edges.totalCount[0][alias]; !ok {
+			if node, err = c.batchLoad(ctx); err != nil {
+				return nil, err
+			}
+		}
+	}
+	totalCount, hasTotalCount := node.Edges.totalCount[0][alias]
+	if nodes, err := node.NamedTodos(alias); err == nil || hasTotalCount {
 		pager, err := newTodoPager(opts, last != nil)
 		if err != nil {
 			return nil, err
 		}
 		conn := &TodoConnection{Edges: []*TodoEdge{}, TotalCount: totalCount}
+		if hasCollectedField(ctx, aggregateField) {
+			if conn.query, err = pager.applyFilter(c.QueryTodos()); err != nil {
+				return nil, err
+			}
+		}
 		conn.build(nodes, pager, after, first, before, last)
 		return conn, nil
 	}
 	return c.QueryTodos().Paginate(ctx, after, first, before, last, opts...)
 }
 
+func (c *Category) TodosPage(
+	ctx context.Context, offset *int, limit *int, orderBy []*TodoOrder, where *TodoWhereInput,
+) (*TodoPage, error) {
+	opts := []TodoPaginateOption{
+		WithTodoOrder(orderBy),
+		WithTodoFilter(where.Filter),
+	}
+	return c.QueryTodos().PaginateOffset(ctx, offset, limit, opts...)
+}
+
 func (c *Category) SubCategories(
 	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy []*CategoryOrder, where *CategoryWhereInput,
 ) (*CategoryConnection, error) {
+	first, err := validateMaxPageSize(first, last, 25)
+	if err != nil {
+		return nil, err
+	}
 	opts := []CategoryPaginateOption{
 		WithCategoryOrder(orderBy),
 		WithCategoryFilter(where.Filter),
 	}
 	alias := graphql.GetFieldContext(ctx).Field.Alias
-	totalCount, hasTotalCount := c.Edges.totalCount[1][alias]
-	if nodes, err := c.NamedSubCategories(alias); err == nil || hasTotalCount {
+	node := c
+	if _, err := node.NamedSubCategories(alias); err != nil {
+		if _, ok := node.Edges.totalCount[1][alias]; !ok {
+			if node, err = c.batchLoad(ctx); err != nil {
+				return nil, err
+			}
+		}
+	}
+	totalCount, hasTotalCount := node.Edges.totalCount[1][alias]
+	if nodes, err := node.NamedSubCategories(alias); err == nil || hasTotalCount {
 		pager, err := newCategoryPager(opts, last != nil)
 		if err != nil {
 			return nil, err
@@ -64,8 +151,52 @@ func (c *Category) SubCategories(
 	return c.QuerySubCategories().Paginate(ctx, after, first, before, last, opts...)
 }
 
+// batchLoad returns a copy of the node, with the edge of the resolved field loaded
+// by a batch loader with the edges of the other nodes that resolve the same field.
+// The node itself is returned in case the context does not hold batch loaders.
+func (f *Friendship) batchLoad(ctx context.Context) (*Friendship, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return f, nil
+	}
+	key := edgeLoaderKey{table: friendship.Table, field: fc.Field.Field}
+	l := entgql.BatchLoader(ctx, key, func(ctx context.Context, ids []string) (map[string]*Friendship, error) {
+		query := (&FriendshipClient{config: f.config}).Query().
+			Where(friendship.IDIn(ids...))
+		parent := graphql.CollectedField{Field: &ast.Field{}, Selections: ast.SelectionSet{fc.Field.Field}}
+		ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{Field: parent})
+		if err := query.collectField(ctx, false, graphql.GetOperationContext(ctx), parent, nil, friendshipImplementors...); err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		loaded := make(map[string]*Friendship, len(nodes))
+		for _, node := range nodes {
+			loaded[node.ID] = node
+		}
+		return loaded, nil
+	})
+	if l == nil {
+		return f, nil
+	}
+	node, err := l.Load(ctx, f.ID)
+	if node == nil && err == nil {
+		return f, nil
+	}
+	return node, err
+}
+
 func (f *Friendship) User(ctx context.Context) (*User, error) {
 	result, err := f.Edges.UserOrErr()
+	if IsNotLoaded(err) {
+		var node *Friendship
+		if node, err = f.batchLoad(ctx); err != nil {
+			return nil, err
+		}
+		result, err = node.Edges.UserOrErr()
+	}
 	if IsNotLoaded(err) {
 		result, err = f.QueryUser().Only(ctx)
 	}
@@ -74,12 +205,56 @@ func (f *Friendship) User(ctx context.Context) (*User, error) {
 
 func (f *Friendship) Friend(ctx context.Context) (*User, error) {
 	result, err := f.Edges.FriendOrErr()
+	if IsNotLoaded(err) {
+		var node *Friendship
+		if node, err = f.batchLoad(ctx); err != nil {
+			return nil, err
+		}
+		result, err = node.Edges.FriendOrErr()
+	}
 	if IsNotLoaded(err) {
 		result, err = f.QueryFriend().Only(ctx)
 	}
 	return result, err
 }
 
+// batchLoad returns a copy of the node, with the edge of the resolved field loaded
+// by a batch loader with the edges of the other nodes that resolve the same field.
+// The node itself is returned in case the context does not hold batch loaders.
+func (gr *Group) batchLoad(ctx context.Context) (*Group, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return gr, nil
+	}
+	key := edgeLoaderKey{table: group.Table, field: fc.Field.Field}
+	l := entgql.BatchLoader(ctx, key, func(ctx context.Context, ids []string) (map[string]*Group, error) {
+		query := (&GroupClient{config: gr.config}).Query().
+			Where(group.IDIn(ids...))
+		parent := graphql.CollectedField{Field: &ast.Field{}, Selections: ast.SelectionSet{fc.Field.Field}}
+		ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{Field: parent})
+		if err := query.collectField(ctx, false, graphql.GetOperationContext(ctx), parent, nil, groupImplementors...); err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		loaded := make(map[string]*Group, len(nodes))
+		for _, node := range nodes {
+			loaded[node.ID] = node
+		}
+		return loaded, nil
+	})
+	if l == nil {
+		return gr, nil
+	}
+	node, err := l.Load(ctx, gr.ID)
+	if node == nil && err == nil {
+		return gr, nil
+	}
+	return node, err
+}
+
 func (gr *Group) Users(
 	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, orderBy *UserOrder, where *UserWhereInput,
 ) (*UserConnection, error) {
@@ -88,8 +263,16 @@ func (gr *Group) Users(
 		WithUserFilter(where.Filter),
 	}
 	alias := graphql.GetFieldContext(ctx).Field.Alias
-	totalCount, hasTotalCount := gr.Edges.totalCount[0][alias]
-	if nodes, err := gr.NamedUsers(alias); err == nil || hasTotalCount {
+	node := gr
+	if _, err := node.NamedUsers(alias); err != nil {
+		if _, ok := node.Edges.totalCount[0][alias]; !ok {
+			if node, err = gr.batchLoad(ctx); err != nil {
+				return nil, err
+			}
+		}
+	}
+	totalCount, hasTotalCount := node.Edges.totalCount[0][alias]
+	if nodes, err := node.NamedUsers(alias); err == nil || hasTotalCount {
 		pager, err := newUserPager(opts, last != nil)
 		if err != nil {
 			return nil, err
@@ -101,8 +284,52 @@ func (gr *Group) Users(
 	return gr.QueryUsers().Paginate(ctx, after, first, before, last, opts...)
 }
 
+// batchLoad returns a copy of the node, with the edge of the resolved field loaded
+// by a batch loader with the edges of the other nodes that resolve the same field.
+// The node itself is returned in case the context does not hold batch loaders.
+func (t *Todo) batchLoad(ctx context.Context) (*Todo, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return t, nil
+	}
+	key := edgeLoaderKey{table: todo.Table, field: fc.Field.Field}
+	l := entgql.BatchLoader(ctx, key, func(ctx context.Context, ids []string) (map[string]*Todo, error) {
+		query := (&TodoClient{config: t.config}).Query().
+			Where(todo.IDIn(ids...))
+		parent := graphql.CollectedField{Field: &ast.Field{}, Selections: ast.SelectionSet{fc.Field.Field}}
+		ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{Field: parent})
+		if err := query.collectField(ctx, false, graphql.GetOperationContext(ctx), parent, nil, todoImplementors...); err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		loaded := make(map[string]*Todo, len(nodes))
+		for _, node := range nodes {
+			loaded[node.ID] = node
+		}
+		return loaded, nil
+	})
+	if l == nil {
+		return t, nil
+	}
+	node, err := l.Load(ctx, t.ID)
+	if node == nil && err == nil {
+		return t, nil
+	}
+	return node, err
+}
+
 func (t *Todo) Parent(ctx context.Context) (*Todo, error) {
 	result, err := t.Edges.ParentOrErr()
+	if IsNotLoaded(err) {
+		var node *Todo
+		if node, err = t.batchLoad(ctx); err != nil {
+			return nil, err
+		}
+		result, err = node.Edges.ParentOrErr()
+	}
 	if IsNotLoaded(err) {
 		result, err = t.QueryParent().Only(ctx)
 	}
@@ -117,13 +344,26 @@ func (t *Todo) Children(
 		WithTodoFilter(where.Filter),
 	}
 	alias := graphql.GetFieldContext(ctx).Field.Alias
-	totalCount, hasTotalCount := t.Edges.totalCount[1][alias]
-	if nodes, err := t.NamedChildren(alias); err == nil || hasTotalCount {
+	node := t
+	if _, err := node.NamedChildren(alias); err != nil {
+		if _, ok := node.Edges.totalCount[1][alias]; !ok {
+			if node, err = t.batchLoad(ctx); err != nil {
+				return nil, err
+			}
+		}
+	}
+	totalCount, hasTotalCount := node.Edges.totalCount[1][alias]
+	if nodes, err := node.NamedChildren(alias); err == nil || hasTotalCount {
 		pager, err := newTodoPager(opts, last != nil)
 		if err != nil {
 			return nil, err
 		}
 		conn := &TodoConnection{Edges: []*TodoEdge{}, TotalCount: totalCount}
+		if hasCollectedField(ctx, aggregateField) {
+			if conn.query, err = pager.applyFilter(t.QueryChildren()); err != nil {
+				return nil, err
+			}
+		}
 		conn.build(nodes, pager, after, first, before, last)
 		return conn, nil
 	}
@@ -132,12 +372,56 @@ func (t *Todo) Children(
 
 func (t *Todo) Category(ctx context.Context) (*Category, error) {
 	result, err := t.Edges.CategoryOrErr()
+	if IsNotLoaded(err) {
+		var node *Todo
+		if node, err = t.batchLoad(ctx); err != nil {
+			return nil, err
+		}
+		result, err = node.Edges.CategoryOrErr()
+	}
 	if IsNotLoaded(err) {
 		result, err = t.QueryCategory().Only(ctx)
 	}
 	return result, MaskNotFound(err)
 }
 
+// batchLoad returns a copy of the node, with the edge of the resolved field loaded
+// by a batch loader with the edges of the other nodes that resolve the same field.
+// The node itself is returned in case the context does not hold batch loaders.
+func (u *User) batchLoad(ctx context.Context) (*User, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return u, nil
+	}
+	key := edgeLoaderKey{table: user.Table, field: fc.Field.Field}
+	l := entgql.BatchLoader(ctx, key, func(ctx context.Context, ids []string) (map[string]*User, error) {
+		query := (&UserClient{config: u.config}).Query().
+			Where(user.IDIn(ids...))
+		parent := graphql.CollectedField{Field: &ast.Field{}, Selections: ast.SelectionSet{fc.Field.Field}}
+		ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{Field: parent})
+		if err := query.collectField(ctx, false, graphql.GetOperationContext(ctx), parent, nil, userImplementors...); err != nil {
+			return nil, err
+		}
+		nodes, err := query.All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		loaded := make(map[string]*User, len(nodes))
+		for _, node := range nodes {
+			loaded[node.ID] = node
+		}
+		return loaded, nil
+	})
+	if l == nil {
+		return u, nil
+	}
+	node, err := l.Load(ctx, u.ID)
+	if node == nil && err == nil {
+		return u, nil
+	}
+	return node, err
+}
+
 func (u *User) Groups(
 	ctx context.Context, after *Cursor, first *int, before *Cursor, last *int, where *GroupWhereInput,
 ) (*GroupConnection, error) {
@@ -145,8 +429,16 @@ func (u *User) Groups(
 		WithGroupFilter(where.Filter),
 	}
 	alias := graphql.GetFieldContext(ctx).Field.Alias
-	totalCount, hasTotalCount := u.Edges.totalCount[0][alias]
-	if nodes, err := u.NamedGroups(alias); err == nil || hasTotalCount {
+	node := u
+	if _, err := node.NamedGroups(alias); err != nil {
+		if _, ok := node.Edges.totalCount[0][alias]; !ok {
+			if node, err = u.batchLoad(ctx); err != nil {
+				return nil, err
+			}
+		}
+	}
+	totalCount, hasTotalCount := node.Edges.totalCount[0][alias]
+	if nodes, err := node.NamedGroups(alias); err == nil || hasTotalCount {
 		pager, err := newGroupPager(opts, last != nil)
 		if err != nil {
 			return nil, err
@@ -161,6 +453,13 @@ func (u *User) Groups(
 func (u *User) Friends(ctx context.Context) (result []*User, err error) {
 	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
 		result, err = u.NamedFriends(graphql.GetFieldContext(ctx).Field.Alias)
+		if IsNotLoaded(err) {
+			var node *User
+			if node, err = u.batchLoad(ctx); err != nil {
+				return nil, err
+			}
+			result, err = node.NamedFriends(fc.Field.Alias)
+		}
 	} else {
 		result, err = u.Edges.FriendsOrErr()
 	}
@@ -173,6 +472,13 @@ func (u *User) Friends(ctx context.Context) (result []*User, err error) {
 func (u *User) Friendships(ctx context.Context) (result []*Friendship, err error) {
 	if fc := graphql.GetFieldContext(ctx); fc != nil && fc.Field.Alias != "" {
 		result, err = u.NamedFriendships(graphql.GetFieldContext(ctx).Field.Alias)
+		if IsNotLoaded(err) {
+			var node *User
+			if node, err = u.batchLoad(ctx); err != nil {
+				return nil, err
+			}
+			result, err = node.NamedFriendships(fc.Field.Alias)
+		}
 	} else {
 		result, err = u.Edges.FriendshipsOrErr()
 	}
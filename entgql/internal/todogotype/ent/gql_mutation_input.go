@@ -17,8 +17,10 @@
 package ent
 
 import (
+	"context"
 	"time"
 
+	"entgo.io/contrib/entgql"
 	"entgo.io/contrib/entgql/internal/todo/ent/schema/schematype"
 	"entgo.io/contrib/entgql/internal/todogotype/ent/category"
 	"entgo.io/contrib/entgql/internal/todogotype/ent/schema/bigintgql"
@@ -169,16 +171,17 @@ func (c *CategoryUpdateOne) SetInput(i UpdateCategoryInput) *CategoryUpdateOne {
 
 // CreateTodoInput represents a mutation input for creating todos.
 type CreateTodoInput struct {
-	Status     todo.Status
-	Priority   *int
-	Text       string
-	Name       *string
-	Init       map[string]interface{}
-	Value      *int
-	ParentID   *string
-	ChildIDs   []string
-	CategoryID *bigintgql.BigInt
-	SecretID   *string
+	Status         todo.Status
+	Priority       *int
+	Text           string
+	Name           *string
+	Init           map[string]interface{}
+	Value          *int
+	ParentID       *string
+	ChildIDs       []string
+	CategoryID     *bigintgql.BigInt
+	SecretID       *string
+	CreateChildren []*CreateTodoInput
 }
 
 // Mutate applies the CreateTodoInput on the TodoMutation builder.
@@ -212,11 +215,44 @@ func (i *CreateTodoInput) Mutate(m *TodoMutation) {
 }
 
 // SetInput applies the change-set in the CreateTodoInput on the TodoCreate builder.
+// The nested nodes of the input are created before the Todo is saved.
 func (c *TodoCreate) SetInput(i CreateTodoInput) *TodoCreate {
 	i.Mutate(c.Mutation())
+	if i.hasNested() {
+		c.hooks = append(c.hooks[:len(c.hooks):len(c.hooks)], func(next Mutator) Mutator {
+			return MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				if err := i.createNested(ctx, c.Mutation()); err != nil {
+					return nil, err
+				}
+				return next.Mutate(ctx, m)
+			})
+		})
+	}
 	return c
 }
 
+// hasNested reports if the CreateTodoInput holds nested inputs.
+func (i *CreateTodoInput) hasNested() bool {
+	return len(i.CreateChildren) > 0
+}
+
+// createNested creates the nodes of the nested inputs and adds them to the TodoMutation.
+func (i *CreateTodoInput) createNested(ctx context.Context, m *TodoMutation) error {
+	ctx, err := entgql.NestedContext(ctx)
+	if err != nil {
+		return err
+	}
+	client := m.Client()
+	for _, v := range i.CreateChildren {
+		node, err := client.Todo.Create().SetInput(*v).Save(ctx)
+		if err != nil {
+			return err
+		}
+		m.AddChildIDs(node.ID)
+	}
+	return nil
+}
+
 // UpdateTodoInput represents a mutation input for updating todos.
 type UpdateTodoInput struct {
 	Status         *todo.Status
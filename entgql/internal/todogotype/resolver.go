@@ -16,6 +16,7 @@ package todo
 
 import (
 	"context"
+	"entgo.io/contrib/entgql"
 	"fmt"
 	"strings"
 
@@ -37,6 +38,7 @@ func NewSchema(client *ent.Client) graphql.ExecutableSchema {
 	return NewExecutableSchema(Config{
 		Resolvers: &Resolver{client},
 		Directives: DirectiveRoot{
+			Authorize:      (&entgql.Authorizer{Policy: todo.Authorize}).Directive,
 			HasPermissions: todo.HasPermission(),
 		},
 	})
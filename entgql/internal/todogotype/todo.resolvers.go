@@ -61,6 +61,11 @@ func (r *queryResolver) Ping(ctx context.Context) (string, error) {
 	panic(fmt.Errorf("not implemented"))
 }
 
+// TopTodos is the resolver for the topTodos field.
+func (r *queryResolver) TopTodos(ctx context.Context, limit int) ([]*ent.Todo, error) {
+	panic(fmt.Errorf("not implemented"))
+}
+
 // TodosWithJoins is the resolver for the todosWithJoins field.
 func (r *queryResolver) TodosWithJoins(ctx context.Context, after *entgql.Cursor[string], first *int, before *entgql.Cursor[string], last *int, orderBy []*ent.TodoOrder, where *ent.TodoWhereInput) (*ent.TodoConnection, error) {
 	panic(fmt.Errorf("not implemented"))
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"time"
 
+	"entgo.io/contrib/entgql"
 	"entgo.io/contrib/entgql/internal/todo/ent/schema/schematype"
 	"entgo.io/contrib/entgql/internal/todopulid/ent/billproduct"
 	"entgo.io/contrib/entgql/internal/todopulid/ent/category"
@@ -33,6 +34,9 @@ import (
 	"github.com/google/uuid"
 )
 
+// JSONPathValue is used for matching the value at a path of a JSON field.
+type JSONPathValue = entgql.JSONPathValue
+
 // BillProductWhereInput represents a where input for filtering BillProduct queries.
 type BillProductWhereInput struct {
 	Predicates []predicate.BillProduct  `json:"-"`
@@ -373,6 +377,10 @@ type CategoryWhereInput struct {
 	CountIsNil  bool     `json:"countIsNil,omitempty"`
 	CountNotNil bool     `json:"countNotNil,omitempty"`
 
+	// "strings" field predicates.
+	StringsContains    *string  `json:"stringsContains,omitempty"`
+	StringsContainsAny []string `json:"stringsContainsAny,omitempty"`
+
 	// "todos" edge predicates.
 	HasTodos     *bool             `json:"hasTodos,omitempty"`
 	HasTodosWith []*TodoWhereInput `json:"hasTodosWith,omitempty"`
@@ -618,6 +626,12 @@ func (i *CategoryWhereInput) P() (predicate.Category, error) {
 	if i.CountNotNil {
 		predicates = append(predicates, category.CountNotNil())
 	}
+	if i.StringsContains != nil {
+		predicates = append(predicates, predicate.Category(entgql.ValuesContain(category.FieldStrings, *i.StringsContains)))
+	}
+	if len(i.StringsContainsAny) > 0 {
+		predicates = append(predicates, predicate.Category(entgql.ValuesContainAny(category.FieldStrings, i.StringsContainsAny)))
+	}
 
 	if i.HasTodos != nil {
 		p := category.HasTodos()
@@ -1232,6 +1246,7 @@ type TodoWhereInput struct {
 	TextHasSuffix    *string  `json:"textHasSuffix,omitempty"`
 	TextEqualFold    *string  `json:"textEqualFold,omitempty"`
 	TextContainsFold *string  `json:"textContainsFold,omitempty"`
+	TextSearch       *string  `json:"textSearch,omitempty"`
 
 	// "name" field predicates.
 	Name             *string  `json:"name,omitempty"`
@@ -1250,6 +1265,11 @@ type TodoWhereInput struct {
 	NameEqualFold    *string  `json:"nameEqualFold,omitempty"`
 	NameContainsFold *string  `json:"nameContainsFold,omitempty"`
 
+	// "init" field predicates.
+	InitHasKey   *string        `json:"initHasKey,omitempty"`
+	InitValueEQ  *JSONPathValue `json:"initValueEQ,omitempty"`
+	InitContains *JSONPathValue `json:"initContains,omitempty"`
+
 	// "value" field predicates.
 	Value      *int  `json:"value,omitempty"`
 	ValueNEQ   *int  `json:"valueNEQ,omitempty"`
@@ -1484,6 +1504,9 @@ func (i *TodoWhereInput) P() (predicate.Todo, error) {
 	if i.TextContainsFold != nil {
 		predicates = append(predicates, todo.TextContainsFold(*i.TextContainsFold))
 	}
+	if i.TextSearch != nil {
+		predicates = append(predicates, predicate.Todo(entgql.TextSearch(todo.FieldText, *i.TextSearch)))
+	}
 	if i.Name != nil {
 		predicates = append(predicates, todo.NameEQ(*i.Name))
 	}
@@ -1529,6 +1552,27 @@ func (i *TodoWhereInput) P() (predicate.Todo, error) {
 	if i.NameContainsFold != nil {
 		predicates = append(predicates, todo.NameContainsFold(*i.NameContainsFold))
 	}
+	if i.InitHasKey != nil {
+		p, err := entgql.JSONHasKey(todo.FieldInit, *i.InitHasKey)
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'InitHasKey'", err)
+		}
+		predicates = append(predicates, predicate.Todo(p))
+	}
+	if i.InitValueEQ != nil {
+		p, err := entgql.JSONValueEQ(todo.FieldInit, *i.InitValueEQ)
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'InitValueEQ'", err)
+		}
+		predicates = append(predicates, predicate.Todo(p))
+	}
+	if i.InitContains != nil {
+		p, err := entgql.JSONContains(todo.FieldInit, *i.InitContains)
+		if err != nil {
+			return nil, fmt.Errorf("%w: field 'InitContains'", err)
+		}
+		predicates = append(predicates, predicate.Todo(p))
+	}
 	if i.Value != nil {
 		predicates = append(predicates, todo.ValueEQ(*i.Value))
 	}
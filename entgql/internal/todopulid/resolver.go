@@ -15,6 +15,7 @@
 package todopulid
 
 import (
+	"entgo.io/contrib/entgql"
 	"entgo.io/contrib/entgql/internal/todo"
 	"entgo.io/contrib/entgql/internal/todopulid/ent"
 	"github.com/99designs/gqlgen/graphql"
@@ -28,6 +29,7 @@ func NewSchema(client *ent.Client) graphql.ExecutableSchema {
 	return NewExecutableSchema(Config{
 		Resolvers: &Resolver{client},
 		Directives: DirectiveRoot{
+			Authorize:      (&entgql.Authorizer{Policy: todo.Authorize}).Directive,
 			HasPermissions: todo.HasPermission(),
 		},
 	})
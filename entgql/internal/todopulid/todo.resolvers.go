@@ -70,6 +70,11 @@ func (r *queryResolver) Ping(ctx context.Context) (string, error) {
 	panic(fmt.Errorf("not implemented"))
 }
 
+// TopTodos is the resolver for the topTodos field.
+func (r *queryResolver) TopTodos(ctx context.Context, limit int) ([]*ent.Todo, error) {
+	panic(fmt.Errorf("not implemented"))
+}
+
 // TodosWithJoins is the resolver for the todosWithJoins field.
 func (r *queryResolver) TodosWithJoins(ctx context.Context, after *entgql.Cursor[pulid.ID], first *int, before *entgql.Cursor[pulid.ID], last *int, orderBy []*ent.TodoOrder, where *ent.TodoWhereInput) (*ent.TodoConnection, error) {
 	panic(fmt.Errorf("not implemented"))
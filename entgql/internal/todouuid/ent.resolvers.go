@@ -35,18 +35,18 @@ func (r *categoryResolver) Types(ctx context.Context, obj *ent.Category) (*Categ
 }
 
 // ID is the resolver for the id field.
-func (r *organizationResolver) ID(ctx context.Context, obj *ent1.Workspace) (uuid.UUID, error) {
+func (r *organizationResolver) ID(ctx context.Context, obj *ent1.Workspace) (string, error) {
 	panic(fmt.Errorf("not implemented"))
 }
 
 // Node is the resolver for the node field.
-func (r *queryResolver) Node(ctx context.Context, id uuid.UUID) (ent.Noder, error) {
-	return r.client.Noder(ctx, id, ent.WithFixedNodeType(todo.Table))
+func (r *queryResolver) Node(ctx context.Context, id string) (ent.Noder, error) {
+	return r.client.Noder(ctx, id)
 }
 
 // Nodes is the resolver for the nodes field.
-func (r *queryResolver) Nodes(ctx context.Context, ids []uuid.UUID) ([]ent.Noder, error) {
-	return r.client.Noders(ctx, ids, ent.WithFixedNodeType(todo.Table))
+func (r *queryResolver) Nodes(ctx context.Context, ids []string) ([]ent.Noder, error) {
+	return r.client.Noders(ctx, ids)
 }
 
 // BillProducts is the resolver for the billProducts field.
@@ -81,6 +81,11 @@ func (r *queryResolver) Todos(ctx context.Context, after *entgql.Cursor[uuid.UUI
 		)
 }
 
+// TodosPage is the resolver for the todosPage field.
+func (r *queryResolver) TodosPage(ctx context.Context, offset *int, limit *int, orderBy []*ent.TodoOrder, where *ent.TodoWhereInput) (*ent.TodoPage, error) {
+	panic(fmt.Errorf("not implemented"))
+}
+
 // Users is the resolver for the users field.
 func (r *queryResolver) Users(ctx context.Context, after *entgql.Cursor[uuid.UUID], first *int, before *entgql.Cursor[uuid.UUID], last *int, orderBy *ent.UserOrder, where *ent.UserWhereInput) (*ent.UserConnection, error) {
 	return r.client.User.Query().
@@ -94,6 +99,11 @@ func (r *todoResolver) Status(ctx context.Context, obj *ent.Todo) (todo.Status,
 	panic(fmt.Errorf("not implemented"))
 }
 
+// Status is the resolver for the status field.
+func (r *todoAggregateResolver) Status(ctx context.Context, obj *ent.TodoAggregate) (*todo.Status, error) {
+	panic(fmt.Errorf("not implemented"))
+}
+
 // Username is the resolver for the username field.
 func (r *userResolver) Username(ctx context.Context, obj *ent.User) (string, error) {
 	panic(fmt.Errorf("not implemented"))
@@ -211,6 +221,9 @@ func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
 // Todo returns TodoResolver implementation.
 func (r *Resolver) Todo() TodoResolver { return &todoResolver{r} }
 
+// TodoAggregate returns TodoAggregateResolver implementation.
+func (r *Resolver) TodoAggregate() TodoAggregateResolver { return &todoAggregateResolver{r} }
+
 // User returns UserResolver implementation.
 func (r *Resolver) User() UserResolver { return &userResolver{r} }
 
@@ -246,6 +259,7 @@ type categoryResolver struct{ *Resolver }
 type organizationResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
 type todoResolver struct{ *Resolver }
+type todoAggregateResolver struct{ *Resolver }
 type userResolver struct{ *Resolver }
 type createCategoryInputResolver struct{ *Resolver }
 type createTodoInputResolver struct{ *Resolver }
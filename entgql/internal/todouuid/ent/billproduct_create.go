@@ -22,6 +22,8 @@ import (
 	"fmt"
 
 	"entgo.io/contrib/entgql/internal/todouuid/ent/billproduct"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
@@ -32,6 +34,7 @@ type BillProductCreate struct {
 	config
 	mutation *BillProductMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetName sets the "name" field.
@@ -149,6 +152,7 @@ func (bpc *BillProductCreate) createSpec() (*BillProduct, *sqlgraph.CreateSpec)
 		_node = &BillProduct{config: bpc.config}
 		_spec = sqlgraph.NewCreateSpec(billproduct.Table, sqlgraph.NewFieldSpec(billproduct.FieldID, field.TypeUUID))
 	)
+	_spec.OnConflict = bpc.conflict
 	if id, ok := bpc.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = &id
@@ -168,11 +172,238 @@ func (bpc *BillProductCreate) createSpec() (*BillProduct, *sqlgraph.CreateSpec)
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BillProduct.Create().
+//		SetName(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BillProductUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (bpc *BillProductCreate) OnConflict(opts ...sql.ConflictOption) *BillProductUpsertOne {
+	bpc.conflict = opts
+	return &BillProductUpsertOne{
+		create: bpc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BillProduct.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (bpc *BillProductCreate) OnConflictColumns(columns ...string) *BillProductUpsertOne {
+	bpc.conflict = append(bpc.conflict, sql.ConflictColumns(columns...))
+	return &BillProductUpsertOne{
+		create: bpc,
+	}
+}
+
+type (
+	// BillProductUpsertOne is the builder for "upsert"-ing
+	//  one BillProduct node.
+	BillProductUpsertOne struct {
+		create *BillProductCreate
+	}
+
+	// BillProductUpsert is the "OnConflict" setter.
+	BillProductUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetName sets the "name" field.
+func (u *BillProductUpsert) SetName(v string) *BillProductUpsert {
+	u.Set(billproduct.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *BillProductUpsert) UpdateName() *BillProductUpsert {
+	u.SetExcluded(billproduct.FieldName)
+	return u
+}
+
+// SetSku sets the "sku" field.
+func (u *BillProductUpsert) SetSku(v string) *BillProductUpsert {
+	u.Set(billproduct.FieldSku, v)
+	return u
+}
+
+// UpdateSku sets the "sku" field to the value that was provided on create.
+func (u *BillProductUpsert) UpdateSku() *BillProductUpsert {
+	u.SetExcluded(billproduct.FieldSku)
+	return u
+}
+
+// SetQuantity sets the "quantity" field.
+func (u *BillProductUpsert) SetQuantity(v uint64) *BillProductUpsert {
+	u.Set(billproduct.FieldQuantity, v)
+	return u
+}
+
+// UpdateQuantity sets the "quantity" field to the value that was provided on create.
+func (u *BillProductUpsert) UpdateQuantity() *BillProductUpsert {
+	u.SetExcluded(billproduct.FieldQuantity)
+	return u
+}
+
+// AddQuantity adds v to the "quantity" field.
+func (u *BillProductUpsert) AddQuantity(v uint64) *BillProductUpsert {
+	u.Add(billproduct.FieldQuantity, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.BillProduct.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(billproduct.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *BillProductUpsertOne) UpdateNewValues() *BillProductUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(billproduct.FieldID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BillProduct.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *BillProductUpsertOne) Ignore() *BillProductUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BillProductUpsertOne) DoNothing() *BillProductUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BillProductCreate.OnConflict
+// documentation for more info.
+func (u *BillProductUpsertOne) Update(set func(*BillProductUpsert)) *BillProductUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BillProductUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *BillProductUpsertOne) SetName(v string) *BillProductUpsertOne {
+	return u.Update(func(s *BillProductUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *BillProductUpsertOne) UpdateName() *BillProductUpsertOne {
+	return u.Update(func(s *BillProductUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetSku sets the "sku" field.
+func (u *BillProductUpsertOne) SetSku(v string) *BillProductUpsertOne {
+	return u.Update(func(s *BillProductUpsert) {
+		s.SetSku(v)
+	})
+}
+
+// UpdateSku sets the "sku" field to the value that was provided on create.
+func (u *BillProductUpsertOne) UpdateSku() *BillProductUpsertOne {
+	return u.Update(func(s *BillProductUpsert) {
+		s.UpdateSku()
+	})
+}
+
+// SetQuantity sets the "quantity" field.
+func (u *BillProductUpsertOne) SetQuantity(v uint64) *BillProductUpsertOne {
+	return u.Update(func(s *BillProductUpsert) {
+		s.SetQuantity(v)
+	})
+}
+
+// AddQuantity adds v to the "quantity" field.
+func (u *BillProductUpsertOne) AddQuantity(v uint64) *BillProductUpsertOne {
+	return u.Update(func(s *BillProductUpsert) {
+		s.AddQuantity(v)
+	})
+}
+
+// UpdateQuantity sets the "quantity" field to the value that was provided on create.
+func (u *BillProductUpsertOne) UpdateQuantity() *BillProductUpsertOne {
+	return u.Update(func(s *BillProductUpsert) {
+		s.UpdateQuantity()
+	})
+}
+
+// Exec executes the query.
+func (u *BillProductUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BillProductCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BillProductUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *BillProductUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: BillProductUpsertOne.ID is not supported by MySQL driver. Use BillProductUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *BillProductUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // BillProductCreateBulk is the builder for creating many BillProduct entities in bulk.
 type BillProductCreateBulk struct {
 	config
 	err      error
 	builders []*BillProductCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the BillProduct entities in the database.
@@ -202,6 +433,7 @@ func (bpcb *BillProductCreateBulk) Save(ctx context.Context) ([]*BillProduct, er
 					_, err = mutators[i+1].Mutate(root, bpcb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = bpcb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, bpcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -251,3 +483,166 @@ func (bpcb *BillProductCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.BillProduct.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.BillProductUpsert) {
+//			SetName(v+v).
+//		}).
+//		Exec(ctx)
+func (bpcb *BillProductCreateBulk) OnConflict(opts ...sql.ConflictOption) *BillProductUpsertBulk {
+	bpcb.conflict = opts
+	return &BillProductUpsertBulk{
+		create: bpcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.BillProduct.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (bpcb *BillProductCreateBulk) OnConflictColumns(columns ...string) *BillProductUpsertBulk {
+	bpcb.conflict = append(bpcb.conflict, sql.ConflictColumns(columns...))
+	return &BillProductUpsertBulk{
+		create: bpcb,
+	}
+}
+
+// BillProductUpsertBulk is the builder for "upsert"-ing
+// a bulk of BillProduct nodes.
+type BillProductUpsertBulk struct {
+	create *BillProductCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.BillProduct.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(billproduct.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *BillProductUpsertBulk) UpdateNewValues() *BillProductUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(billproduct.FieldID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.BillProduct.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *BillProductUpsertBulk) Ignore() *BillProductUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *BillProductUpsertBulk) DoNothing() *BillProductUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the BillProductCreateBulk.OnConflict
+// documentation for more info.
+func (u *BillProductUpsertBulk) Update(set func(*BillProductUpsert)) *BillProductUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&BillProductUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *BillProductUpsertBulk) SetName(v string) *BillProductUpsertBulk {
+	return u.Update(func(s *BillProductUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *BillProductUpsertBulk) UpdateName() *BillProductUpsertBulk {
+	return u.Update(func(s *BillProductUpsert) {
+		s.UpdateName()
+	})
+}
+
+// SetSku sets the "sku" field.
+func (u *BillProductUpsertBulk) SetSku(v string) *BillProductUpsertBulk {
+	return u.Update(func(s *BillProductUpsert) {
+		s.SetSku(v)
+	})
+}
+
+// UpdateSku sets the "sku" field to the value that was provided on create.
+func (u *BillProductUpsertBulk) UpdateSku() *BillProductUpsertBulk {
+	return u.Update(func(s *BillProductUpsert) {
+		s.UpdateSku()
+	})
+}
+
+// SetQuantity sets the "quantity" field.
+func (u *BillProductUpsertBulk) SetQuantity(v uint64) *BillProductUpsertBulk {
+	return u.Update(func(s *BillProductUpsert) {
+		s.SetQuantity(v)
+	})
+}
+
+// AddQuantity adds v to the "quantity" field.
+func (u *BillProductUpsertBulk) AddQuantity(v uint64) *BillProductUpsertBulk {
+	return u.Update(func(s *BillProductUpsert) {
+		s.AddQuantity(v)
+	})
+}
+
+// UpdateQuantity sets the "quantity" field to the value that was provided on create.
+func (u *BillProductUpsertBulk) UpdateQuantity() *BillProductUpsertBulk {
+	return u.Update(func(s *BillProductUpsert) {
+		s.UpdateQuantity()
+	})
+}
+
+// Exec executes the query.
+func (u *BillProductUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the BillProductCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for BillProductCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *BillProductUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -18,6 +18,8 @@ import (
 func main() {
 	ex, err := entgql.NewExtension(
 		entgql.WithWhereInputs(true),
+		entgql.WithMutationFields(true),
+		entgql.WithGlobalID(true),
 		// This option is disabled in this example,
 		// because the schema file is edited by the
 		// internal/todo/ent example.
@@ -46,6 +48,9 @@ func main() {
 			//
 			// Code generated by entc, DO NOT EDIT.
 		`,
+		Features: []gen.Feature{
+			gen.FeatureUpsert,
+		},
 	}, entc.Extensions(ex))
 	if err != nil {
 		log.Fatalf("running ent codegen: %v", err)
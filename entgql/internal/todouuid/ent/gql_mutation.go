@@ -0,0 +1,326 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/contrib/entgql/internal/todouuid/ent/billproduct"
+	"entgo.io/contrib/entgql/internal/todouuid/ent/predicate"
+	"entgo.io/contrib/entgql/internal/todouuid/ent/todo"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// DefaultMaxBulkRows is the default number of rows that bulk mutations
+// of the MutationResolver are allowed to affect.
+const DefaultMaxBulkRows = 100
+
+// MutationResolver implements the default resolvers of the Mutation fields
+// generated by entgql. The gqlgen resolvers can delegate to its methods, or
+// replace them with custom implementations.
+type MutationResolver struct {
+	// Client is used in case the context does not hold a client,
+	// for example, a transactional client set by entgql.Transactioner.
+	Client *Client
+	// MaxBulkRows caps the number of rows that bulk mutations are allowed
+	// to affect. Zero means DefaultMaxBulkRows.
+	MaxBulkRows int
+}
+
+// client returns the client to run the mutations with.
+func (r *MutationResolver) client(ctx context.Context) *Client {
+	if c := FromContext(ctx); c != nil {
+		return c
+	}
+	return r.Client
+}
+
+// maxBulkRows returns the number of rows bulk mutations are allowed to affect.
+func (r *MutationResolver) maxBulkRows() int {
+	if r.MaxBulkRows > 0 {
+		return r.MaxBulkRows
+	}
+	return DefaultMaxBulkRows
+}
+
+// CreateBillProductPayload is the return response of the createBillProduct mutation.
+type CreateBillProductPayload struct {
+	// BillProduct holds the created billproduct.
+	BillProduct *BillProduct `json:"billProduct"`
+}
+
+// CreateBillProduct creates a new BillProduct from the given input.
+func (r *MutationResolver) CreateBillProduct(ctx context.Context, input CreateBillProductInput) (*CreateBillProductPayload, error) {
+	node, err := r.client(ctx).BillProduct.Create().SetInput(input).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &CreateBillProductPayload{BillProduct: node}, nil
+}
+
+// UpsertBillProductPayload is the return response of the upsertBillProduct mutation.
+type UpsertBillProductPayload struct {
+	// BillProduct holds the created or updated billproduct.
+	BillProduct *BillProduct `json:"billProduct"`
+}
+
+// UpsertBillProduct creates a new BillProduct from the given input, or
+// updates the BillProduct that conflicts with it on the input conflict target.
+func (r *MutationResolver) UpsertBillProduct(ctx context.Context, input UpsertBillProductInput) (*UpsertBillProductPayload, error) {
+	client := r.client(ctx)
+	create := client.BillProduct.Create()
+	if err := create.UpsertInput(input).Exec(ctx); err != nil {
+		return nil, err
+	}
+	// Conflicts may resolve to an existing row. Hence, the
+	// node is queried by the values of its conflict target.
+	columns, fields := input.ConflictTarget.Columns(), input.ConflictTarget.fields()
+	ps := make([]predicate.BillProduct, len(columns))
+	for i := range columns {
+		if v, ok := create.Mutation().Field(fields[i]); ok {
+			ps[i] = sql.FieldEQ(columns[i], v)
+		} else {
+			ps[i] = sql.FieldIsNull(columns[i])
+		}
+	}
+	node, err := client.BillProduct.Query().Where(billproduct.And(ps...)).Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &UpsertBillProductPayload{BillProduct: node}, nil
+}
+
+// CreateCategoryPayload is the return response of the createCategory mutation.
+type CreateCategoryPayload struct {
+	// Category holds the created category.
+	Category *Category `json:"category"`
+}
+
+// CreateCategory creates a new Category from the given input.
+func (r *MutationResolver) CreateCategory(ctx context.Context, input CreateCategoryInput) (*CreateCategoryPayload, error) {
+	node, err := r.client(ctx).Category.Create().SetInput(input).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &CreateCategoryPayload{Category: node}, nil
+}
+
+// UpdateCategoryPayload is the return response of the updateCategory mutation.
+type UpdateCategoryPayload struct {
+	// Category holds the updated category.
+	Category *Category `json:"category"`
+}
+
+// UpdateCategory updates the Category with the given ID using the given input.
+func (r *MutationResolver) UpdateCategory(ctx context.Context, id string, input UpdateCategoryInput) (*UpdateCategoryPayload, error) {
+	uid, err := UnmarshalNodeIDOf(ctx, "Category", id)
+	if err != nil {
+		return nil, err
+	}
+	node, err := r.client(ctx).Category.UpdateOneID(uid).SetInput(input).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &UpdateCategoryPayload{Category: node}, nil
+}
+
+// DeleteCategoryPayload is the return response of the deleteCategory mutation.
+type DeleteCategoryPayload struct {
+	// DeletedID holds the ID of the deleted category.
+	DeletedID uuid.UUID `json:"deletedID"`
+}
+
+// DeleteCategory deletes the Category with the given ID.
+func (r *MutationResolver) DeleteCategory(ctx context.Context, id string) (*DeleteCategoryPayload, error) {
+	uid, err := UnmarshalNodeIDOf(ctx, "Category", id)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.client(ctx).Category.DeleteOneID(uid).Exec(ctx); err != nil {
+		return nil, err
+	}
+	return &DeleteCategoryPayload{DeletedID: uid}, nil
+}
+
+// CreateTodoPayload is the return response of the createTodo mutation.
+type CreateTodoPayload struct {
+	// Todo holds the created todo.
+	Todo *Todo `json:"todo"`
+}
+
+// CreateTodo creates a new Todo from the given input.
+func (r *MutationResolver) CreateTodo(ctx context.Context, input CreateTodoInput) (*CreateTodoPayload, error) {
+	node, err := r.client(ctx).Todo.Create().SetInput(input).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &CreateTodoPayload{Todo: node}, nil
+}
+
+// CreateManyTodoPayload is the return response of the createManyTodo mutation.
+type CreateManyTodoPayload struct {
+	// Todos holds the created todos.
+	Todos []*Todo `json:"todos"`
+}
+
+// CreateManyTodo creates a list of Todos from the given inputs.
+func (r *MutationResolver) CreateManyTodo(ctx context.Context, input []*CreateTodoInput) (*CreateManyTodoPayload, error) {
+	if limit := r.maxBulkRows(); len(input) > limit {
+		return nil, entgql.ErrBulkLimitExceeded(limit)
+	}
+	client := r.client(ctx)
+	builders := make([]*TodoCreate, len(input))
+	for i := range input {
+		builders[i] = client.Todo.Create().SetInput(*input[i])
+	}
+	nodes, err := client.Todo.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &CreateManyTodoPayload{Todos: nodes}, nil
+}
+
+// UpdateTodoPayload is the return response of the updateTodo mutation.
+type UpdateTodoPayload struct {
+	// Todo holds the updated todo.
+	Todo *Todo `json:"todo"`
+}
+
+// UpdateTodo updates the Todo with the given ID using the given input.
+func (r *MutationResolver) UpdateTodo(ctx context.Context, id string, input UpdateTodoInput) (*UpdateTodoPayload, error) {
+	uid, err := UnmarshalNodeIDOf(ctx, "Todo", id)
+	if err != nil {
+		return nil, err
+	}
+	node, err := r.client(ctx).Todo.UpdateOneID(uid).SetInput(input).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &UpdateTodoPayload{Todo: node}, nil
+}
+
+// UpdateTodosPayload is the return response of the updateTodos mutation.
+type UpdateTodosPayload struct {
+	// Todos holds the updated todos.
+	Todos []*Todo `json:"todos"`
+}
+
+// UpdateTodos updates the Todos matching the given filter using the given input.
+func (r *MutationResolver) UpdateTodos(ctx context.Context, where TodoWhereInput, input UpdateTodoInput) (*UpdateTodosPayload, error) {
+	client := r.client(ctx)
+	p, err := where.P()
+	if err != nil {
+		return nil, err
+	}
+	limit := r.maxBulkRows()
+	ids, err := client.Todo.Query().Where(p).Limit(limit + 1).IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) > limit {
+		return nil, entgql.ErrBulkLimitExceeded(limit)
+	}
+	if err := client.Todo.Update().Where(todo.IDIn(ids...)).SetInput(input).Exec(ctx); err != nil {
+		return nil, err
+	}
+	nodes, err := client.Todo.Query().Where(todo.IDIn(ids...)).All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &UpdateTodosPayload{Todos: nodes}, nil
+}
+
+// DeleteTodoPayload is the return response of the deleteTodo mutation.
+type DeleteTodoPayload struct {
+	// DeletedID holds the ID of the deleted todo.
+	DeletedID uuid.UUID `json:"deletedID"`
+}
+
+// DeleteTodo deletes the Todo with the given ID.
+func (r *MutationResolver) DeleteTodo(ctx context.Context, id string) (*DeleteTodoPayload, error) {
+	uid, err := UnmarshalNodeIDOf(ctx, "Todo", id)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.client(ctx).Todo.DeleteOneID(uid).Exec(ctx); err != nil {
+		return nil, err
+	}
+	return &DeleteTodoPayload{DeletedID: uid}, nil
+}
+
+// DeleteTodosPayload is the return response of the deleteTodos mutation.
+type DeleteTodosPayload struct {
+	// DeletedIDs holds the IDs of the deleted todos.
+	DeletedIDs []uuid.UUID `json:"deletedIDs"`
+}
+
+// DeleteTodos deletes the Todos matching the given filter.
+func (r *MutationResolver) DeleteTodos(ctx context.Context, where TodoWhereInput) (*DeleteTodosPayload, error) {
+	client := r.client(ctx)
+	p, err := where.P()
+	if err != nil {
+		return nil, err
+	}
+	limit := r.maxBulkRows()
+	ids, err := client.Todo.Query().Where(p).Limit(limit + 1).IDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) > limit {
+		return nil, entgql.ErrBulkLimitExceeded(limit)
+	}
+	if _, err := client.Todo.Delete().Where(todo.IDIn(ids...)).Exec(ctx); err != nil {
+		return nil, err
+	}
+	return &DeleteTodosPayload{DeletedIDs: ids}, nil
+}
+
+// CreateUserPayload is the return response of the createUser mutation.
+type CreateUserPayload struct {
+	// User holds the created user.
+	User *User `json:"user"`
+}
+
+// CreateUser creates a new User from the given input.
+func (r *MutationResolver) CreateUser(ctx context.Context, input CreateUserInput) (*CreateUserPayload, error) {
+	node, err := r.client(ctx).User.Create().SetInput(input).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &CreateUserPayload{User: node}, nil
+}
+
+// UpdateUserPayload is the return response of the updateUser mutation.
+type UpdateUserPayload struct {
+	// User holds the updated user.
+	User *User `json:"user"`
+}
+
+// UpdateUser updates the User with the given ID using the given input.
+func (r *MutationResolver) UpdateUser(ctx context.Context, id string, input UpdateUserInput) (*UpdateUserPayload, error) {
+	uid, err := UnmarshalNodeIDOf(ctx, "User", id)
+	if err != nil {
+		return nil, err
+	}
+	node, err := r.client(ctx).User.UpdateOneID(uid).SetInput(input).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &UpdateUserPayload{User: node}, nil
+}
@@ -17,14 +17,41 @@
 package ent
 
 import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
 	"time"
 
+	"entgo.io/contrib/entgql"
 	"entgo.io/contrib/entgql/internal/todo/ent/schema/schematype"
+	"entgo.io/contrib/entgql/internal/todouuid/ent/billproduct"
 	"entgo.io/contrib/entgql/internal/todouuid/ent/category"
 	"entgo.io/contrib/entgql/internal/todouuid/ent/todo"
+	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 )
 
+// CreateBillProductInput represents a mutation input for creating billproducts.
+type CreateBillProductInput struct {
+	Name     string
+	Sku      string
+	Quantity uint64
+}
+
+// Mutate applies the CreateBillProductInput on the BillProductMutation builder.
+func (i *CreateBillProductInput) Mutate(m *BillProductMutation) {
+	m.SetName(i.Name)
+	m.SetSku(i.Sku)
+	m.SetQuantity(i.Quantity)
+}
+
+// SetInput applies the change-set in the CreateBillProductInput on the BillProductCreate builder.
+func (c *BillProductCreate) SetInput(i CreateBillProductInput) *BillProductCreate {
+	i.Mutate(c.Mutation())
+	return c
+}
+
 // CreateCategoryInput represents a mutation input for creating categories.
 type CreateCategoryInput struct {
 	Text           string
@@ -169,16 +196,17 @@ func (c *CategoryUpdateOne) SetInput(i UpdateCategoryInput) *CategoryUpdateOne {
 
 // CreateTodoInput represents a mutation input for creating todos.
 type CreateTodoInput struct {
-	Status     todo.Status
-	Priority   *int
-	Text       string
-	Name       *string
-	Init       map[string]interface{}
-	Value      *int
-	ParentID   *uuid.UUID
-	ChildIDs   []uuid.UUID
-	CategoryID *uuid.UUID
-	SecretID   *uuid.UUID
+	Status         todo.Status
+	Priority       *int
+	Text           string
+	Name           *string
+	Init           map[string]interface{}
+	Value          *int
+	ParentID       *uuid.UUID
+	ChildIDs       []uuid.UUID
+	CategoryID     *uuid.UUID
+	SecretID       *uuid.UUID
+	CreateChildren []*CreateTodoInput
 }
 
 // Mutate applies the CreateTodoInput on the TodoMutation builder.
@@ -212,11 +240,44 @@ func (i *CreateTodoInput) Mutate(m *TodoMutation) {
 }
 
 // SetInput applies the change-set in the CreateTodoInput on the TodoCreate builder.
+// The nested nodes of the input are created before the Todo is saved.
 func (c *TodoCreate) SetInput(i CreateTodoInput) *TodoCreate {
 	i.Mutate(c.Mutation())
+	if i.hasNested() {
+		c.hooks = append(c.hooks[:len(c.hooks):len(c.hooks)], func(next Mutator) Mutator {
+			return MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				if err := i.createNested(ctx, c.Mutation()); err != nil {
+					return nil, err
+				}
+				return next.Mutate(ctx, m)
+			})
+		})
+	}
 	return c
 }
 
+// hasNested reports if the CreateTodoInput holds nested inputs.
+func (i *CreateTodoInput) hasNested() bool {
+	return len(i.CreateChildren) > 0
+}
+
+// createNested creates the nodes of the nested inputs and adds them to the TodoMutation.
+func (i *CreateTodoInput) createNested(ctx context.Context, m *TodoMutation) error {
+	ctx, err := entgql.NestedContext(ctx)
+	if err != nil {
+		return err
+	}
+	client := m.Client()
+	for _, v := range i.CreateChildren {
+		node, err := client.Todo.Create().SetInput(*v).Save(ctx)
+		if err != nil {
+			return err
+		}
+		m.AddChildIDs(node.ID)
+	}
+	return nil
+}
+
 // UpdateTodoInput represents a mutation input for updating todos.
 type UpdateTodoInput struct {
 	Status         *todo.Status
@@ -410,3 +471,144 @@ func (c *UserUpdateOne) SetInput(i UpdateUserInput) *UserUpdateOne {
 	i.Mutate(c.Mutation())
 	return c
 }
+
+// BillProductConflictTarget defines the unique fields and indexes
+// by which conflicts of the UpsertBillProductInput are detected.
+type BillProductConflictTarget string
+
+// BillProductConflictTarget values.
+const (
+	BillProductConflictTargetSku BillProductConflictTarget = "SKU"
+)
+
+// String implements fmt.Stringer interface.
+func (e BillProductConflictTarget) String() string {
+	return string(e)
+}
+
+// IsValid reports if the value is a valid BillProductConflictTarget.
+func (e BillProductConflictTarget) IsValid() bool {
+	switch e {
+	case BillProductConflictTargetSku:
+		return true
+	default:
+		return false
+	}
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e BillProductConflictTarget) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *BillProductConflictTarget) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("BillProductConflictTarget %T must be a string", v)
+	}
+	*e = BillProductConflictTarget(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid BillProductConflictTarget", str)
+	}
+	return nil
+}
+
+// Columns returns the columns of the conflict target.
+func (e BillProductConflictTarget) Columns() []string {
+	switch e {
+	case BillProductConflictTargetSku:
+		return []string{billproduct.FieldSku}
+	default:
+		return nil
+	}
+}
+
+// fields returns the names of the fields of the conflict target.
+func (e BillProductConflictTarget) fields() []string {
+	switch e {
+	case BillProductConflictTargetSku:
+		return []string{"sku"}
+	default:
+		return nil
+	}
+}
+
+// BillProductUpsertField defines the fields that the UpsertBillProductInput updates on conflict.
+type BillProductUpsertField string
+
+// BillProductUpsertField values.
+const (
+	BillProductUpsertFieldName     BillProductUpsertField = "NAME"
+	BillProductUpsertFieldSku      BillProductUpsertField = "SKU"
+	BillProductUpsertFieldQuantity BillProductUpsertField = "QUANTITY"
+)
+
+// String implements fmt.Stringer interface.
+func (e BillProductUpsertField) String() string {
+	return string(e)
+}
+
+// IsValid reports if the value is a valid BillProductUpsertField.
+func (e BillProductUpsertField) IsValid() bool {
+	switch e {
+	case BillProductUpsertFieldName, BillProductUpsertFieldSku, BillProductUpsertFieldQuantity:
+		return true
+	default:
+		return false
+	}
+}
+
+// MarshalGQL implements graphql.Marshaler interface.
+func (e BillProductUpsertField) MarshalGQL(w io.Writer) {
+	io.WriteString(w, strconv.Quote(e.String()))
+}
+
+// UnmarshalGQL implements graphql.Unmarshaler interface.
+func (e *BillProductUpsertField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("BillProductUpsertField %T must be a string", v)
+	}
+	*e = BillProductUpsertField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid BillProductUpsertField", str)
+	}
+	return nil
+}
+
+// Column returns the column of the field.
+func (e BillProductUpsertField) Column() string {
+	switch e {
+	case BillProductUpsertFieldName:
+		return billproduct.FieldName
+	case BillProductUpsertFieldSku:
+		return billproduct.FieldSku
+	case BillProductUpsertFieldQuantity:
+		return billproduct.FieldQuantity
+	default:
+		return ""
+	}
+}
+
+// UpsertBillProductInput represents a mutation input for upserting billproducts.
+type UpsertBillProductInput struct {
+	Create         CreateBillProductInput
+	ConflictTarget BillProductConflictTarget
+	Update         []BillProductUpsertField
+}
+
+// UpsertInput applies the UpsertBillProductInput on the BillProductCreate builder.
+// If no update fields are given, all fields set on create are updated on conflict.
+func (c *BillProductCreate) UpsertInput(i UpsertBillProductInput) *BillProductUpsertOne {
+	c.SetInput(i.Create)
+	columns := i.ConflictTarget.Columns()
+	if len(i.Update) > 0 {
+		return c.OnConflict(sql.ConflictColumns(columns...), sql.ResolveWith(func(u *sql.UpdateSet) {
+			for _, f := range i.Update {
+				u.SetExcluded(f.Column())
+			}
+		}))
+	}
+	return c.OnConflictColumns(columns...).UpdateNewValues()
+}
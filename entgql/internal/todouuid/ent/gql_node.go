@@ -100,30 +100,25 @@ func (c *Client) newNodeOpts(opts []NodeOption) *nodeOptions {
 	for _, opt := range opts {
 		opt(nopts)
 	}
-	if nopts.nodeType == nil {
-		nopts.nodeType = func(ctx context.Context, id uuid.UUID) (string, error) {
-			return "", fmt.Errorf("cannot resolve noder (%v) without its type", id)
-		}
-	}
 	return nopts
 }
 
-// Noder returns a Node by its id. If the NodeType was not provided, it will
-// be derived from the id value according to the universal-id configuration.
+// Noder returns a Node by its global id. If the NodeType was not provided, it
+// will be derived from the type encoded in the global id.
 //
 //	c.Noder(ctx, id)
 //	c.Noder(ctx, id, ent.WithNodeType(typeResolver))
-func (c *Client) Noder(ctx context.Context, id uuid.UUID, opts ...NodeOption) (_ Noder, err error) {
+func (c *Client) Noder(ctx context.Context, id string, opts ...NodeOption) (_ Noder, err error) {
 	defer func() {
 		if IsNotFound(err) {
 			err = multierror.Append(err, entgql.ErrNodeNotFound(id))
 		}
 	}()
-	table, err := c.newNodeOpts(opts).nodeType(ctx, id)
+	table, uid, err := c.newNodeOpts(opts).globalID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
-	return c.noder(ctx, table, id)
+	return c.noder(ctx, table, uid)
 }
 
 func (c *Client) noder(ctx context.Context, table string, id uuid.UUID) (Noder, error) {
@@ -187,7 +182,7 @@ func (c *Client) noder(ctx context.Context, table string, id uuid.UUID) (Noder,
 	}
 }
 
-func (c *Client) Noders(ctx context.Context, ids []uuid.UUID, opts ...NodeOption) ([]Noder, error) {
+func (c *Client) Noders(ctx context.Context, ids []string, opts ...NodeOption) ([]Noder, error) {
 	switch len(ids) {
 	case 1:
 		noder, err := c.Noder(ctx, ids[0], opts...)
@@ -202,29 +197,32 @@ func (c *Client) Noders(ctx context.Context, ids []uuid.UUID, opts ...NodeOption
 	noders := make([]Noder, len(ids))
 	errors := make([]error, len(ids))
 	tables := make(map[string][]uuid.UUID)
-	id2idx := make(map[uuid.UUID][]int, len(ids))
+	id2idx := make(map[string]map[uuid.UUID][]int, len(ids))
 	nopts := c.newNodeOpts(opts)
-	for i, id := range ids {
-		table, err := nopts.nodeType(ctx, id)
+	for i, gid := range ids {
+		table, id, err := nopts.globalID(ctx, gid)
 		if err != nil {
 			errors[i] = err
 			continue
 		}
 		tables[table] = append(tables[table], id)
-		id2idx[id] = append(id2idx[id], i)
+		if id2idx[table] == nil {
+			id2idx[table] = make(map[uuid.UUID][]int)
+		}
+		id2idx[table][id] = append(id2idx[table][id], i)
 	}
 
 	for table, ids := range tables {
 		nodes, err := c.noders(ctx, table, ids)
 		if err != nil {
 			for _, id := range ids {
-				for _, idx := range id2idx[id] {
+				for _, idx := range id2idx[table][id] {
 					errors[idx] = err
 				}
 			}
 		} else {
 			for i, id := range ids {
-				for _, idx := range id2idx[id] {
+				for _, idx := range id2idx[table][id] {
 					noders[idx] = nodes[i]
 				}
 			}
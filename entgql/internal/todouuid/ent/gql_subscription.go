@@ -0,0 +1,196 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// Code generated by entc, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/contrib/entgql/internal/todouuid/ent/predicate"
+	"entgo.io/contrib/entgql/internal/todouuid/ent/todo"
+	"entgo.io/ent"
+	"github.com/google/uuid"
+)
+
+// SubscriptionHook returns a hook that publishes the mutations of the types
+// with Subscription fields to the given PubSub. Mutations that run in a
+// transaction are published only after it was committed.
+//
+// Publishing errors do not fail the mutations. They are passed to onError,
+// or logged if it is nil.
+//
+//	client.Use(ent.SubscriptionHook(ps, nil))
+func SubscriptionHook(ps entgql.PubSub, onError func(context.Context, error)) Hook {
+	if onError == nil {
+		onError = func(_ context.Context, err error) {
+			log.Printf("ent: publishing subscription events: %v", err)
+		}
+	}
+	return func(next Mutator) Mutator {
+		return MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+			switch m := m.(type) {
+			case *TodoMutation:
+				return publishTodo(ctx, ps, onError, m, next)
+			default:
+				return next.Mutate(ctx, m)
+			}
+		})
+	}
+}
+
+// publishOnCommit calls publish after the transaction of the mutation
+// was committed, or immediately if the mutation does not run in one.
+func publishOnCommit(ctx context.Context, m interface{ Tx() (*Tx, error) }, publish func(context.Context)) {
+	tx, err := m.Tx()
+	if err != nil {
+		publish(ctx)
+		return
+	}
+	tx.OnCommit(func(next Committer) Committer {
+		return CommitFunc(func(ctx context.Context, tx *Tx) error {
+			if err := next.Commit(ctx, tx); err != nil {
+				return err
+			}
+			publish(ctx)
+			return nil
+		})
+	})
+}
+
+// SubscriptionResolver implements the default resolvers of the Subscription
+// fields generated by entgql. The events are received from the PubSub that
+// the SubscriptionHook publishes to.
+type SubscriptionResolver struct {
+	// Client is used for loading the nodes of the events.
+	Client *Client
+	// PubSub is used for receiving the events.
+	PubSub entgql.PubSub
+}
+
+// publishTodo publishes the IDs of the todos affected by the TodoMutation.
+func publishTodo(ctx context.Context, ps entgql.PubSub, onError func(context.Context, error), m *TodoMutation, next Mutator) (Value, error) {
+	var (
+		ids   []uuid.UUID
+		err   error
+		topic = "todoUpdated"
+	)
+	switch op := m.Op(); {
+	case op.Is(ent.OpCreate):
+		topic = "todoCreated"
+	case op.Is(ent.OpDelete | ent.OpDeleteOne):
+		topic = "todoDeleted"
+		fallthrough
+	default:
+		// The IDs are resolved before the mutation, as
+		// its predicates may not match the nodes after it.
+		if ids, err = m.IDs(ctx); err != nil {
+			return nil, err
+		}
+	}
+	v, err := next.Mutate(ctx, m)
+	if err != nil {
+		return nil, err
+	}
+	if id, ok := m.ID(); ok && m.Op().Is(ent.OpCreate) {
+		ids = append(ids, id)
+	}
+	publishOnCommit(ctx, m, func(ctx context.Context) {
+		for _, id := range ids {
+			if err := ps.Publish(ctx, topic, id); err != nil {
+				onError(ctx, err)
+			}
+		}
+	})
+	return v, nil
+}
+
+// TodoCreated delivers the created Todos matching the given filter.
+func (r *SubscriptionResolver) TodoCreated(ctx context.Context, where *TodoWhereInput) (<-chan *Todo, error) {
+	return r.subscribeTodo(ctx, "todoCreated", where)
+}
+
+// TodoUpdated delivers the updated Todos matching the given filter.
+func (r *SubscriptionResolver) TodoUpdated(ctx context.Context, where *TodoWhereInput) (<-chan *Todo, error) {
+	return r.subscribeTodo(ctx, "todoUpdated", where)
+}
+
+// TodoDeleted delivers the IDs of the deleted Todos.
+func (r *SubscriptionResolver) TodoDeleted(ctx context.Context) (<-chan uuid.UUID, error) {
+	msgs, err := r.PubSub.Subscribe(ctx, "todoDeleted")
+	if err != nil {
+		return nil, err
+	}
+	ch := make(chan uuid.UUID)
+	go func() {
+		defer close(ch)
+		for msg := range msgs {
+			id, ok := msg.(uuid.UUID)
+			if !ok {
+				continue
+			}
+			select {
+			case ch <- id:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch, nil
+}
+
+// subscribeTodo delivers the Todos of the topic events matching the given filter.
+func (r *SubscriptionResolver) subscribeTodo(ctx context.Context, topic string, where *TodoWhereInput) (<-chan *Todo, error) {
+	var ps []predicate.Todo
+	if where != nil {
+		p, err := where.P()
+		switch {
+		case errors.Is(err, ErrEmptyTodoWhereInput):
+		case err != nil:
+			return nil, err
+		default:
+			ps = append(ps, p)
+		}
+	}
+	msgs, err := r.PubSub.Subscribe(ctx, topic)
+	if err != nil {
+		return nil, err
+	}
+	ch := make(chan *Todo)
+	go func() {
+		defer close(ch)
+		for msg := range msgs {
+			id, ok := msg.(uuid.UUID)
+			if !ok {
+				continue
+			}
+			// Nodes that were deleted since, or that
+			// do not match the filter are skipped.
+			node, err := r.Client.Todo.Query().Where(append(ps, todo.ID(id))...).Only(ctx)
+			if err != nil {
+				continue
+			}
+			select {
+			case ch <- node:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch, nil
+}
@@ -34,6 +34,13 @@ var (
 		Name:       "bill_products",
 		Columns:    BillProductsColumns,
 		PrimaryKey: []*schema.Column{BillProductsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "billproduct_sku",
+				Unique:  true,
+				Columns: []*schema.Column{BillProductsColumns[2]},
+			},
+		},
 	}
 	// CategoriesColumns holds the columns for the "categories" table.
 	CategoriesColumns = []*schema.Column{
@@ -15,9 +15,12 @@
 package schema
 
 import (
+	"entgo.io/contrib/entgql"
 	"entgo.io/contrib/entgql/internal/todo/ent/schema"
 	"entgo.io/ent"
+	entschema "entgo.io/ent/schema"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
 )
 
@@ -41,3 +44,21 @@ func (BillProduct) Fields() []ent.Field {
 			Default(uuid.New),
 	}
 }
+
+// Indexes returns BillProduct indexes.
+func (BillProduct) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("sku").
+			Unique(),
+	}
+}
+
+// Annotations returns BillProduct annotations.
+func (BillProduct) Annotations() []entschema.Annotation {
+	return []entschema.Annotation{
+		entgql.Mutations(
+			entgql.MutationCreate(),
+			entgql.MutationUpsert(),
+		),
+	}
+}
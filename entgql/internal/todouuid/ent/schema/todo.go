@@ -15,8 +15,10 @@
 package schema
 
 import (
+	"entgo.io/contrib/entgql"
 	todoschema "entgo.io/contrib/entgql/internal/todo/ent/schema"
 	"entgo.io/ent"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/field"
 
 	"github.com/google/uuid"
@@ -44,3 +46,10 @@ func (Todo) Fields() []ent.Field {
 			Immutable(),
 	}
 }
+
+// Annotations returns todo annotations.
+func (Todo) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Subscriptions(),
+	}
+}
@@ -26,6 +26,8 @@ import (
 	"entgo.io/contrib/entgql/internal/todouuid/ent/category"
 	"entgo.io/contrib/entgql/internal/todouuid/ent/todo"
 	"entgo.io/contrib/entgql/internal/todouuid/ent/verysecret"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/google/uuid"
@@ -36,6 +38,7 @@ type TodoCreate struct {
 	config
 	mutation *TodoMutation
 	hooks    []Hook
+	conflict []sql.ConflictOption
 }
 
 // SetCreatedAt sets the "created_at" field.
@@ -327,6 +330,7 @@ func (tc *TodoCreate) createSpec() (*Todo, *sqlgraph.CreateSpec) {
 		_node = &Todo{config: tc.config}
 		_spec = sqlgraph.NewCreateSpec(todo.Table, sqlgraph.NewFieldSpec(todo.FieldID, field.TypeUUID))
 	)
+	_spec.OnConflict = tc.conflict
 	if id, ok := tc.mutation.ID(); ok {
 		_node.ID = id
 		_spec.ID.Value = &id
@@ -441,11 +445,478 @@ func (tc *TodoCreate) createSpec() (*Todo, *sqlgraph.CreateSpec) {
 	return _node, _spec
 }
 
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Todo.Create().
+//		SetCreatedAt(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TodoUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (tc *TodoCreate) OnConflict(opts ...sql.ConflictOption) *TodoUpsertOne {
+	tc.conflict = opts
+	return &TodoUpsertOne{
+		create: tc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Todo.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tc *TodoCreate) OnConflictColumns(columns ...string) *TodoUpsertOne {
+	tc.conflict = append(tc.conflict, sql.ConflictColumns(columns...))
+	return &TodoUpsertOne{
+		create: tc,
+	}
+}
+
+type (
+	// TodoUpsertOne is the builder for "upsert"-ing
+	//  one Todo node.
+	TodoUpsertOne struct {
+		create *TodoCreate
+	}
+
+	// TodoUpsert is the "OnConflict" setter.
+	TodoUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// SetStatus sets the "status" field.
+func (u *TodoUpsert) SetStatus(v todo.Status) *TodoUpsert {
+	u.Set(todo.FieldStatus, v)
+	return u
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TodoUpsert) UpdateStatus() *TodoUpsert {
+	u.SetExcluded(todo.FieldStatus)
+	return u
+}
+
+// SetPriority sets the "priority" field.
+func (u *TodoUpsert) SetPriority(v int) *TodoUpsert {
+	u.Set(todo.FieldPriority, v)
+	return u
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *TodoUpsert) UpdatePriority() *TodoUpsert {
+	u.SetExcluded(todo.FieldPriority)
+	return u
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *TodoUpsert) AddPriority(v int) *TodoUpsert {
+	u.Add(todo.FieldPriority, v)
+	return u
+}
+
+// SetText sets the "text" field.
+func (u *TodoUpsert) SetText(v string) *TodoUpsert {
+	u.Set(todo.FieldText, v)
+	return u
+}
+
+// UpdateText sets the "text" field to the value that was provided on create.
+func (u *TodoUpsert) UpdateText() *TodoUpsert {
+	u.SetExcluded(todo.FieldText)
+	return u
+}
+
+// SetName sets the "name" field.
+func (u *TodoUpsert) SetName(v string) *TodoUpsert {
+	u.Set(todo.FieldName, v)
+	return u
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *TodoUpsert) UpdateName() *TodoUpsert {
+	u.SetExcluded(todo.FieldName)
+	return u
+}
+
+// ClearName clears the value of the "name" field.
+func (u *TodoUpsert) ClearName() *TodoUpsert {
+	u.SetNull(todo.FieldName)
+	return u
+}
+
+// SetBlob sets the "blob" field.
+func (u *TodoUpsert) SetBlob(v []byte) *TodoUpsert {
+	u.Set(todo.FieldBlob, v)
+	return u
+}
+
+// UpdateBlob sets the "blob" field to the value that was provided on create.
+func (u *TodoUpsert) UpdateBlob() *TodoUpsert {
+	u.SetExcluded(todo.FieldBlob)
+	return u
+}
+
+// ClearBlob clears the value of the "blob" field.
+func (u *TodoUpsert) ClearBlob() *TodoUpsert {
+	u.SetNull(todo.FieldBlob)
+	return u
+}
+
+// SetInit sets the "init" field.
+func (u *TodoUpsert) SetInit(v map[string]interface{}) *TodoUpsert {
+	u.Set(todo.FieldInit, v)
+	return u
+}
+
+// UpdateInit sets the "init" field to the value that was provided on create.
+func (u *TodoUpsert) UpdateInit() *TodoUpsert {
+	u.SetExcluded(todo.FieldInit)
+	return u
+}
+
+// ClearInit clears the value of the "init" field.
+func (u *TodoUpsert) ClearInit() *TodoUpsert {
+	u.SetNull(todo.FieldInit)
+	return u
+}
+
+// SetCustom sets the "custom" field.
+func (u *TodoUpsert) SetCustom(v []customstruct.Custom) *TodoUpsert {
+	u.Set(todo.FieldCustom, v)
+	return u
+}
+
+// UpdateCustom sets the "custom" field to the value that was provided on create.
+func (u *TodoUpsert) UpdateCustom() *TodoUpsert {
+	u.SetExcluded(todo.FieldCustom)
+	return u
+}
+
+// ClearCustom clears the value of the "custom" field.
+func (u *TodoUpsert) ClearCustom() *TodoUpsert {
+	u.SetNull(todo.FieldCustom)
+	return u
+}
+
+// SetCustomp sets the "customp" field.
+func (u *TodoUpsert) SetCustomp(v []*customstruct.Custom) *TodoUpsert {
+	u.Set(todo.FieldCustomp, v)
+	return u
+}
+
+// UpdateCustomp sets the "customp" field to the value that was provided on create.
+func (u *TodoUpsert) UpdateCustomp() *TodoUpsert {
+	u.SetExcluded(todo.FieldCustomp)
+	return u
+}
+
+// ClearCustomp clears the value of the "customp" field.
+func (u *TodoUpsert) ClearCustomp() *TodoUpsert {
+	u.SetNull(todo.FieldCustomp)
+	return u
+}
+
+// SetValue sets the "value" field.
+func (u *TodoUpsert) SetValue(v int) *TodoUpsert {
+	u.Set(todo.FieldValue, v)
+	return u
+}
+
+// UpdateValue sets the "value" field to the value that was provided on create.
+func (u *TodoUpsert) UpdateValue() *TodoUpsert {
+	u.SetExcluded(todo.FieldValue)
+	return u
+}
+
+// AddValue adds v to the "value" field.
+func (u *TodoUpsert) AddValue(v int) *TodoUpsert {
+	u.Add(todo.FieldValue, v)
+	return u
+}
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.Todo.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(todo.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TodoUpsertOne) UpdateNewValues() *TodoUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(todo.FieldID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(todo.FieldCreatedAt)
+		}
+		if _, exists := u.create.mutation.CategoryID(); exists {
+			s.SetIgnore(todo.FieldCategoryID)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Todo.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *TodoUpsertOne) Ignore() *TodoUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TodoUpsertOne) DoNothing() *TodoUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TodoCreate.OnConflict
+// documentation for more info.
+func (u *TodoUpsertOne) Update(set func(*TodoUpsert)) *TodoUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TodoUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *TodoUpsertOne) SetStatus(v todo.Status) *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TodoUpsertOne) UpdateStatus() *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *TodoUpsertOne) SetPriority(v int) *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *TodoUpsertOne) AddPriority(v int) *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *TodoUpsertOne) UpdatePriority() *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetText sets the "text" field.
+func (u *TodoUpsertOne) SetText(v string) *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.SetText(v)
+	})
+}
+
+// UpdateText sets the "text" field to the value that was provided on create.
+func (u *TodoUpsertOne) UpdateText() *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.UpdateText()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *TodoUpsertOne) SetName(v string) *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *TodoUpsertOne) UpdateName() *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.UpdateName()
+	})
+}
+
+// ClearName clears the value of the "name" field.
+func (u *TodoUpsertOne) ClearName() *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.ClearName()
+	})
+}
+
+// SetBlob sets the "blob" field.
+func (u *TodoUpsertOne) SetBlob(v []byte) *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.SetBlob(v)
+	})
+}
+
+// UpdateBlob sets the "blob" field to the value that was provided on create.
+func (u *TodoUpsertOne) UpdateBlob() *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.UpdateBlob()
+	})
+}
+
+// ClearBlob clears the value of the "blob" field.
+func (u *TodoUpsertOne) ClearBlob() *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.ClearBlob()
+	})
+}
+
+// SetInit sets the "init" field.
+func (u *TodoUpsertOne) SetInit(v map[string]interface{}) *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.SetInit(v)
+	})
+}
+
+// UpdateInit sets the "init" field to the value that was provided on create.
+func (u *TodoUpsertOne) UpdateInit() *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.UpdateInit()
+	})
+}
+
+// ClearInit clears the value of the "init" field.
+func (u *TodoUpsertOne) ClearInit() *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.ClearInit()
+	})
+}
+
+// SetCustom sets the "custom" field.
+func (u *TodoUpsertOne) SetCustom(v []customstruct.Custom) *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.SetCustom(v)
+	})
+}
+
+// UpdateCustom sets the "custom" field to the value that was provided on create.
+func (u *TodoUpsertOne) UpdateCustom() *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.UpdateCustom()
+	})
+}
+
+// ClearCustom clears the value of the "custom" field.
+func (u *TodoUpsertOne) ClearCustom() *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.ClearCustom()
+	})
+}
+
+// SetCustomp sets the "customp" field.
+func (u *TodoUpsertOne) SetCustomp(v []*customstruct.Custom) *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.SetCustomp(v)
+	})
+}
+
+// UpdateCustomp sets the "customp" field to the value that was provided on create.
+func (u *TodoUpsertOne) UpdateCustomp() *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.UpdateCustomp()
+	})
+}
+
+// ClearCustomp clears the value of the "customp" field.
+func (u *TodoUpsertOne) ClearCustomp() *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.ClearCustomp()
+	})
+}
+
+// SetValue sets the "value" field.
+func (u *TodoUpsertOne) SetValue(v int) *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.SetValue(v)
+	})
+}
+
+// AddValue adds v to the "value" field.
+func (u *TodoUpsertOne) AddValue(v int) *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.AddValue(v)
+	})
+}
+
+// UpdateValue sets the "value" field to the value that was provided on create.
+func (u *TodoUpsertOne) UpdateValue() *TodoUpsertOne {
+	return u.Update(func(s *TodoUpsert) {
+		s.UpdateValue()
+	})
+}
+
+// Exec executes the query.
+func (u *TodoUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TodoCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TodoUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *TodoUpsertOne) ID(ctx context.Context) (id uuid.UUID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("ent: TodoUpsertOne.ID is not supported by MySQL driver. Use TodoUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *TodoUpsertOne) IDX(ctx context.Context) uuid.UUID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
 // TodoCreateBulk is the builder for creating many Todo entities in bulk.
 type TodoCreateBulk struct {
 	config
 	err      error
 	builders []*TodoCreate
+	conflict []sql.ConflictOption
 }
 
 // Save creates the Todo entities in the database.
@@ -475,6 +946,7 @@ func (tcb *TodoCreateBulk) Save(ctx context.Context) ([]*Todo, error) {
 					_, err = mutators[i+1].Mutate(root, tcb.builders[i+1].mutation)
 				} else {
 					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = tcb.conflict
 					// Invoke the actual operation on the latest mutation in the chain.
 					if err = sqlgraph.BatchCreate(ctx, tcb.driver, spec); err != nil {
 						if sqlgraph.IsConstraintError(err) {
@@ -524,3 +996,298 @@ func (tcb *TodoCreateBulk) ExecX(ctx context.Context) {
 		panic(err)
 	}
 }
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.Todo.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.TodoUpsert) {
+//			SetCreatedAt(v+v).
+//		}).
+//		Exec(ctx)
+func (tcb *TodoCreateBulk) OnConflict(opts ...sql.ConflictOption) *TodoUpsertBulk {
+	tcb.conflict = opts
+	return &TodoUpsertBulk{
+		create: tcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.Todo.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (tcb *TodoCreateBulk) OnConflictColumns(columns ...string) *TodoUpsertBulk {
+	tcb.conflict = append(tcb.conflict, sql.ConflictColumns(columns...))
+	return &TodoUpsertBulk{
+		create: tcb,
+	}
+}
+
+// TodoUpsertBulk is the builder for "upsert"-ing
+// a bulk of Todo nodes.
+type TodoUpsertBulk struct {
+	create *TodoCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.Todo.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(todo.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *TodoUpsertBulk) UpdateNewValues() *TodoUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(todo.FieldID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(todo.FieldCreatedAt)
+			}
+			if _, exists := b.mutation.CategoryID(); exists {
+				s.SetIgnore(todo.FieldCategoryID)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.Todo.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *TodoUpsertBulk) Ignore() *TodoUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *TodoUpsertBulk) DoNothing() *TodoUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the TodoCreateBulk.OnConflict
+// documentation for more info.
+func (u *TodoUpsertBulk) Update(set func(*TodoUpsert)) *TodoUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&TodoUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// SetStatus sets the "status" field.
+func (u *TodoUpsertBulk) SetStatus(v todo.Status) *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.SetStatus(v)
+	})
+}
+
+// UpdateStatus sets the "status" field to the value that was provided on create.
+func (u *TodoUpsertBulk) UpdateStatus() *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.UpdateStatus()
+	})
+}
+
+// SetPriority sets the "priority" field.
+func (u *TodoUpsertBulk) SetPriority(v int) *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.SetPriority(v)
+	})
+}
+
+// AddPriority adds v to the "priority" field.
+func (u *TodoUpsertBulk) AddPriority(v int) *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.AddPriority(v)
+	})
+}
+
+// UpdatePriority sets the "priority" field to the value that was provided on create.
+func (u *TodoUpsertBulk) UpdatePriority() *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.UpdatePriority()
+	})
+}
+
+// SetText sets the "text" field.
+func (u *TodoUpsertBulk) SetText(v string) *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.SetText(v)
+	})
+}
+
+// UpdateText sets the "text" field to the value that was provided on create.
+func (u *TodoUpsertBulk) UpdateText() *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.UpdateText()
+	})
+}
+
+// SetName sets the "name" field.
+func (u *TodoUpsertBulk) SetName(v string) *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.SetName(v)
+	})
+}
+
+// UpdateName sets the "name" field to the value that was provided on create.
+func (u *TodoUpsertBulk) UpdateName() *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.UpdateName()
+	})
+}
+
+// ClearName clears the value of the "name" field.
+func (u *TodoUpsertBulk) ClearName() *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.ClearName()
+	})
+}
+
+// SetBlob sets the "blob" field.
+func (u *TodoUpsertBulk) SetBlob(v []byte) *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.SetBlob(v)
+	})
+}
+
+// UpdateBlob sets the "blob" field to the value that was provided on create.
+func (u *TodoUpsertBulk) UpdateBlob() *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.UpdateBlob()
+	})
+}
+
+// ClearBlob clears the value of the "blob" field.
+func (u *TodoUpsertBulk) ClearBlob() *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.ClearBlob()
+	})
+}
+
+// SetInit sets the "init" field.
+func (u *TodoUpsertBulk) SetInit(v map[string]interface{}) *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.SetInit(v)
+	})
+}
+
+// UpdateInit sets the "init" field to the value that was provided on create.
+func (u *TodoUpsertBulk) UpdateInit() *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.UpdateInit()
+	})
+}
+
+// ClearInit clears the value of the "init" field.
+func (u *TodoUpsertBulk) ClearInit() *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.ClearInit()
+	})
+}
+
+// SetCustom sets the "custom" field.
+func (u *TodoUpsertBulk) SetCustom(v []customstruct.Custom) *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.SetCustom(v)
+	})
+}
+
+// UpdateCustom sets the "custom" field to the value that was provided on create.
+func (u *TodoUpsertBulk) UpdateCustom() *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.UpdateCustom()
+	})
+}
+
+// ClearCustom clears the value of the "custom" field.
+func (u *TodoUpsertBulk) ClearCustom() *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.ClearCustom()
+	})
+}
+
+// SetCustomp sets the "customp" field.
+func (u *TodoUpsertBulk) SetCustomp(v []*customstruct.Custom) *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.SetCustomp(v)
+	})
+}
+
+// UpdateCustomp sets the "customp" field to the value that was provided on create.
+func (u *TodoUpsertBulk) UpdateCustomp() *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.UpdateCustomp()
+	})
+}
+
+// ClearCustomp clears the value of the "customp" field.
+func (u *TodoUpsertBulk) ClearCustomp() *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.ClearCustomp()
+	})
+}
+
+// SetValue sets the "value" field.
+func (u *TodoUpsertBulk) SetValue(v int) *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.SetValue(v)
+	})
+}
+
+// AddValue adds v to the "value" field.
+func (u *TodoUpsertBulk) AddValue(v int) *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.AddValue(v)
+	})
+}
+
+// UpdateValue sets the "value" field to the value that was provided on create.
+func (u *TodoUpsertBulk) UpdateValue() *TodoUpsertBulk {
+	return u.Update(func(s *TodoUpsert) {
+		s.UpdateValue()
+	})
+}
+
+// Exec executes the query.
+func (u *TodoUpsertBulk) Exec(ctx context.Context) error {
+	if u.create.err != nil {
+		return u.create.err
+	}
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("ent: OnConflict was set for builder %d. Set it on the TodoCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("ent: missing options for TodoCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *TodoUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
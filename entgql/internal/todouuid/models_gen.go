@@ -26,7 +26,7 @@ type CategoryTypesInput struct {
 }
 
 type OneToMany struct {
-	ID       uuid.UUID    `json:"id"`
+	ID       string       `json:"id"`
 	Name     string       `json:"name"`
 	Field2   *string      `json:"field2,omitempty"`
 	Parent   *OneToMany   `json:"parent,omitempty"`
@@ -68,14 +68,14 @@ type OneToManyWhereInput struct {
 	And []*OneToManyWhereInput `json:"and,omitempty"`
 	Or  []*OneToManyWhereInput `json:"or,omitempty"`
 	// id field predicates
-	ID      *uuid.UUID  `json:"id,omitempty"`
-	IDNeq   *uuid.UUID  `json:"idNEQ,omitempty"`
-	IDIn    []uuid.UUID `json:"idIn,omitempty"`
-	IDNotIn []uuid.UUID `json:"idNotIn,omitempty"`
-	IDGt    *uuid.UUID  `json:"idGT,omitempty"`
-	IDGte   *uuid.UUID  `json:"idGTE,omitempty"`
-	IDLt    *uuid.UUID  `json:"idLT,omitempty"`
-	IDLte   *uuid.UUID  `json:"idLTE,omitempty"`
+	ID      *string  `json:"id,omitempty"`
+	IDNeq   *string  `json:"idNEQ,omitempty"`
+	IDIn    []string `json:"idIn,omitempty"`
+	IDNotIn []string `json:"idNotIn,omitempty"`
+	IDGt    *string  `json:"idGT,omitempty"`
+	IDGte   *string  `json:"idGTE,omitempty"`
+	IDLt    *string  `json:"idLT,omitempty"`
+	IDLte   *string  `json:"idLTE,omitempty"`
 	// name field predicates
 	Name             *string  `json:"name,omitempty"`
 	NameNeq          *string  `json:"nameNEQ,omitempty"`
@@ -121,14 +121,14 @@ type OrganizationWhereInput struct {
 	And []*OrganizationWhereInput `json:"and,omitempty"`
 	Or  []*OrganizationWhereInput `json:"or,omitempty"`
 	// id field predicates
-	ID      *uuid.UUID  `json:"id,omitempty"`
-	IDNeq   *uuid.UUID  `json:"idNEQ,omitempty"`
-	IDIn    []uuid.UUID `json:"idIn,omitempty"`
-	IDNotIn []uuid.UUID `json:"idNotIn,omitempty"`
-	IDGt    *uuid.UUID  `json:"idGT,omitempty"`
-	IDGte   *uuid.UUID  `json:"idGTE,omitempty"`
-	IDLt    *uuid.UUID  `json:"idLT,omitempty"`
-	IDLte   *uuid.UUID  `json:"idLTE,omitempty"`
+	ID      *string  `json:"id,omitempty"`
+	IDNeq   *string  `json:"idNEQ,omitempty"`
+	IDIn    []string `json:"idIn,omitempty"`
+	IDNotIn []string `json:"idNotIn,omitempty"`
+	IDGt    *string  `json:"idGT,omitempty"`
+	IDGte   *string  `json:"idGTE,omitempty"`
+	IDLt    *string  `json:"idLT,omitempty"`
+	IDLte   *string  `json:"idLTE,omitempty"`
 	// name field predicates
 	Name             *string  `json:"name,omitempty"`
 	NameNeq          *string  `json:"nameNEQ,omitempty"`
@@ -146,7 +146,7 @@ type OrganizationWhereInput struct {
 }
 
 type Project struct {
-	ID    uuid.UUID           `json:"id"`
+	ID    string              `json:"id"`
 	Todos *ent.TodoConnection `json:"todos"`
 }
 
@@ -159,14 +159,14 @@ type ProjectWhereInput struct {
 	And []*ProjectWhereInput `json:"and,omitempty"`
 	Or  []*ProjectWhereInput `json:"or,omitempty"`
 	// id field predicates
-	ID      *uuid.UUID  `json:"id,omitempty"`
-	IDNeq   *uuid.UUID  `json:"idNEQ,omitempty"`
-	IDIn    []uuid.UUID `json:"idIn,omitempty"`
-	IDNotIn []uuid.UUID `json:"idNotIn,omitempty"`
-	IDGt    *uuid.UUID  `json:"idGT,omitempty"`
-	IDGte   *uuid.UUID  `json:"idGTE,omitempty"`
-	IDLt    *uuid.UUID  `json:"idLT,omitempty"`
-	IDLte   *uuid.UUID  `json:"idLTE,omitempty"`
+	ID      *string  `json:"id,omitempty"`
+	IDNeq   *string  `json:"idNEQ,omitempty"`
+	IDIn    []string `json:"idIn,omitempty"`
+	IDNotIn []string `json:"idNotIn,omitempty"`
+	IDGt    *string  `json:"idGT,omitempty"`
+	IDGte   *string  `json:"idGTE,omitempty"`
+	IDLt    *string  `json:"idLT,omitempty"`
+	IDLte   *string  `json:"idLTE,omitempty"`
 	// todos edge predicates
 	HasTodos     *bool                 `json:"hasTodos,omitempty"`
 	HasTodosWith []*ent.TodoWhereInput `json:"hasTodosWith,omitempty"`
@@ -176,8 +176,8 @@ type ProjectWhereInput struct {
 // Input was generated by ent.
 type UpdateFriendshipInput struct {
 	CreatedAt *time.Time `json:"createdAt,omitempty"`
-	UserID    *uuid.UUID `json:"userID,omitempty"`
-	FriendID  *uuid.UUID `json:"friendID,omitempty"`
+	UserID    *string    `json:"userID,omitempty"`
+	FriendID  *string    `json:"friendID,omitempty"`
 }
 
 // Properties by which OneToMany connections can be ordered.
@@ -48,8 +48,15 @@ func (r *mutationResolver) CreateTodo(ctx context.Context, input ent.CreateTodoI
 }
 
 // UpdateTodo is the resolver for the updateTodo field.
-func (r *mutationResolver) UpdateTodo(ctx context.Context, id uuid.UUID, input ent.UpdateTodoInput) (*ent.Todo, error) {
-	panic(fmt.Errorf("not implemented"))
+func (r *mutationResolver) UpdateTodo(ctx context.Context, id string, input ent.UpdateTodoInput) (*ent.Todo, error) {
+	uid, err := ent.UnmarshalNodeIDOf(ctx, "Todo", id)
+	if err != nil {
+		return nil, err
+	}
+	return ent.FromContext(ctx).Todo.
+		UpdateOneID(uid).
+		SetInput(input).
+		Save(ctx)
 }
 
 // ClearTodos is the resolver for the clearTodos field.
@@ -61,7 +68,7 @@ func (r *mutationResolver) ClearTodos(ctx context.Context) (int, error) {
 }
 
 // UpdateFriendship is the resolver for the updateFriendship field.
-func (r *mutationResolver) UpdateFriendship(ctx context.Context, id uuid.UUID, input UpdateFriendshipInput) (*ent.Friendship, error) {
+func (r *mutationResolver) UpdateFriendship(ctx context.Context, id string, input UpdateFriendshipInput) (*ent.Friendship, error) {
 	panic(fmt.Errorf("not implemented"))
 }
 
@@ -70,6 +77,11 @@ func (r *queryResolver) Ping(ctx context.Context) (string, error) {
 	panic(fmt.Errorf("not implemented"))
 }
 
+// TopTodos is the resolver for the topTodos field.
+func (r *queryResolver) TopTodos(ctx context.Context, limit int) ([]*ent.Todo, error) {
+	panic(fmt.Errorf("not implemented"))
+}
+
 // TodosWithJoins is the resolver for the todosWithJoins field.
 func (r *queryResolver) TodosWithJoins(ctx context.Context, after *entgql.Cursor[uuid.UUID], first *int, before *entgql.Cursor[uuid.UUID], last *int, orderBy []*ent.TodoOrder, where *ent.TodoWhereInput) (*ent.TodoConnection, error) {
 	panic(fmt.Errorf("not implemented"))
@@ -0,0 +1,297 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package todo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/contrib/entgql/internal/todouuid/ent"
+	"entgo.io/contrib/entgql/internal/todouuid/ent/category"
+	"entgo.io/contrib/entgql/internal/todouuid/ent/enttest"
+	"entgo.io/contrib/entgql/internal/todouuid/ent/todo"
+
+	"entgo.io/ent/dialect"
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/google/uuid"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMutationResolver(t *testing.T) {
+	ctx := context.Background()
+	ec := enttest.Open(
+		t, dialect.SQLite,
+		fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()),
+	)
+	r := &ent.MutationResolver{Client: ec, MaxBulkRows: 2}
+
+	created, err := r.CreateTodo(ctx, ent.CreateTodoInput{Text: "a", Status: todo.StatusInProgress})
+	require.NoError(t, err)
+	require.Equal(t, "a", created.Todo.Text)
+
+	many, err := r.CreateManyTodo(ctx, []*ent.CreateTodoInput{
+		{Text: "b", Status: todo.StatusInProgress},
+		{Text: "c", Status: todo.StatusCompleted},
+	})
+	require.NoError(t, err)
+	require.Len(t, many.Todos, 2)
+
+	_, err = r.CreateManyTodo(ctx, []*ent.CreateTodoInput{
+		{Text: "d", Status: todo.StatusInProgress},
+		{Text: "e", Status: todo.StatusInProgress},
+		{Text: "f", Status: todo.StatusInProgress},
+	})
+	require.EqualError(t, err, "input: Bulk mutation exceeds the limit of 2 affected rows")
+	require.Equal(t, 3, ec.Todo.Query().CountX(ctx))
+
+	// The IDs are passed to the resolvers as global IDs.
+	text := "updated"
+	gid, err := entgql.ToGlobalID("Todo", created.Todo.ID)
+	require.NoError(t, err)
+	updated, err := r.UpdateTodo(ctx, gid, ent.UpdateTodoInput{Text: &text})
+	require.NoError(t, err)
+	require.Equal(t, text, updated.Todo.Text)
+	_, err = r.UpdateTodo(ctx, created.Todo.ID.String(), ent.UpdateTodoInput{Text: &text})
+	require.ErrorContains(t, err, "invalid global id")
+	gid, err = entgql.ToGlobalID("Category", created.Todo.ID)
+	require.NoError(t, err)
+	_, err = r.UpdateTodo(ctx, gid, ent.UpdateTodoInput{Text: &text})
+	require.ErrorContains(t, err, `is of type "Category", expected "Todo"`)
+
+	status := todo.StatusInProgress
+	bulk, err := r.UpdateTodos(ctx, ent.TodoWhereInput{Status: &status}, ent.UpdateTodoInput{Text: &text})
+	require.NoError(t, err)
+	require.Len(t, bulk.Todos, 2)
+	require.Equal(t, 2, ec.Todo.Query().Where(todo.Text(text)).CountX(ctx))
+
+	_, err = r.UpdateTodos(ctx, ent.TodoWhereInput{}, ent.UpdateTodoInput{Text: &text})
+	require.ErrorIs(t, err, ent.ErrEmptyTodoWhereInput)
+
+	r.MaxBulkRows = 1
+	_, err = r.DeleteTodos(ctx, ent.TodoWhereInput{TextHasPrefix: &text})
+	require.EqualError(t, err, "input: Bulk mutation exceeds the limit of 1 affected rows")
+	require.Equal(t, 3, ec.Todo.Query().CountX(ctx))
+
+	r.MaxBulkRows = 0
+	deleted, err := r.DeleteTodos(ctx, ent.TodoWhereInput{TextHasPrefix: &text})
+	require.NoError(t, err)
+	require.Len(t, deleted.DeletedIDs, 2)
+
+	gid, err = entgql.ToGlobalID("Todo", many.Todos[1].ID)
+	require.NoError(t, err)
+	last, err := r.DeleteTodo(ctx, gid)
+	require.NoError(t, err)
+	require.Equal(t, many.Todos[1].ID, last.DeletedID)
+	require.Zero(t, ec.Todo.Query().CountX(ctx))
+}
+
+func TestMutationResolver_Upsert(t *testing.T) {
+	ctx := context.Background()
+	ec := enttest.Open(
+		t, dialect.SQLite,
+		fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()),
+	)
+	r := &ent.MutationResolver{Client: ec}
+
+	created, err := r.UpsertBillProduct(ctx, ent.UpsertBillProductInput{
+		Create:         ent.CreateBillProductInput{Name: "a", Sku: "sku", Quantity: 1},
+		ConflictTarget: ent.BillProductConflictTargetSku,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "a", created.BillProduct.Name)
+
+	// All fields set on create are updated on conflict.
+	updated, err := r.UpsertBillProduct(ctx, ent.UpsertBillProductInput{
+		Create:         ent.CreateBillProductInput{Name: "b", Sku: "sku", Quantity: 2},
+		ConflictTarget: ent.BillProductConflictTargetSku,
+	})
+	require.NoError(t, err)
+	require.Equal(t, created.BillProduct.ID, updated.BillProduct.ID)
+	require.Equal(t, "b", updated.BillProduct.Name)
+	require.Equal(t, uint64(2), updated.BillProduct.Quantity)
+
+	// Only the given fields are updated on conflict.
+	updated, err = r.UpsertBillProduct(ctx, ent.UpsertBillProductInput{
+		Create:         ent.CreateBillProductInput{Name: "c", Sku: "sku", Quantity: 3},
+		ConflictTarget: ent.BillProductConflictTargetSku,
+		Update:         []ent.BillProductUpsertField{ent.BillProductUpsertFieldQuantity},
+	})
+	require.NoError(t, err)
+	require.Equal(t, created.BillProduct.ID, updated.BillProduct.ID)
+	require.Equal(t, "b", updated.BillProduct.Name)
+	require.Equal(t, uint64(3), updated.BillProduct.Quantity)
+	require.Equal(t, 1, ec.BillProduct.Query().CountX(ctx))
+
+	var target ent.BillProductConflictTarget
+	require.NoError(t, target.UnmarshalGQL("SKU"))
+	require.Equal(t, ent.BillProductConflictTargetSku, target)
+	require.EqualError(t, target.UnmarshalGQL("NAME"), "NAME is not a valid BillProductConflictTarget")
+}
+
+func TestSubscriptionResolver(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ec := enttest.Open(
+		t, dialect.SQLite,
+		fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()),
+	)
+	var ps entgql.MemPubSub
+	ec.Use(ent.SubscriptionHook(&ps, nil))
+	r := &ent.SubscriptionResolver{Client: ec, PubSub: &ps}
+
+	status := todo.StatusCompleted
+	created, err := r.TodoCreated(ctx, &ent.TodoWhereInput{Status: &status})
+	require.NoError(t, err)
+	updated, err := r.TodoUpdated(ctx, nil)
+	require.NoError(t, err)
+	deleted, err := r.TodoDeleted(ctx)
+	require.NoError(t, err)
+
+	// Nodes that do not match the filter are not delivered.
+	ec.Todo.Create().SetText("a").SetStatus(todo.StatusInProgress).ExecX(ctx)
+	t1 := ec.Todo.Create().SetText("b").SetStatus(todo.StatusCompleted).SaveX(ctx)
+	require.Equal(t, t1.ID, (<-created).ID)
+
+	// Mutations of rolled back transactions are not delivered,
+	// and committed ones are delivered only after the commit.
+	tx, err := ec.Tx(ctx)
+	require.NoError(t, err)
+	tx.Todo.Create().SetText("c").SetStatus(todo.StatusCompleted).ExecX(ctx)
+	require.NoError(t, tx.Rollback())
+	tx, err = ec.Tx(ctx)
+	require.NoError(t, err)
+	t2 := tx.Todo.Create().SetText("d").SetStatus(todo.StatusCompleted).SaveX(ctx)
+	require.NoError(t, tx.Commit())
+	require.Equal(t, t2.ID, (<-created).ID)
+
+	ec.Todo.UpdateOne(t1).SetText("e").ExecX(ctx)
+	require.Equal(t, "e", (<-updated).Text)
+	ec.Todo.DeleteOne(t2).ExecX(ctx)
+	require.Equal(t, t2.ID, <-deleted)
+}
+
+// failingPubSub is a PubSub that fails to publish.
+type failingPubSub struct{ *entgql.MemPubSub }
+
+func (failingPubSub) Publish(context.Context, string, any) error {
+	return errors.New("unavailable")
+}
+
+func TestSubscriptionHook_PublishError(t *testing.T) {
+	ctx := context.Background()
+	ec := enttest.Open(
+		t, dialect.SQLite,
+		fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()),
+	)
+	var errs []error
+	ec.Use(ent.SubscriptionHook(failingPubSub{&entgql.MemPubSub{}}, func(_ context.Context, err error) {
+		errs = append(errs, err)
+	}))
+
+	// Publishing errors are reported, and do not fail the
+	// mutations or the commits of their transactions.
+	ec.Todo.Create().SetText("a").SetStatus(todo.StatusInProgress).ExecX(ctx)
+	tx, err := ec.Tx(ctx)
+	require.NoError(t, err)
+	tx.Todo.Create().SetText("b").SetStatus(todo.StatusInProgress).ExecX(ctx)
+	require.NoError(t, tx.Commit())
+	require.Len(t, errs, 2)
+	require.EqualError(t, errs[0], "unavailable")
+	require.Equal(t, 2, ec.Todo.Query().CountX(ctx))
+}
+
+func TestGlobalID(t *testing.T) {
+	ctx := context.Background()
+	ec := enttest.Open(
+		t, dialect.SQLite,
+		fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", t.Name()),
+	)
+	cat := ec.Category.Create().SetText("c").SetStatus(category.StatusEnabled).SaveX(ctx)
+	td := ec.Todo.Create().SetText("t").SetStatus(todo.StatusInProgress).SetCategory(cat).SaveX(ctx)
+	srv := handler.NewDefaultServer(NewSchema(ec))
+	srv.Use(entgql.Transactioner{TxOpener: ec})
+	gqlc := client.New(srv)
+
+	var rsp struct {
+		Todos struct {
+			Edges []struct {
+				Node struct {
+					ID         string
+					CategoryID string
+					Category   struct{ ID string }
+				}
+			}
+		}
+	}
+	gqlc.MustPost(`query { todos { edges { node { id categoryID category { id } } } } }`, &rsp)
+	require.Len(t, rsp.Todos.Edges, 1)
+	node := rsp.Todos.Edges[0].Node
+	typ, id, err := entgql.FromGlobalID[uuid.UUID](node.ID)
+	require.NoError(t, err)
+	require.Equal(t, "Todo", typ)
+	require.Equal(t, td.ID, id)
+	require.Equal(t, node.Category.ID, node.CategoryID)
+	typ, id, err = entgql.FromGlobalID[uuid.UUID](node.CategoryID)
+	require.NoError(t, err)
+	require.Equal(t, "Category", typ)
+	require.Equal(t, cat.ID, id)
+
+	// Nodes are resolved by the type encoded in their global IDs.
+	var nodes struct {
+		Nodes []struct {
+			Text string
+		}
+	}
+	gqlc.MustPost(`query($ids: [ID!]!) { nodes(ids: $ids) { ... on Todo { text } ... on Category { text } } }`, &nodes,
+		client.Var("ids", []string{node.CategoryID, node.ID}),
+	)
+	require.Len(t, nodes.Nodes, 2)
+	require.Equal(t, "c", nodes.Nodes[0].Text)
+	require.Equal(t, "t", nodes.Nodes[1].Text)
+
+	// Global IDs are decoded in the ID fields of the inputs.
+	ec.Todo.Create().SetText("u").SetStatus(todo.StatusInProgress).ExecX(ctx)
+	gqlc.MustPost(`query($id: ID!) { todos(where: {id: $id}) { edges { node { id } } } }`, &rsp, client.Var("id", node.ID))
+	require.Len(t, rsp.Todos.Edges, 1)
+	require.Equal(t, node.ID, rsp.Todos.Edges[0].Node.ID)
+	gqlc.MustPost(`query($id: ID!) { todos(where: {categoryID: $id}) { edges { node { id } } } }`, &rsp, client.Var("id", node.CategoryID))
+	require.Len(t, rsp.Todos.Edges, 1)
+	require.Equal(t, node.ID, rsp.Todos.Edges[0].Node.ID)
+
+	// Global IDs are decoded in the ID arguments of the resolvers.
+	var upd struct {
+		UpdateTodo struct {
+			ID   string
+			Text string
+		}
+	}
+	gqlc.MustPost(`mutation($id: ID!) { updateTodo(id: $id, input: {text: "v"}) { id text } }`, &upd, client.Var("id", node.ID))
+	require.Equal(t, node.ID, upd.UpdateTodo.ID)
+	require.Equal(t, "v", upd.UpdateTodo.Text)
+	err = gqlc.Post(`mutation($id: ID!) { updateTodo(id: $id, input: {text: "v"}) { id } }`, &upd, client.Var("id", node.CategoryID))
+	require.ErrorContains(t, err, "is of type")
+
+	err = gqlc.Post(`query($id: ID!) { todos(where: {id: $id}) { totalCount } }`, &rsp, client.Var("id", td.ID.String()))
+	require.ErrorContains(t, err, "invalid global id")
+	gid, err := entgql.ToGlobalID("Unknown", td.ID)
+	require.NoError(t, err)
+	err = gqlc.Post(`query($id: ID!) { node(id: $id) { id } }`, &rsp, client.Var("id", gid))
+	require.ErrorContains(t, err, "Could not resolve to a node with the global id")
+}
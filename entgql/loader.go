@@ -0,0 +1,188 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// DefaultLoaderWait is the time that batch loaders wait for more keys
+// before loading them, in case DataLoader.Wait is not set.
+const DefaultLoaderWait = 2 * time.Millisecond
+
+// DataLoader is a gqlgen extension that attaches a set of batch loaders to the context of
+// each response. The edge resolvers generated by entgql use these loaders for edges that
+// were not eager-loaded by CollectFields, for example, edges of nodes returned by custom
+// resolvers. The edges of all nodes resolving the same field are then loaded in a single
+// query, instead of a query per node.
+//
+//	srv.Use(entgql.DataLoader{})
+type DataLoader struct {
+	// Wait is the time a loader waits for more keys before loading them.
+	// Zero means DefaultLoaderWait.
+	Wait time.Duration
+	// MaxBatch caps the number of keys loaded in a single batch.
+	// Zero means no limit.
+	MaxBatch int
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.ResponseInterceptor
+} = DataLoader{}
+
+// ExtensionName returns the extension name.
+func (DataLoader) ExtensionName() string {
+	return "EntGQLDataLoader"
+}
+
+// Validate is called when adding an extension to the server, it allows validation against the servers schema.
+func (d DataLoader) Validate(graphql.ExecutableSchema) error {
+	if d.Wait < 0 || d.MaxBatch < 0 {
+		return fmt.Errorf("entgql: invalid data loader wait %v or max batch %d", d.Wait, d.MaxBatch)
+	}
+	return nil
+}
+
+// InterceptResponse attaches a new set of batch loaders to the context of the response.
+func (d DataLoader) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	return next(d.NewContext(ctx))
+}
+
+// NewContext returns a new context with an empty set of batch loaders. It can
+// be used for attaching loaders to contexts that are not handled by gqlgen.
+func (d DataLoader) NewContext(parent context.Context) context.Context {
+	if d.Wait == 0 {
+		d.Wait = DefaultLoaderWait
+	}
+	ls := &loaders{DataLoader: d, m: make(map[any]any)}
+	ls.ctx = context.WithValue(parent, loadersCtxKey{}, ls)
+	return ls.ctx
+}
+
+type (
+	// loaders holds the batch loaders of a response.
+	loaders struct {
+		DataLoader
+		// ctx is the context the loaders are attached to, that
+		// is passed to the batch functions of the loaders.
+		ctx context.Context
+		mu  sync.Mutex
+		m   map[any]any
+	}
+	loadersCtxKey struct{}
+)
+
+// BatchFunc loads the values of the given keys. Keys that are missing
+// from the returned map are loaded as the zero value of V.
+//
+// The function is called with the context the loaders were attached to,
+// like the context of the response, and not with the context of one of
+// the callers of Load, as canceling it must not fail the loads of others.
+type BatchFunc[K comparable, V any] func(context.Context, []K) (map[K]V, error)
+
+// Loader batches the loads of keys that are requested concurrently.
+type Loader[K comparable, V any] struct {
+	ctx      context.Context
+	fetch    BatchFunc[K, V]
+	wait     time.Duration
+	maxBatch int
+	mu       sync.Mutex
+	batch    *batch[K, V]
+}
+
+// batch holds the keys of a single call to the batch function, and its results.
+type batch[K comparable, V any] struct {
+	keys   []K
+	seen   map[K]struct{}
+	full   chan struct{}
+	done   chan struct{}
+	values map[K]V
+	err    error
+}
+
+// BatchLoader returns the loader that is registered under the given key in the context, or
+// registers a new one that loads its keys using the given function. It returns nil in case
+// the context does not hold a set of loaders, for example, if DataLoader is not used.
+func BatchLoader[K comparable, V any](ctx context.Context, key any, fetch BatchFunc[K, V]) *Loader[K, V] {
+	ls, ok := ctx.Value(loadersCtxKey{}).(*loaders)
+	if !ok {
+		return nil
+	}
+	ls.mu.Lock()
+	defer ls.mu.Unlock()
+	if l, ok := ls.m[key].(*Loader[K, V]); ok {
+		return l
+	}
+	l := &Loader[K, V]{ctx: ls.ctx, fetch: fetch, wait: ls.Wait, maxBatch: ls.MaxBatch}
+	ls.m[key] = l
+	return l
+}
+
+// Load returns the value of the given key. The key is loaded with the other keys that
+// are requested before the wait time of the loader passes, or until its batch is full.
+func (l *Loader[K, V]) Load(ctx context.Context, key K) (V, error) {
+	l.mu.Lock()
+	b := l.batch
+	if b == nil {
+		b = &batch[K, V]{seen: make(map[K]struct{}), full: make(chan struct{}), done: make(chan struct{})}
+		l.batch = b
+		go l.run(b)
+	}
+	if _, ok := b.seen[key]; !ok {
+		b.seen[key] = struct{}{}
+		b.keys = append(b.keys, key)
+		if l.maxBatch > 0 && len(b.keys) >= l.maxBatch {
+			l.batch = nil
+			close(b.full)
+		}
+	}
+	l.mu.Unlock()
+	select {
+	case <-b.done:
+		return b.values[key], b.err
+	case <-ctx.Done():
+		var zero V
+		return zero, ctx.Err()
+	}
+}
+
+// run calls the batch function with the keys of the batch,
+// after the wait time of the loader passes or the batch is full.
+func (l *Loader[K, V]) run(b *batch[K, V]) {
+	timer := time.NewTimer(l.wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		l.mu.Lock()
+		if l.batch == b {
+			l.batch = nil
+		}
+		l.mu.Unlock()
+	case <-b.full:
+	}
+	defer close(b.done)
+	defer func() {
+		if r := recover(); r != nil {
+			b.err = fmt.Errorf("entgql: batch loader panic: %v", r)
+		}
+	}()
+	b.values, b.err = l.fetch(l.ctx, b.keys)
+}
@@ -0,0 +1,122 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"entgo.io/contrib/entgql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchLoader(t *testing.T) {
+	fetch := func(calls *[][]int) entgql.BatchFunc[int, string] {
+		var mu sync.Mutex
+		return func(_ context.Context, keys []int) (map[int]string, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			*calls = append(*calls, keys)
+			m := make(map[int]string, len(keys))
+			for _, k := range keys {
+				m[k] = string(rune('a' + k))
+			}
+			return m, nil
+		}
+	}
+	load := func(ctx context.Context, l *entgql.Loader[int, string], keys ...int) []string {
+		var wg sync.WaitGroup
+		values, errs := make([]string, len(keys)), make([]error, len(keys))
+		for i, k := range keys {
+			wg.Add(1)
+			go func(i, k int) {
+				defer wg.Done()
+				values[i], errs[i] = l.Load(ctx, k)
+			}(i, k)
+		}
+		wg.Wait()
+		for _, err := range errs {
+			require.NoError(t, err)
+		}
+		return values
+	}
+
+	t.Run("NoLoaders", func(t *testing.T) {
+		var calls [][]int
+		require.Nil(t, entgql.BatchLoader(context.Background(), "key", fetch(&calls)))
+	})
+
+	t.Run("Batch", func(t *testing.T) {
+		var calls [][]int
+		ctx := entgql.DataLoader{Wait: 50 * time.Millisecond}.NewContext(context.Background())
+		l := entgql.BatchLoader(ctx, "key", fetch(&calls))
+		require.Same(t, l, entgql.BatchLoader(ctx, "key", fetch(&calls)))
+		require.Equal(t, []string{"a", "b", "a", "c"}, load(ctx, l, 0, 1, 0, 2))
+		require.Len(t, calls, 1)
+		require.ElementsMatch(t, []int{0, 1, 2}, calls[0])
+
+		// Keys that are loaded after the batch was loaded start a new batch.
+		require.Equal(t, []string{"d"}, load(ctx, l, 3))
+		require.Equal(t, [][]int{{3}}, calls[1:])
+	})
+
+	t.Run("MaxBatch", func(t *testing.T) {
+		var calls [][]int
+		ctx := entgql.DataLoader{Wait: time.Minute, MaxBatch: 2}.NewContext(context.Background())
+		l := entgql.BatchLoader(ctx, "key", fetch(&calls))
+		require.Equal(t, []string{"a", "b", "c", "d"}, load(ctx, l, 0, 1, 2, 3))
+		require.Len(t, calls, 2)
+		for _, keys := range calls {
+			require.Len(t, keys, 2)
+		}
+	})
+
+	t.Run("CanceledCaller", func(t *testing.T) {
+		var calls [][]int
+		ctx := entgql.DataLoader{Wait: 50 * time.Millisecond}.NewContext(context.Background())
+		fetch := fetch(&calls)
+		l := entgql.BatchLoader(ctx, "key", func(ctx context.Context, keys []int) (map[int]string, error) {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			return fetch(ctx, keys)
+		})
+		// The batch is started by a caller that is canceled before
+		// it is loaded. The other callers of the batch are not affected.
+		cctx, cancel := context.WithCancel(ctx)
+		cancel()
+		_, err := l.Load(cctx, 0)
+		require.ErrorIs(t, err, context.Canceled)
+		require.Equal(t, []string{"b", "c"}, load(ctx, l, 1, 2))
+		require.Len(t, calls, 1)
+		require.ElementsMatch(t, []int{0, 1, 2}, calls[0])
+	})
+
+	t.Run("Panic", func(t *testing.T) {
+		ctx := entgql.DataLoader{}.NewContext(context.Background())
+		l := entgql.BatchLoader(ctx, "key", func(context.Context, []int) (map[int]string, error) {
+			panic("boom")
+		})
+		_, err := l.Load(ctx, 1)
+		require.EqualError(t, err, "entgql: batch loader panic: boom")
+	})
+
+	t.Run("Validate", func(t *testing.T) {
+		require.NoError(t, entgql.DataLoader{}.Validate(nil))
+		require.Error(t, entgql.DataLoader{MaxBatch: -1}.Validate(nil))
+	})
+}
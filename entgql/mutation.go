@@ -0,0 +1,50 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import "context"
+
+// DefaultMaxNestedDepth is the default depth limit of nested mutation inputs.
+const DefaultMaxNestedDepth = 3
+
+type (
+	nestedDepthCtxKey    struct{}
+	maxNestedDepthCtxKey struct{}
+)
+
+// WithMaxNestedDepth returns a new context that limits the depth of nested mutation
+// inputs, created by edges annotated with NestedCreate, to the given value.
+//
+//	srv.AroundOperations(func(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+//		return next(entgql.WithMaxNestedDepth(ctx, 5))
+//	})
+func WithMaxNestedDepth(ctx context.Context, limit int) context.Context {
+	return context.WithValue(ctx, maxNestedDepthCtxKey{}, limit)
+}
+
+// NestedContext returns a new context for creating the nodes of a nested mutation input,
+// or an error if the depth of the nested inputs exceeds the limit set by WithMaxNestedDepth.
+// It is used by the code generated for edges annotated with NestedCreate.
+func NestedContext(ctx context.Context) (context.Context, error) {
+	limit, ok := ctx.Value(maxNestedDepthCtxKey{}).(int)
+	if !ok {
+		limit = DefaultMaxNestedDepth
+	}
+	depth, _ := ctx.Value(nestedDepthCtxKey{}).(int)
+	if depth++; depth > limit {
+		return nil, ErrNestedDepthExceeded(limit)
+	}
+	return context.WithValue(ctx, nestedDepthCtxKey{}, depth), nil
+}
@@ -0,0 +1,40 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql_test
+
+import (
+	"context"
+	"testing"
+
+	"entgo.io/contrib/entgql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNestedContext(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	for i := 0; i < entgql.DefaultMaxNestedDepth; i++ {
+		var err error
+		ctx, err = entgql.NestedContext(ctx)
+		require.NoError(t, err)
+	}
+	_, err := entgql.NestedContext(ctx)
+	require.EqualError(t, err, "input: Nested mutation input exceeds the maximum depth of 3")
+
+	ctx, err = entgql.NestedContext(entgql.WithMaxNestedDepth(context.Background(), 1))
+	require.NoError(t, err)
+	_, err = entgql.NestedContext(ctx)
+	require.EqualError(t, err, "input: Nested mutation input exceeds the maximum depth of 1")
+}
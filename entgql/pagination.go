@@ -92,6 +92,27 @@ type PageInfo[T any] struct {
 	EndCursor       *Cursor[T] `json:"endCursor"`
 }
 
+// OffsetPageInfo of an offset-based page type.
+type OffsetPageInfo struct {
+	Offset   int  `json:"offset"`
+	PageSize int  `json:"pageSize"`
+	HasNext  bool `json:"hasNext"`
+}
+
+// NewOffsetPageInfo returns the info of a page with n nodes that was queried with the
+// given offset and limit. The offset is not required to be a multiple of the limit,
+// and the page size defaults to n in case the limit was not set.
+func NewOffsetPageInfo(offset, limit *int, n int, hasNext bool) OffsetPageInfo {
+	info := OffsetPageInfo{PageSize: n, HasNext: hasNext}
+	if limit != nil {
+		info.PageSize = *limit
+	}
+	if offset != nil {
+		info.Offset = *offset
+	}
+	return info
+}
+
 // Cursor of an edge type.
 type Cursor[T any] struct {
 	ID    T         `msgpack:"i"`
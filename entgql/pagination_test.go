@@ -0,0 +1,31 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewOffsetPageInfo(t *testing.T) {
+	intp := func(i int) *int { return &i }
+	require.Equal(t, OffsetPageInfo{PageSize: 5}, NewOffsetPageInfo(nil, nil, 5, false))
+	require.Equal(t, OffsetPageInfo{PageSize: 10, HasNext: true}, NewOffsetPageInfo(intp(0), intp(10), 10, true))
+	require.Equal(t, OffsetPageInfo{Offset: 20, PageSize: 10}, NewOffsetPageInfo(intp(20), intp(10), 3, false))
+	require.Equal(t, OffsetPageInfo{Offset: 5, PageSize: 0}, NewOffsetPageInfo(intp(5), intp(0), 0, false))
+	// Offsets that are not aligned to the limit are reported as is.
+	require.Equal(t, OffsetPageInfo{Offset: 5, PageSize: 10, HasNext: true}, NewOffsetPageInfo(intp(5), intp(10), 10, true))
+}
@@ -0,0 +1,145 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+)
+
+// JSONPathValue is the input of the JSON predicates that
+// match the value at a path of a JSON field. An empty path
+// refers to the root of the JSON document.
+type JSONPathValue struct {
+	Path  string `json:"path"`
+	Value any    `json:"value,omitempty"`
+}
+
+// value returns the value of the input, converting JSON
+// numbers to Go numbers, as JSON values are compared by type.
+func (v JSONPathValue) value() any {
+	n, ok := v.Value.(json.Number)
+	if !ok {
+		return v.Value
+	}
+	if i, err := n.Int64(); err == nil {
+		return i
+	}
+	if f, err := n.Float64(); err == nil {
+		return f
+	}
+	return n.String()
+}
+
+// JSONHasKey returns a predicate for checking that the JSON
+// column has a non-null value at the given dot-separated path.
+//
+//	entgql.JSONHasKey(user.FieldMetadata, "address.city")
+func JSONHasKey(column, path string) (func(*sql.Selector), error) {
+	opts, err := jsonPath(path)
+	if err != nil {
+		return nil, err
+	}
+	return func(s *sql.Selector) {
+		s.Where(sqljson.HasKey(s.C(column), opts...))
+	}, nil
+}
+
+// JSONValueEQ returns a predicate for checking that the value
+// at the path of the JSON column is equal to the given value.
+func JSONValueEQ(column string, v JSONPathValue) (func(*sql.Selector), error) {
+	opts, err := jsonPath(v.Path)
+	if err != nil {
+		return nil, err
+	}
+	return func(s *sql.Selector) {
+		s.Where(sqljson.ValueEQ(s.C(column), v.value(), opts...))
+	}, nil
+}
+
+// JSONContains returns a predicate for checking that the value
+// at the path of the JSON column contains the given value.
+func JSONContains(column string, v JSONPathValue) (func(*sql.Selector), error) {
+	opts, err := jsonPath(v.Path)
+	if err != nil {
+		return nil, err
+	}
+	return func(s *sql.Selector) {
+		s.Where(sqljson.ValueContains(s.C(column), v.value(), opts...))
+	}, nil
+}
+
+// ValuesContain returns a predicate for checking that the
+// JSON array column contains the given value.
+//
+//	entgql.ValuesContain(user.FieldTags, "admin")
+func ValuesContain[T any](column string, v T) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		s.Where(sqljson.ValueContains(s.C(column), v))
+	}
+}
+
+// ValuesContainAny returns a predicate for checking that the JSON
+// array column contains at least one of the given values.
+func ValuesContainAny[T any](column string, vs []T) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		ps := make([]*sql.Predicate, len(vs))
+		for i := range vs {
+			ps[i] = sqljson.ValueContains(s.C(column), vs[i])
+		}
+		s.Where(sql.Or(ps...))
+	}
+}
+
+// TextSearch returns a full-text search predicate for the given column.
+// In PostgreSQL, the column is matched using to_tsvector and plainto_tsquery,
+// and in MySQL using MATCH AGAINST in natural language mode, which requires a
+// FULLTEXT index on the column. Other dialects, such as SQLite, fall back to a
+// case-insensitive match of all words of the query.
+func TextSearch(column, query string) func(*sql.Selector) {
+	return func(s *sql.Selector) {
+		c := s.C(column)
+		switch s.Dialect() {
+		case dialect.Postgres:
+			s.Where(sql.P(func(b *sql.Builder) {
+				b.WriteString("to_tsvector(").Ident(c).WriteString(") @@ plainto_tsquery(").Arg(query).WriteByte(')')
+			}))
+		case dialect.MySQL:
+			s.Where(sql.P(func(b *sql.Builder) {
+				b.WriteString("MATCH(").Ident(c).WriteString(") AGAINST(").Arg(query).WriteString(" IN NATURAL LANGUAGE MODE)")
+			}))
+		default:
+			for _, w := range strings.Fields(query) {
+				s.Where(sql.ContainsFold(c, w))
+			}
+		}
+	}
+}
+
+// jsonPath returns the sqljson options for the given dot-separated path.
+func jsonPath(path string) ([]sqljson.Option, error) {
+	if path == "" {
+		return nil, nil
+	}
+	if _, err := sqljson.ParsePath(path); err != nil {
+		return nil, fmt.Errorf("entgql: invalid JSON path %q: %w", path, err)
+	}
+	return []sqljson.Option{sqljson.DotPath(path)}, nil
+}
@@ -0,0 +1,94 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONPredicates(t *testing.T) {
+	tests := []struct {
+		name    string
+		dialect string
+		p       func() (func(*sql.Selector), error)
+		query   string
+		args    []any
+	}{
+		{
+			name:    "HasKey",
+			dialect: dialect.SQLite,
+			p:       func() (func(*sql.Selector), error) { return entgql.JSONHasKey("meta", "a.b") },
+			query:   "SELECT * FROM `users` WHERE JSON_TYPE(`users`.`meta`, '$.a.b') IS NOT NULL",
+		},
+		{
+			name:    "ValueEQ",
+			dialect: dialect.Postgres,
+			p: func() (func(*sql.Selector), error) {
+				return entgql.JSONValueEQ("meta", entgql.JSONPathValue{Path: "a", Value: json.Number("1")})
+			},
+			query: `SELECT * FROM "users" WHERE ("users"."meta"->>'a')::int = $1`,
+			args:  []any{int64(1)},
+		},
+		{
+			name:    "Contains",
+			dialect: dialect.MySQL,
+			p: func() (func(*sql.Selector), error) {
+				return entgql.JSONContains("meta", entgql.JSONPathValue{Path: "tags", Value: "a"})
+			},
+			query: "SELECT * FROM `users` WHERE JSON_CONTAINS(`users`.`meta`, ?, '$.tags') = ?",
+			args:  []any{`"a"`, 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := tt.p()
+			require.NoError(t, err)
+			s := sql.Dialect(tt.dialect).Select("*").From(sql.Table("users"))
+			p(s)
+			query, args := s.Query()
+			require.Equal(t, tt.query, query)
+			require.Equal(t, tt.args, args)
+		})
+	}
+	_, err := entgql.JSONHasKey("meta", "a[b]")
+	require.Error(t, err)
+}
+
+func TestValuesContainAny(t *testing.T) {
+	s := sql.Dialect(dialect.SQLite).Select("*").From(sql.Table("users"))
+	entgql.ValuesContainAny("tags", []string{"a", "b"})(s)
+	query, args := s.Query()
+	require.Equal(t, "SELECT * FROM `users` WHERE EXISTS(SELECT * FROM JSON_EACH(`users`.`tags`, '$') WHERE `value` = ?) OR EXISTS(SELECT * FROM JSON_EACH(`users`.`tags`, '$') WHERE `value` = ?)", query)
+	require.Equal(t, []any{"a", "b"}, args)
+}
+
+func TestTextSearch(t *testing.T) {
+	for d, want := range map[string]string{
+		dialect.Postgres: `SELECT * FROM "posts" WHERE to_tsvector("posts"."body") @@ plainto_tsquery($1)`,
+		dialect.MySQL:    "SELECT * FROM `posts` WHERE MATCH(`posts`.`body`) AGAINST(? IN NATURAL LANGUAGE MODE)",
+		dialect.SQLite:   "SELECT * FROM `posts` WHERE LOWER(`posts`.`body`) LIKE ? AND LOWER(`posts`.`body`) LIKE ?",
+	} {
+		s := sql.Dialect(d).Select("*").From(sql.Table("posts"))
+		entgql.TextSearch("body", "hello world")(s)
+		query, _ := s.Query()
+		require.Equal(t, want, query, d)
+	}
+}
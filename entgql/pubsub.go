@@ -0,0 +1,96 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// PubSub is the interface used by the generated subscription hook and
+// resolvers for publishing and receiving the events of mutated nodes.
+type PubSub interface {
+	// Publish publishes the message to all subscribers of the topic.
+	Publish(ctx context.Context, topic string, msg any) error
+	// Subscribe returns a channel that receives the messages published
+	// to the topic. The channel is closed when the context is done.
+	Subscribe(ctx context.Context, topic string) (<-chan any, error)
+}
+
+// memBufferSize is the number of messages buffered for each subscriber
+// of the MemPubSub before new messages are dropped.
+const memBufferSize = 64
+
+// MemPubSub is an in-memory PubSub that delivers messages to the subscribers
+// of the same process. The zero value is ready to use.
+type MemPubSub struct {
+	mu   sync.RWMutex
+	subs map[string]map[*memSub]struct{}
+}
+
+type memSub struct {
+	ch   chan any
+	done <-chan struct{}
+}
+
+var _ PubSub = (*MemPubSub)(nil)
+
+// Publish publishes the message to all subscribers of the topic without
+// blocking. The message is dropped for subscribers whose buffer is full,
+// and an error is returned after it was delivered to the others.
+func (p *MemPubSub) Publish(_ context.Context, topic string, msg any) error {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	var dropped int
+	for s := range p.subs[topic] {
+		select {
+		case s.ch <- msg:
+		case <-s.done:
+		default:
+			dropped++
+		}
+	}
+	if dropped > 0 {
+		return fmt.Errorf("entgql: message of topic %q dropped for %d slow subscribers", topic, dropped)
+	}
+	return nil
+}
+
+// Subscribe returns a channel that receives the messages published
+// to the topic. The channel is closed when the context is done.
+func (p *MemPubSub) Subscribe(ctx context.Context, topic string) (<-chan any, error) {
+	s := &memSub{ch: make(chan any, memBufferSize), done: ctx.Done()}
+	p.mu.Lock()
+	if p.subs == nil {
+		p.subs = make(map[string]map[*memSub]struct{})
+	}
+	if p.subs[topic] == nil {
+		p.subs[topic] = make(map[*memSub]struct{})
+	}
+	p.subs[topic][s] = struct{}{}
+	p.mu.Unlock()
+	go func() {
+		<-ctx.Done()
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		delete(p.subs[topic], s)
+		if len(p.subs[topic]) == 0 {
+			delete(p.subs, topic)
+		}
+		close(s.ch)
+	}()
+	return s.ch, nil
+}
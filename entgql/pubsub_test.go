@@ -0,0 +1,73 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entgql_test
+
+import (
+	"context"
+	"testing"
+
+	"entgo.io/contrib/entgql"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemPubSub(t *testing.T) {
+	var (
+		ps          entgql.MemPubSub
+		ctx         = context.Background()
+		ctx1, stop1 = context.WithCancel(ctx)
+		ctx2, stop2 = context.WithCancel(ctx)
+	)
+	defer stop2()
+	ch1, err := ps.Subscribe(ctx1, "a")
+	require.NoError(t, err)
+	ch2, err := ps.Subscribe(ctx2, "a")
+	require.NoError(t, err)
+	ch3, err := ps.Subscribe(ctx2, "b")
+	require.NoError(t, err)
+
+	require.NoError(t, ps.Publish(ctx, "a", 1))
+	require.Equal(t, 1, <-ch1)
+	require.Equal(t, 1, <-ch2)
+	require.Empty(t, ch3)
+
+	// Channels of done subscribers are closed.
+	stop1()
+	_, ok := <-ch1
+	require.False(t, ok)
+	require.NoError(t, ps.Publish(ctx, "a", 2))
+	require.Equal(t, 2, <-ch2)
+	require.NoError(t, ps.Publish(ctx, "c", 3))
+}
+
+func TestMemPubSub_SlowSubscriber(t *testing.T) {
+	var (
+		ps          entgql.MemPubSub
+		ctx, cancel = context.WithCancel(context.Background())
+	)
+	defer cancel()
+	slow, err := ps.Subscribe(ctx, "a")
+	require.NoError(t, err)
+	for i := 0; i < cap(slow); i++ {
+		require.NoError(t, ps.Publish(ctx, "a", i))
+	}
+	fast, err := ps.Subscribe(ctx, "a")
+	require.NoError(t, err)
+
+	// Publishing does not block on full buffers, and
+	// the message is still delivered to the others.
+	require.EqualError(t, ps.Publish(ctx, "a", -1), `entgql: message of topic "a" dropped for 1 slow subscribers`)
+	require.Equal(t, -1, <-fast)
+	require.Equal(t, 0, <-slow)
+}
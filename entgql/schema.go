@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"reflect"
+	"slices"
 	"strings"
 
 	"entgo.io/ent/entc/gen"
@@ -32,6 +33,10 @@ import (
 const (
 	// QueryType is the name of the root Query object.
 	QueryType = "Query"
+	// MutationType is the name of the root Mutation object.
+	MutationType = "Mutation"
+	// SubscriptionType is the name of the root Subscription object.
+	SubscriptionType = "Subscription"
 	// OrderDirectionEnum is the name of enum OrderDirection
 	OrderDirectionEnum = "OrderDirection"
 	// RelayCursor is the name of the cursor type
@@ -40,6 +45,13 @@ const (
 	RelayNode = "Node"
 	// RelayPageInfo is the name of the PageInfo type
 	RelayPageInfo = "PageInfo"
+	// OffsetPageInfoType is the name of the PageInfo type of offset-based pages
+	OffsetPageInfoType = "OffsetPageInfo"
+	// AuthorizeDirective is the name of the directive added by the Authorize annotation
+	AuthorizeDirective = "authorize"
+	// FederationSpec is the URL of the Apollo Federation specification
+	// that is linked by schemas with FederationKey annotations
+	FederationSpec = "https://specs.apollo.dev/federation/v2.3"
 )
 
 var (
@@ -102,11 +114,13 @@ var (
 )
 
 type schemaGenerator struct {
-	path          string
-	relaySpec     bool
-	genSchema     bool
-	genWhereInput bool
-	genMutations  bool
+	path              string
+	relaySpec         bool
+	genSchema         bool
+	genWhereInput     bool
+	genMutations      bool
+	genMutationFields bool
+	globalID          bool
 
 	cfg         *config.Config
 	scalarFunc  func(*gen.Field, gen.Op) string
@@ -129,6 +143,10 @@ func (e *schemaGenerator) BuildSchema(g *gen.Graph) (s *ast.Schema, err error) {
 	if err := e.buildTypes(g, s); err != nil {
 		return nil, err
 	}
+	if e.genSchema {
+		mayAddAuthorize(s)
+		mayAddFederation(s)
+	}
 
 	for _, h := range e.schemaHooks {
 		if err = h(g, s); err != nil {
@@ -139,13 +157,17 @@ func (e *schemaGenerator) BuildSchema(g *gen.Graph) (s *ast.Schema, err error) {
 }
 
 func (e *schemaGenerator) buildTypes(g *gen.Graph, s *ast.Schema) error {
-	var queryFields ast.FieldList
+	var queryFields, mutationFields, subscriptionFields ast.FieldList
 	if e.relaySpec {
 		queryFields = relayBuiltinQueryFields()
 	}
 
 	for _, node := range g.Nodes {
-		if node.HasCompositeID() {
+		// Types with a composite ID are exposed only when the nodes are
+		// identified by global IDs, and without pagination, filters
+		// and mutations.
+		composite := node.HasCompositeID()
+		if composite && !e.globalID {
 			continue
 		}
 		gqlType, ant, err := gqlTypeFromNode(node)
@@ -195,6 +217,9 @@ func (e *schemaGenerator) buildTypes(g *gen.Graph, s *ast.Schema) error {
 				}
 			}
 		}
+		if composite {
+			continue
+		}
 
 		if e.genSchema && !ant.Skip.Is(SkipOrderField) {
 			def, err := e.enumOrderByValues(node, names.OrderField)
@@ -221,7 +246,7 @@ func (e *schemaGenerator) buildTypes(g *gen.Graph, s *ast.Schema) error {
 
 					def := names.ConnectionField(name, hasOrderBy, ant.MultiOrder, hasWhereInput)
 					def.Description = ant.QueryField.Description
-					def.Directives = e.buildDirectives(ant.QueryField.Directives)
+					def.Directives = withAuthorize(e.buildDirectives(ant.QueryField.Directives), ant)
 					queryFields = append(queryFields, def)
 				}
 			} else if ant.QueryField != nil {
@@ -231,9 +256,31 @@ func (e *schemaGenerator) buildTypes(g *gen.Graph, s *ast.Schema) error {
 					Description: ant.QueryField.Description,
 					Type:        listNamedType(gqlType, false),
 				}
-				def.Directives = e.buildDirectives(ant.QueryField.Directives)
+				def.Directives = withAuthorize(e.buildDirectives(ant.QueryField.Directives), ant)
 				queryFields = append(queryFields, def)
 			}
+			if ant.OffsetPagination {
+				s.AddTypes(names.PageTypeDef())
+				if s.Types[OffsetPageInfoType] == nil {
+					s.AddTypes(offsetPageInfoType())
+				}
+				if ant.QueryField != nil {
+					name := ant.QueryField.fieldName(gqlType) + "Page"
+					_, hasOrderBy := s.Types[names.Order]
+					hasWhereInput := e.genWhereInput && !ant.Skip.Is(SkipWhereInput)
+
+					def := names.PageField(name, hasOrderBy, ant.MultiOrder, hasWhereInput)
+					def.Description = ant.QueryField.Description
+					def.Directives = withAuthorize(e.buildDirectives(ant.QueryField.Directives), ant)
+					queryFields = append(queryFields, def)
+				}
+			}
+		}
+
+		if e.genSchema && !ant.Skip.Is(SkipType) {
+			if err := e.buildAggregate(s, node, names); err != nil {
+				return err
+			}
 		}
 
 		if e.genWhereInput && !ant.Skip.Is(SkipWhereInput) {
@@ -243,6 +290,7 @@ func (e *schemaGenerator) buildTypes(g *gen.Graph, s *ast.Schema) error {
 			}
 			if def != nil {
 				s.AddTypes(def)
+				e.mayAddJSONPathValue(s, def)
 			}
 		}
 
@@ -254,6 +302,22 @@ func (e *schemaGenerator) buildTypes(g *gen.Graph, s *ast.Schema) error {
 			if len(defs) > 0 {
 				s.AddTypes(defs...)
 			}
+			if e.genSchema && e.genMutationFields && !ant.Skip.Is(SkipType) {
+				defs, fields, err := e.buildMutationFields(node, ant, gqlType)
+				if err != nil {
+					return err
+				}
+				s.AddTypes(defs...)
+				mutationFields = append(mutationFields, fields...)
+			}
+		}
+
+		if e.genSchema && ant.Subscriptions && !ant.Skip.Is(SkipType) {
+			fields, err := e.buildSubscriptionFields(ant, gqlType)
+			if err != nil {
+				return err
+			}
+			subscriptionFields = append(subscriptionFields, fields...)
 		}
 	}
 
@@ -264,6 +328,20 @@ func (e *schemaGenerator) buildTypes(g *gen.Graph, s *ast.Schema) error {
 			Fields: queryFields,
 		})
 	}
+	if len(mutationFields) > 0 {
+		s.AddTypes(&ast.Definition{
+			Name:   MutationType,
+			Kind:   ast.Object,
+			Fields: mutationFields,
+		})
+	}
+	if len(subscriptionFields) > 0 {
+		s.AddTypes(&ast.Definition{
+			Name:   SubscriptionType,
+			Kind:   ast.Object,
+			Fields: subscriptionFields,
+		})
+	}
 
 	return nil
 }
@@ -296,6 +374,38 @@ func (e *schemaGenerator) mayAddScalars(s *ast.Schema, def *ast.Definition) {
 	}
 }
 
+// mayAddJSONPathValue adds the JSONPathValue input to the schema,
+// in case it is used by the predicates of the given WhereInput.
+func (e *schemaGenerator) mayAddJSONPathValue(s *ast.Schema, def *ast.Definition) {
+	if s.Types[JSONPathValueType] != nil {
+		return
+	}
+	for _, f := range def.Fields {
+		if f.Type.Name() != JSONPathValueType {
+			continue
+		}
+		input := &ast.Definition{
+			Name:        JSONPathValueType,
+			Kind:        ast.InputObject,
+			Description: "JSONPathValue is used for matching the value at a path of a JSON field.",
+			Fields: ast.FieldList{
+				{
+					Name:        "path",
+					Type:        namedType("String", false),
+					Description: `The dot-separated path of the value (e.g. "a.b[1].c"). An empty path refers to the root of the JSON document.`,
+				},
+				{
+					Name: "value",
+					Type: namedType("Any", true),
+				},
+			},
+		}
+		s.AddTypes(input)
+		e.mayAddScalars(s, input)
+		return
+	}
+}
+
 // externalType indicates if the given type name exists in another schema.
 func (e *schemaGenerator) externalType(name string) bool {
 	if e.cfg == nil || e.cfg.Schema == nil || e.cfg.Schema.Types[name] == nil {
@@ -309,7 +419,7 @@ func (e *schemaGenerator) buildType(t *gen.Type, ant *Annotation, gqlType, pkg s
 	def := &ast.Definition{
 		Name:       gqlType,
 		Kind:       ast.Object,
-		Directives: e.buildDirectives(ant.Directives),
+		Directives: withAuthorize(e.buildDirectives(ant.Directives), ant),
 	}
 	if t.Name != gqlType {
 		def.Directives = append(def.Directives, goModel(entGoType(t.Name, pkg)))
@@ -320,6 +430,18 @@ func (e *schemaGenerator) buildType(t *gen.Type, ant *Annotation, gqlType, pkg s
 	if len(ant.Implements) > 0 {
 		def.Interfaces = append(def.Interfaces, ant.Implements...)
 	}
+	keys, err := federationKeys(t, ant, gqlType)
+	if err != nil {
+		return nil, err
+	}
+	def.Directives = append(def.Directives, keys...)
+	if t.HasCompositeID() {
+		// The id of a type with a composite ID is its global ID.
+		def.Fields = append(def.Fields, &ast.FieldDefinition{
+			Name: "id",
+			Type: namedType("ID", false),
+		})
+	}
 
 	fields := allFields(t)
 	for _, f := range fields {
@@ -375,6 +497,133 @@ func (e *schemaGenerator) buildDirectives(directives []Directive) ast.DirectiveL
 	return list
 }
 
+// withAuthorize appends the @authorize directive to the list, in case one of the
+// annotations has the Authorize annotation. The policies of all annotations are
+// merged, as the directive can be used only once in each location.
+func withAuthorize(list ast.DirectiveList, ants ...*Annotation) ast.DirectiveList {
+	var (
+		annotated bool
+		policies  ast.ChildValueList
+	)
+	for _, ant := range ants {
+		if ant.Authorize == nil {
+			continue
+		}
+		annotated = true
+		for _, p := range ant.Authorize.Policies {
+			if !slices.ContainsFunc(policies, func(v *ast.ChildValue) bool { return v.Value.Raw == p }) {
+				policies = append(policies, &ast.ChildValue{Value: &ast.Value{Raw: p, Kind: ast.StringValue}})
+			}
+		}
+	}
+	if !annotated {
+		return list
+	}
+	d := &ast.Directive{Name: AuthorizeDirective}
+	if len(policies) > 0 {
+		d.Arguments = ast.ArgumentList{
+			{Name: "policies", Value: &ast.Value{Children: policies, Kind: ast.ListValue}},
+		}
+	}
+	return append(list, d)
+}
+
+// federationKeys returns the @key directives of the given type, and the @entityResolver
+// directive that tells gqlgen to generate batched resolvers for its keys.
+func federationKeys(t *gen.Type, ant *Annotation, gqlType string) (ast.DirectiveList, error) {
+	if len(ant.FederationKeys) == 0 {
+		return nil, nil
+	}
+	if t.HasCompositeID() {
+		return nil, fmt.Errorf("entgql: federation keys are not supported on type %s with a composite id", t.Name)
+	}
+	list := make(ast.DirectiveList, 0, len(ant.FederationKeys)+1)
+	for _, fields := range ant.FederationKeys {
+		k, err := federationEntityKey(t, gqlType, fields)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, &ast.Directive{
+			Name: "key",
+			Arguments: ast.ArgumentList{
+				{Name: "fields", Value: &ast.Value{Raw: k.FieldSet, Kind: ast.StringValue}},
+			},
+		})
+	}
+	return append(list, &ast.Directive{
+		Name: "entityResolver",
+		Arguments: ast.ArgumentList{
+			{Name: "multi", Value: &ast.Value{Raw: "true", Kind: ast.BooleanValue}},
+		},
+	}), nil
+}
+
+// mayAddFederation links the Federation v2 directives to the schema, in case one of its
+// types is an entity, and defines the gqlgen @entityResolver directive. The PageInfo type
+// is marked as shareable, as it is defined by all subgraphs that were generated by entgql.
+func mayAddFederation(s *ast.Schema) {
+	entities := false
+	for _, t := range s.Types {
+		entities = entities || t.Directives.ForName("key") != nil
+	}
+	if !entities {
+		return
+	}
+	s.SchemaDirectives = append(s.SchemaDirectives, &ast.Directive{
+		Name: "link",
+		Arguments: ast.ArgumentList{
+			{Name: "url", Value: &ast.Value{Raw: FederationSpec, Kind: ast.StringValue}},
+			{Name: "import", Value: &ast.Value{
+				Kind: ast.ListValue,
+				Children: ast.ChildValueList{
+					{Value: &ast.Value{Raw: "@key", Kind: ast.StringValue}},
+					{Value: &ast.Value{Raw: "@shareable", Kind: ast.StringValue}},
+				},
+			}},
+		},
+	})
+	s.Directives["entityResolver"] = &ast.DirectiveDefinition{
+		Name:     "entityResolver",
+		Position: pos,
+		Arguments: ast.ArgumentDefinitionList{
+			{Name: "multi", Type: ast.NamedType("Boolean", nil)},
+		},
+		Locations: []ast.DirectiveLocation{ast.LocationObject},
+	}
+	for _, name := range []string{RelayPageInfo, OffsetPageInfoType} {
+		if t, ok := s.Types[name]; ok && t.Directives.ForName("shareable") == nil {
+			t.Directives = append(t.Directives, &ast.Directive{Name: "shareable"})
+		}
+	}
+}
+
+// mayAddAuthorize adds the @authorize directive definition to the
+// schema, in case it is used by one of its types or fields.
+func mayAddAuthorize(s *ast.Schema) {
+	used := func(list ast.DirectiveList) bool {
+		return list.ForName(AuthorizeDirective) != nil
+	}
+	for _, t := range s.Types {
+		if used(t.Directives) || slices.ContainsFunc(t.Fields, func(f *ast.FieldDefinition) bool { return used(f.Directives) }) {
+			s.Directives[AuthorizeDirective] = &ast.DirectiveDefinition{
+				Name:     AuthorizeDirective,
+				Position: pos,
+				Arguments: ast.ArgumentDefinitionList{
+					{
+						Name: "policies",
+						Type: ast.ListType(ast.NonNullNamedType("String", nil), nil),
+					},
+				},
+				Locations: []ast.DirectiveLocation{
+					ast.LocationObject,
+					ast.LocationFieldDefinition,
+				},
+			}
+			return
+		}
+	}
+}
+
 func (e *schemaGenerator) enumOrderByValues(t *gen.Type, gqlType string) (*ast.Definition, error) {
 	terms, err := orderFields(t)
 	if err != nil {
@@ -434,6 +683,14 @@ func (e *schemaGenerator) buildEdge(node *gen.Type, edge *gen.Edge, edgeAnt *Ann
 	if len(edgeAnt.Mapping) > 0 {
 		mappings = edgeAnt.Mapping
 	}
+	if edgeAnt.OffsetPagination {
+		if edge.Unique {
+			return nil, fmt.Errorf("entgql: OffsetPagination cannot be defined on Unique edge: %s.%s", node.Name, edge.Name)
+		}
+		if !ant.OffsetPagination {
+			return nil, fmt.Errorf("entgql.OffsetPagination() must be set on entity %q in order to define %q.%q as offset page", edge.Type.Name, node.Name, edge.Name)
+		}
+	}
 	for _, name := range mappings {
 		fieldDef := &ast.FieldDefinition{
 			Name:        name,
@@ -462,11 +719,22 @@ func (e *schemaGenerator) buildEdge(node *gen.Type, edge *gen.Edge, edgeAnt *Ann
 			fieldDef.Type = listNamedType(gqlType, edge.Optional)
 		}
 
-		fieldDef.Directives = e.buildDirectives(edgeAnt.Directives)
+		fieldDef.Directives = withAuthorize(e.buildDirectives(edgeAnt.Directives), edgeAnt, ant)
 		if goFieldName != templates.ToGo(name) {
 			fieldDef.Directives = append(fieldDef.Directives, goField(structField))
 		}
 		fields = append(fields, fieldDef)
+		if edgeAnt.OffsetPagination {
+			pageDef := paginationNames(gqlType).
+				PageField(name+"Page", len(orderFields) > 0, ant.MultiOrder,
+					e.genWhereInput && !edgeAnt.Skip.Is(SkipWhereInput) && !ant.Skip.Is(SkipWhereInput),
+				)
+			pageDef.Directives = withAuthorize(e.buildDirectives(edgeAnt.Directives), edgeAnt, ant)
+			if goFieldName != templates.ToGo(name) {
+				pageDef.Directives = append(pageDef.Directives, goField(structField+"Page"))
+			}
+			fields = append(fields, pageDef)
+		}
 	}
 
 	return fields, nil
@@ -495,7 +763,7 @@ func (e *schemaGenerator) buildWhereInput(t *gen.Type, nodeGQLType, gqlType stri
 
 	fields := allFields(t)
 	for _, f := range fields {
-		if t.IsEdgeSchema() && f.IsEdgeField() || !f.Type.Comparable() || f.Sensitive() {
+		if t.IsEdgeSchema() && f.IsEdgeField() || f.Sensitive() {
 			continue
 		}
 		ant, err := annotation(f.Annotations)
@@ -505,13 +773,30 @@ func (e *schemaGenerator) buildWhereInput(t *gen.Type, nodeGQLType, gqlType stri
 		if ant.Skip.Is(SkipWhereInput) {
 			continue
 		}
-		for i, op := range f.Ops() {
-			fd := e.fieldDefinitionOp(nodeGQLType, f, ant, op)
-			if i == 0 {
-				fd.Description = f.Name + " field predicates"
+		var ops []*ast.FieldDefinition
+		if f.Type.Comparable() {
+			for _, op := range f.Ops() {
+				ops = append(ops, e.fieldDefinitionOp(nodeGQLType, f, ant, op))
 			}
-			def.Fields = append(def.Fields, fd)
 		}
+		preds, err := fieldWherePredicates(f)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range preds {
+			fd := &ast.FieldDefinition{
+				Name: camel(f.Name + "_" + p.Name),
+				Type: namedType(p.GQLType, true),
+			}
+			if p.List {
+				fd.Type = listNamedType(p.GQLType, true)
+			}
+			ops = append(ops, fd)
+		}
+		if len(ops) > 0 {
+			ops[0].Description = f.Name + " field predicates"
+		}
+		def.Fields = append(def.Fields, ops...)
 	}
 
 	if t.IsEdgeSchema() {
@@ -546,6 +831,17 @@ func (e *schemaGenerator) buildMutationInputs(t *gen.Type, ant *Annotation, gqlT
 	var defs []*ast.Definition
 
 	for _, i := range ant.MutationInputs {
+		if i.IsDelete {
+			continue
+		}
+		if i.IsUpsert {
+			upsertDefs, err := e.buildUpsertInput(t, i.Description)
+			if err != nil {
+				return nil, err
+			}
+			defs = append(defs, upsertDefs...)
+			continue
+		}
 		if i.IsCreate && ant.Skip.Is(SkipMutationCreateInput) {
 			continue
 		}
@@ -637,12 +933,354 @@ func (e *schemaGenerator) buildMutationInputs(t *gen.Type, ant *Annotation, gqlT
 				})
 			}
 		}
+		nested, err := desc.NestedEdges()
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range nested {
+			t := ast.ListType(ast.NonNullNamedType(e.Input, nil), nil)
+			if e.Unique {
+				t = ast.NamedType(e.Input, nil)
+			}
+			def.Fields = append(def.Fields, &ast.FieldDefinition{
+				Name: "create" + pascal(e.Name),
+				Type: t,
+			})
+		}
 		defs = append(defs, def)
 	}
 
 	return defs, nil
 }
 
+// buildAggregate adds the aggregate field to the connection of the given
+// type, and the types it returns, if the type has aggregated fields.
+func (e *schemaGenerator) buildAggregate(s *ast.Schema, t *gen.Type, names *PaginationNames) error {
+	agg, err := nodeAggregate(t)
+	if err != nil || agg == nil {
+		return err
+	}
+	conn := s.Types[names.Connection]
+	if conn == nil {
+		return fmt.Errorf("entgql: aggregations of %s require the %s type", t.Name, names.Connection)
+	}
+	field := &ast.FieldDefinition{
+		Name:        "aggregate",
+		Description: fmt.Sprintf("Aggregates the %s of the connection, regardless of its pagination.", plural(names.Node)),
+		Type:        ast.NonNullListType(ast.NonNullNamedType(agg.Aggregate, nil), nil),
+	}
+	aggregate := &ast.Definition{
+		Name:        agg.Aggregate,
+		Kind:        ast.Object,
+		Description: fmt.Sprintf("Aggregated values of a group of %s.", plural(names.Node)),
+		Fields: ast.FieldList{
+			{
+				Name:        "count",
+				Type:        ast.NonNullNamedType("Int", nil),
+				Description: "The number of nodes in the group.",
+			},
+		},
+	}
+	defs := []*ast.Definition{aggregate}
+	scalar := func(f *gen.Field) (*ast.Type, error) {
+		ant, err := annotation(f.Annotations)
+		if err != nil {
+			return nil, err
+		}
+		return ast.NamedType(e.mapScalar(names.Node, f, ant, nonInputObjectFilter), nil), nil
+	}
+	if numeric := agg.Numeric(); len(numeric) > 0 {
+		sum := &ast.Definition{
+			Name:        agg.Sum,
+			Kind:        ast.Object,
+			Description: fmt.Sprintf("Sums of the numeric fields of %s.", plural(names.Node)),
+		}
+		avg := &ast.Definition{
+			Name:        agg.Avg,
+			Kind:        ast.Object,
+			Description: fmt.Sprintf("Averages of the numeric fields of %s.", plural(names.Node)),
+		}
+		for _, f := range numeric {
+			typ, err := scalar(f)
+			if err != nil {
+				return err
+			}
+			sum.Fields = append(sum.Fields, &ast.FieldDefinition{
+				Name: camel(f.Name),
+				Type: typ,
+			})
+			avg.Fields = append(avg.Fields, &ast.FieldDefinition{
+				Name: camel(f.Name),
+				Type: ast.NamedType("Float", nil),
+			})
+		}
+		aggregate.Fields = append(aggregate.Fields,
+			&ast.FieldDefinition{Name: "sum", Type: ast.NonNullNamedType(agg.Sum, nil)},
+			&ast.FieldDefinition{Name: "avg", Type: ast.NonNullNamedType(agg.Avg, nil)},
+		)
+		defs = append(defs, sum, avg)
+	}
+	if len(agg.Fields) > 0 {
+		minMax := &ast.Definition{
+			Name:        agg.MinMax,
+			Kind:        ast.Object,
+			Description: fmt.Sprintf("Minimum or maximum values of the fields of %s.", plural(names.Node)),
+		}
+		for _, f := range agg.Fields {
+			typ, err := scalar(f)
+			if err != nil {
+				return err
+			}
+			minMax.Fields = append(minMax.Fields, &ast.FieldDefinition{
+				Name: camel(f.Name),
+				Type: typ,
+			})
+		}
+		aggregate.Fields = append(aggregate.Fields,
+			&ast.FieldDefinition{Name: "min", Type: ast.NonNullNamedType(agg.MinMax, nil)},
+			&ast.FieldDefinition{Name: "max", Type: ast.NonNullNamedType(agg.MinMax, nil)},
+		)
+		defs = append(defs, minMax)
+	}
+	if len(agg.GroupBy) > 0 {
+		groupBy := &ast.Definition{
+			Name:        agg.GroupByField,
+			Kind:        ast.Enum,
+			Description: fmt.Sprintf("Properties by which %s aggregations can be grouped.", names.Node),
+		}
+		for _, v := range agg.GroupBy {
+			f := v.Fields[0]
+			groupBy.EnumValues = append(groupBy.EnumValues, &ast.EnumValueDefinition{Name: v.Name})
+			typ, err := scalar(f)
+			if err != nil {
+				return err
+			}
+			aggregate.Fields = append(aggregate.Fields, &ast.FieldDefinition{
+				Name:        camel(f.Name),
+				Type:        typ,
+				Description: fmt.Sprintf("The %s of the group, if grouped by it.", camel(f.Name)),
+			})
+		}
+		field.Arguments = ast.ArgumentDefinitionList{
+			{
+				Name:        "groupBy",
+				Type:        ast.ListType(ast.NonNullNamedType(agg.GroupByField, nil), nil),
+				Description: "The fields to group the aggregations by.",
+			},
+		}
+		defs = append(defs, groupBy)
+	}
+	conn.Fields = append(conn.Fields, field)
+	s.AddTypes(defs...)
+	return nil
+}
+
+// buildSubscriptionFields returns the Subscription fields of the given type.
+func (e *schemaGenerator) buildSubscriptionFields(ant *Annotation, gqlType string) (ast.FieldList, error) {
+	names := subscriptionNames(gqlType)
+	whereInput := paginationNames(gqlType).WhereInput
+	if !e.genWhereInput || ant.Skip.Is(SkipWhereInput) {
+		return nil, fmt.Errorf("subscriptions of %s require the %s type, please enable the entgql.WithWhereInputs option", gqlType, whereInput)
+	}
+	where := ast.ArgumentDefinitionList{
+		{Name: "where", Type: ast.NamedType(whereInput, nil)},
+	}
+	return ast.FieldList{
+		{
+			Name:        names.Created,
+			Description: fmt.Sprintf("Delivers the created %s matching the given filter.", plural(gqlType)),
+			Arguments:   where,
+			Type:        ast.NonNullNamedType(gqlType, nil),
+		},
+		{
+			Name:        names.Updated,
+			Description: fmt.Sprintf("Delivers the updated %s matching the given filter.", plural(gqlType)),
+			Arguments:   where,
+			Type:        ast.NonNullNamedType(gqlType, nil),
+		},
+		{
+			Name:        names.Deleted,
+			Description: fmt.Sprintf("Delivers the IDs of the deleted %s.", plural(gqlType)),
+			Type:        ast.NonNullNamedType("ID", nil),
+		},
+	}, nil
+}
+
+// buildUpsertInput returns the definitions of the Upsert<T>Input
+// of the given type and the enums it uses.
+func (e *schemaGenerator) buildUpsertInput(t *gen.Type, description string) ([]*ast.Definition, error) {
+	u, err := newUpsertDescriptor(t)
+	if err != nil {
+		return nil, err
+	}
+	targets, err := u.ConflictTargets()
+	if err != nil {
+		return nil, err
+	}
+	fields, err := u.UpsertFields()
+	if err != nil {
+		return nil, err
+	}
+	if description == "" {
+		description = fmt.Sprintf("%s is used for upsert %s object.\nInput was generated by ent.", u.Input, t.Name)
+	}
+	target := &ast.Definition{
+		Name:        u.ConflictTarget,
+		Kind:        ast.Enum,
+		Description: fmt.Sprintf("Unique fields and indexes by which %s conflicts are detected.", u.Input),
+	}
+	for _, v := range targets {
+		target.EnumValues = append(target.EnumValues, &ast.EnumValueDefinition{Name: v.Name})
+	}
+	field := &ast.Definition{
+		Name:        u.UpsertField,
+		Kind:        ast.Enum,
+		Description: fmt.Sprintf("Fields that %s updates on conflict.", u.Input),
+	}
+	for _, v := range fields {
+		field.EnumValues = append(field.EnumValues, &ast.EnumValueDefinition{Name: v.Name})
+	}
+	input := &ast.Definition{
+		Name:        u.Input,
+		Kind:        ast.InputObject,
+		Description: description,
+		Fields: ast.FieldList{
+			{
+				Name:        "create",
+				Type:        ast.NonNullNamedType(u.Create, nil),
+				Description: "The node to create.",
+			},
+			{
+				Name:        "conflictTarget",
+				Type:        ast.NonNullNamedType(u.ConflictTarget, nil),
+				Description: "The unique field or index that detects the conflict.",
+			},
+			{
+				Name:        "update",
+				Type:        ast.ListType(ast.NonNullNamedType(u.UpsertField, nil), nil),
+				Description: "The fields to update on conflict. If empty, all fields set on create are updated.",
+			},
+		},
+	}
+	if len(field.EnumValues) == 0 {
+		return []*ast.Definition{target, input}, nil
+	}
+	return []*ast.Definition{target, field, input}, nil
+}
+
+// buildMutationFields returns the Mutation fields for the mutation inputs of
+// the given type, and the definitions of the payloads they return.
+func (e *schemaGenerator) buildMutationFields(t *gen.Type, ant *Annotation, gqlType string) ([]*ast.Definition, ast.FieldList, error) {
+	var (
+		defs       []*ast.Definition
+		fields     ast.FieldList
+		names      = mutationNames(gqlType)
+		whereInput = paginationNames(gqlType).WhereInput
+	)
+	for _, i := range ant.MutationInputs {
+		if i.IsBulk && !i.IsCreate && (!e.genWhereInput || ant.Skip.Is(SkipWhereInput)) {
+			return nil, nil, fmt.Errorf("bulk mutations of %s require the %s type, please enable the entgql.WithWhereInputs option", gqlType, whereInput)
+		}
+		if i.IsDelete {
+			defs = append(defs, names.DeletePayloadDef())
+			fields = append(fields, &ast.FieldDefinition{
+				Name:        names.Delete,
+				Description: fmt.Sprintf("Deletes the %s with the given ID.", gqlType),
+				Arguments: ast.ArgumentDefinitionList{
+					{Name: "id", Type: ast.NonNullNamedType("ID", nil)},
+				},
+				Type: ast.NonNullNamedType(names.DeletePayload, nil),
+			})
+			if i.IsBulk {
+				defs = append(defs, names.DeleteManyPayloadDef())
+				fields = append(fields, &ast.FieldDefinition{
+					Name:        names.DeleteMany,
+					Description: fmt.Sprintf("Deletes the %s matching the given filter.", plural(gqlType)),
+					Arguments: ast.ArgumentDefinitionList{
+						{Name: "where", Type: ast.NonNullNamedType(whereInput, nil)},
+					},
+					Type: ast.NonNullNamedType(names.DeleteManyPayload, nil),
+				})
+			}
+			continue
+		}
+		if i.IsUpsert {
+			desc := MutationDescriptor{Type: t, IsUpsert: true}
+			input, err := desc.Input()
+			if err != nil {
+				return nil, nil, err
+			}
+			defs = append(defs, names.PayloadDef(names.UpsertPayload, names.Upsert, "Created or updated"))
+			fields = append(fields, &ast.FieldDefinition{
+				Name:        names.Upsert,
+				Description: fmt.Sprintf("Creates a new %s, or updates the one conflicting with the input.", gqlType),
+				Arguments: ast.ArgumentDefinitionList{
+					{Name: "input", Type: ast.NonNullNamedType(input, nil)},
+				},
+				Type: ast.NonNullNamedType(names.UpsertPayload, nil),
+			})
+			continue
+		}
+		if i.IsCreate && ant.Skip.Is(SkipMutationCreateInput) {
+			continue
+		}
+		if !i.IsCreate && ant.Skip.Is(SkipMutationUpdateInput) {
+			continue
+		}
+		desc := MutationDescriptor{Type: t, IsCreate: i.IsCreate}
+		input, err := desc.Input()
+		if err != nil {
+			return nil, nil, err
+		}
+		if i.IsCreate {
+			defs = append(defs, names.PayloadDef(names.CreatePayload, names.Create, "Created"))
+			fields = append(fields, &ast.FieldDefinition{
+				Name:        names.Create,
+				Description: fmt.Sprintf("Creates a new %s.", gqlType),
+				Arguments: ast.ArgumentDefinitionList{
+					{Name: "input", Type: ast.NonNullNamedType(input, nil)},
+				},
+				Type: ast.NonNullNamedType(names.CreatePayload, nil),
+			})
+			if i.IsBulk {
+				defs = append(defs, names.ManyPayloadDef(names.CreateManyPayload, names.CreateMany, "Created"))
+				fields = append(fields, &ast.FieldDefinition{
+					Name:        names.CreateMany,
+					Description: fmt.Sprintf("Creates a list of %s.", plural(gqlType)),
+					Arguments: ast.ArgumentDefinitionList{
+						{Name: "input", Type: ast.NonNullListType(ast.NonNullNamedType(input, nil), nil)},
+					},
+					Type: ast.NonNullNamedType(names.CreateManyPayload, nil),
+				})
+			}
+			continue
+		}
+		defs = append(defs, names.PayloadDef(names.UpdatePayload, names.Update, "Updated"))
+		fields = append(fields, &ast.FieldDefinition{
+			Name:        names.Update,
+			Description: fmt.Sprintf("Updates the %s with the given ID.", gqlType),
+			Arguments: ast.ArgumentDefinitionList{
+				{Name: "id", Type: ast.NonNullNamedType("ID", nil)},
+				{Name: "input", Type: ast.NonNullNamedType(input, nil)},
+			},
+			Type: ast.NonNullNamedType(names.UpdatePayload, nil),
+		})
+		if i.IsBulk {
+			defs = append(defs, names.ManyPayloadDef(names.UpdateManyPayload, names.UpdateMany, "Updated"))
+			fields = append(fields, &ast.FieldDefinition{
+				Name:        names.UpdateMany,
+				Description: fmt.Sprintf("Updates the %s matching the given filter.", plural(gqlType)),
+				Arguments: ast.ArgumentDefinitionList{
+					{Name: "where", Type: ast.NonNullNamedType(whereInput, nil)},
+					{Name: "input", Type: ast.NonNullNamedType(input, nil)},
+				},
+				Type: ast.NonNullNamedType(names.UpdateManyPayload, nil),
+			})
+		}
+	}
+	return defs, fields, nil
+}
+
 func (e *schemaGenerator) fieldDefinitions(gqlType string, f *gen.Field, ant *Annotation) ([]*ast.FieldDefinition, error) {
 	ft, err := e.typeFromField(gqlType, f, ant)
 	if err != nil {
@@ -662,7 +1300,7 @@ func (e *schemaGenerator) fieldDefinitions(gqlType string, f *gen.Field, ant *An
 			Name:        name,
 			Type:        ft,
 			Description: f.Comment(),
-			Directives:  e.buildDirectives(ant.Directives),
+			Directives:  withAuthorize(e.buildDirectives(ant.Directives), ant),
 		}
 		// We check the field name with gqlgen's naming convention.
 		// To avoid unnecessary @goField directives
@@ -963,6 +1601,32 @@ https://relay.dev/graphql/connections.htm#sec-undefined.PageInfo`,
 	}
 }
 
+// offsetPageInfoType returns the PageInfo type of offset-based pages.
+func offsetPageInfoType() *ast.Definition {
+	return &ast.Definition{
+		Name:        OffsetPageInfoType,
+		Kind:        ast.Object,
+		Description: "Information about pagination in an offset-based page.",
+		Fields: []*ast.FieldDefinition{
+			{
+				Name:        "offset",
+				Type:        ast.NonNullNamedType("Int", nil),
+				Description: "The number of items skipped before the page.",
+			},
+			{
+				Name:        "pageSize",
+				Type:        ast.NonNullNamedType("Int", nil),
+				Description: "The maximum number of items in the page.",
+			},
+			{
+				Name:        "hasNext",
+				Type:        ast.NonNullNamedType("Boolean", nil),
+				Description: "Are there more items after the page?",
+			},
+		},
+	}
+}
+
 func relayConnectionTypes(t *gen.Type) ([]*ast.Definition, error) {
 	pagination, err := nodePaginationNames(t)
 	if err != nil {
@@ -988,6 +1652,23 @@ func listNamedType(name string, nullable bool) *ast.Type {
 
 func printSchema(schema *ast.Schema) string {
 	sb := &strings.Builder{}
+	// The formatter does not print the directives of the schema,
+	// and they are printed as a schema extension.
+	if len(schema.SchemaDirectives) > 0 {
+		sb.WriteString("extend schema")
+		for _, d := range schema.SchemaDirectives {
+			sb.WriteString(" @" + d.Name)
+			if len(d.Arguments) == 0 {
+				continue
+			}
+			args := make([]string, len(d.Arguments))
+			for i, a := range d.Arguments {
+				args[i] = a.Name + ": " + a.Value.String()
+			}
+			sb.WriteString("(" + strings.Join(args, ", ") + ")")
+		}
+		sb.WriteString("\n\n")
+	}
 	formatter.
 		NewFormatter(sb, formatter.WithIndent("  ")).
 		FormatSchema(schema)
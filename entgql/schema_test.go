@@ -17,10 +17,12 @@ package entgql
 import (
 	"os"
 	"reflect"
+	"strings"
 	"testing"
 
 	"entgo.io/ent/entc"
 	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/schema/field"
 	"github.com/stretchr/testify/require"
 	"github.com/vektah/gqlparser/v2/ast"
 )
@@ -72,6 +74,166 @@ func TestEntGQL_buildTypes_todoplugin_relay(t *testing.T) {
 	require.Equal(t, string(schemaExpect), output)
 }
 
+func TestEntGQL_buildTypes_mutationFields(t *testing.T) {
+	s, err := gen.NewStorage("sql")
+	require.NoError(t, err)
+
+	graph, err := entc.LoadGraph("./internal/todo/ent/schema", &gen.Config{
+		Storage: s,
+	})
+	require.NoError(t, err)
+	plugin := &schemaGenerator{genSchema: true, genWhereInput: true, genMutations: true, genMutationFields: true, relaySpec: true}
+	schema := &ast.Schema{
+		Types: make(map[string]*ast.Definition),
+	}
+	err = plugin.buildTypes(graph, schema)
+	require.NoError(t, err)
+	mutations := &ast.Schema{
+		Types: make(map[string]*ast.Definition),
+	}
+	for name, def := range schema.Types {
+		if name == MutationType || strings.HasSuffix(name, "Payload") {
+			mutations.AddTypes(def)
+		}
+	}
+	schemaExpect, err := os.ReadFile("./testdata/schema_mutations.graphql")
+	require.NoError(t, err)
+	output := printSchema(mutations)
+	if string(schemaExpect) != output {
+		require.NoError(t, os.WriteFile("./testdata/schema_mutations_output.graphql", []byte(output), 0644))
+	}
+	require.Equal(t, string(schemaExpect), output)
+}
+
+func TestEntGQL_buildTypes_mutationFieldsWithoutWhereInput(t *testing.T) {
+	s, err := gen.NewStorage("sql")
+	require.NoError(t, err)
+
+	graph, err := entc.LoadGraph("./internal/todo/ent/schema", &gen.Config{
+		Storage: s,
+	})
+	require.NoError(t, err)
+	plugin := &schemaGenerator{genSchema: true, genMutations: true, genMutationFields: true, relaySpec: true}
+	schema := &ast.Schema{
+		Types: make(map[string]*ast.Definition),
+	}
+	err = plugin.buildTypes(graph, schema)
+	require.EqualError(t, err, "bulk mutations of Todo require the TodoWhereInput type, please enable the entgql.WithWhereInputs option")
+}
+
+func TestEntGQL_buildTypes_upsertInputs(t *testing.T) {
+	s, err := gen.NewStorage("sql")
+	require.NoError(t, err)
+
+	graph, err := entc.LoadGraph("./internal/todouuid/ent/schema", &gen.Config{
+		Storage:  s,
+		Features: []gen.Feature{gen.FeatureUpsert},
+	})
+	require.NoError(t, err)
+	plugin := &schemaGenerator{genSchema: true, genWhereInput: true, genMutations: true, genMutationFields: true, relaySpec: true}
+	schema := &ast.Schema{
+		Types: make(map[string]*ast.Definition),
+	}
+	err = plugin.buildTypes(graph, schema)
+	require.NoError(t, err)
+	upserts := &ast.Schema{
+		Types: make(map[string]*ast.Definition),
+	}
+	for name, def := range schema.Types {
+		if strings.HasPrefix(name, "Upsert") || strings.HasSuffix(name, "ConflictTarget") || strings.HasSuffix(name, "UpsertField") {
+			upserts.AddTypes(def)
+		}
+	}
+	require.Equal(t, `"""
+Unique fields and indexes by which UpsertBillProductInput conflicts are detected.
+"""
+enum BillProductConflictTarget {
+  SKU
+}
+"""
+Fields that UpsertBillProductInput updates on conflict.
+"""
+enum BillProductUpsertField {
+  NAME
+  SKU
+  QUANTITY
+}
+"""
+UpsertBillProductInput is used for upsert BillProduct object.
+Input was generated by ent.
+"""
+input UpsertBillProductInput {
+  """
+  The node to create.
+  """
+  create: CreateBillProductInput!
+  """
+  The unique field or index that detects the conflict.
+  """
+  conflictTarget: BillProductConflictTarget!
+  """
+  The fields to update on conflict. If empty, all fields set on create are updated.
+  """
+  update: [BillProductUpsertField!]
+}
+"""
+Return response for upsertBillProduct mutation.
+"""
+type UpsertBillProductPayload {
+  """
+  Created or updated BillProduct.
+  """
+  billProduct: BillProduct!
+}
+`, printSchema(upserts))
+	f := schema.Types[MutationType].Fields.ForName("upsertBillProduct")
+	require.NotNil(t, f)
+	require.Equal(t, "UpsertBillProductPayload!", f.Type.String())
+
+	graph, err = entc.LoadGraph("./internal/todouuid/ent/schema", &gen.Config{
+		Storage: s,
+	})
+	require.NoError(t, err)
+	err = plugin.buildTypes(graph, &ast.Schema{Types: make(map[string]*ast.Definition)})
+	require.EqualError(t, err, "entgql: MutationUpsert of BillProduct requires the sql/upsert feature flag")
+}
+
+func TestEntGQL_buildTypes_subscriptionFields(t *testing.T) {
+	s, err := gen.NewStorage("sql")
+	require.NoError(t, err)
+
+	graph, err := entc.LoadGraph("./internal/todouuid/ent/schema", &gen.Config{
+		Storage:  s,
+		Features: []gen.Feature{gen.FeatureUpsert},
+	})
+	require.NoError(t, err)
+	plugin := &schemaGenerator{genSchema: true, genWhereInput: true, relaySpec: true}
+	schema := &ast.Schema{
+		Types: make(map[string]*ast.Definition),
+	}
+	err = plugin.buildTypes(graph, schema)
+	require.NoError(t, err)
+	require.Equal(t, `type Subscription {
+  """
+  Delivers the created Todos matching the given filter.
+  """
+  todoCreated(where: TodoWhereInput): Todo!
+  """
+  Delivers the updated Todos matching the given filter.
+  """
+  todoUpdated(where: TodoWhereInput): Todo!
+  """
+  Delivers the IDs of the deleted Todos.
+  """
+  todoDeleted: ID!
+}
+`, printSchema(&ast.Schema{Types: map[string]*ast.Definition{SubscriptionType: schema.Types[SubscriptionType]}}))
+
+	plugin = &schemaGenerator{genSchema: true, relaySpec: true}
+	err = plugin.buildTypes(graph, &ast.Schema{Types: make(map[string]*ast.Definition)})
+	require.EqualError(t, err, "subscriptions of Todo require the TodoWhereInput type, please enable the entgql.WithWhereInputs option")
+}
+
 func TestSchema_relayConnectionTypes(t *testing.T) {
 	type args struct {
 		t *gen.Type
@@ -246,11 +408,61 @@ type PageInfo {
 	}
 }
 
+func TestSchema_federation(t *testing.T) {
+	id := &gen.Field{Name: "id", Type: &field.TypeInfo{Type: field.TypeInt}}
+	user := &gen.Type{Name: "User", ID: id}
+	keys, err := federationKeys(user, &Annotation{FederationKeys: [][]string{{"id"}}}, "User")
+	require.NoError(t, err)
+	s := &ast.Schema{Directives: map[string]*ast.DirectiveDefinition{}}
+	s.AddTypes(relayBuiltinTypes("todo/ent")[2], &ast.Definition{
+		Kind:       ast.Object,
+		Name:       "User",
+		Directives: keys,
+		Fields:     ast.FieldList{{Name: "id", Type: ast.NonNullNamedType("ID", nil)}},
+	})
+	mayAddFederation(s)
+	require.Equal(t, `extend schema @link(url: "https://specs.apollo.dev/federation/v2.3", import: ["@key","@shareable"])
+
+directive @entityResolver(multi: Boolean) on OBJECT
+"""
+Information about pagination in a connection.
+https://relay.dev/graphql/connections.htm#sec-undefined.PageInfo
+"""
+type PageInfo @shareable {
+  """
+  When paginating forwards, are there more items?
+  """
+  hasNextPage: Boolean!
+  """
+  When paginating backwards, are there more items?
+  """
+  hasPreviousPage: Boolean!
+  """
+  When paginating backwards, the cursor to continue.
+  """
+  startCursor: Cursor
+  """
+  When paginating forwards, the cursor to continue.
+  """
+  endCursor: Cursor
+}
+type User @key(fields: "id") @entityResolver(multi: true) {
+  id: ID!
+}
+`, printSchema(s))
+
+	keys, err = federationKeys(user, &Annotation{}, "User")
+	require.NoError(t, err)
+	require.Empty(t, keys)
+}
+
 func disableRelayConnection(g *gen.Graph) {
 	disable := func(a gen.Annotations) {
 		if ant, ok := a[annotationName]; ok {
 			if m, ok := ant.(map[string]interface{}); ok {
 				m["RelayConnection"] = false
+				// Aggregations are exposed on connections.
+				m["Aggregate"], m["GroupBy"] = false, false
 			}
 		}
 	}
@@ -28,6 +28,7 @@ import (
 
 	"entgo.io/ent/entc/gen"
 	"entgo.io/ent/schema/field"
+	"github.com/99designs/gqlgen/codegen/templates"
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/vektah/gqlparser/v2/ast"
 	"golang.org/x/exp/slices"
@@ -47,6 +48,10 @@ var (
 	// NodeDescriptorTemplate implements the Node descriptor API for all types.
 	NodeDescriptorTemplate = parseT("template/node_descriptor.tmpl")
 
+	// GlobalIDTemplate adds a template for encoding the IDs of the nodes as opaque
+	// global IDs. See WithGlobalID for more info.
+	GlobalIDTemplate = parseT("template/globalid.tmpl")
+
 	// PaginationTemplate adds pagination support according to the GraphQL Cursor Connections Spec.
 	// More info can be found in the following link: https://relay.dev/graphql/connections.htm.
 	PaginationTemplate = parseT("template/pagination.tmpl")
@@ -64,6 +69,26 @@ var (
 	// MutationInputTemplate adds a template for generating Create<T>Input and Update<T>Input for each schema type.
 	MutationInputTemplate = parseT("template/mutation_input.tmpl").SkipIf(skipMutationTemplate)
 
+	// MutationTemplate adds a template for generating the payloads and the default
+	// resolvers of the Mutation fields. See WithMutationFields for more info.
+	MutationTemplate = parseT("template/mutation.tmpl").SkipIf(skipMutationFieldsTemplate)
+
+	// AggregateTemplate adds a template for generating the aggregate field of the
+	// connections of types with aggregated fields. See Aggregate for more info.
+	AggregateTemplate = parseT("template/aggregate.tmpl").SkipIf(skipAggregateTemplate)
+
+	// SubscriptionTemplate adds a template for generating the hook publishing the mutations
+	// and the default resolvers of the Subscription fields. See Subscriptions for more info.
+	SubscriptionTemplate = parseT("template/subscription.tmpl").SkipIf(skipSubscriptionTemplate)
+
+	// ComplexityTemplate adds a template for generating the complexity functions of the
+	// connections and list edges of the schema. See ComplexityLimit for more info.
+	ComplexityTemplate = parseT("template/complexity.tmpl").SkipIf(skipComplexityTemplate)
+
+	// FederationTemplate adds a template for generating the batched entity resolvers
+	// of the types annotated with FederationKey.
+	FederationTemplate = parseT("template/federation.tmpl").SkipIf(skipFederationTemplate)
+
 	// AllTemplates holds all templates for extending ent to support GraphQL.
 	AllTemplates = []*gen.Template{
 		CollectionTemplate,
@@ -73,28 +98,48 @@ var (
 		TransactionTemplate,
 		EdgeTemplate,
 		MutationInputTemplate,
+		SubscriptionTemplate,
+		AggregateTemplate,
+		ComplexityTemplate,
+		FederationTemplate,
 	}
 
 	// TemplateFuncs contains the extra template functions used by entgql.
 	TemplateFuncs = template.FuncMap{
-		"fieldCollections":    fieldCollections,
-		"fieldMapping":        fieldMapping,
-		"fieldCollectedFor":   fieldCollectedFor,
-		"filterEdges":         filterEdges,
-		"filterFields":        filterFields,
-		"filterNodes":         filterNodes,
-		"gqlIDType":           gqlIDType,
-		"gqlMarshaler":        gqlMarshaler,
-		"gqlUnmarshaler":      gqlUnmarshaler,
-		"hasWhereInput":       hasWhereInput,
-		"isRelayConn":         isRelayConn,
-		"isSkipMode":          isSkipMode,
-		"mutationInputs":      mutationInputs,
-		"nodeImplementors":    nodeImplementors,
-		"nodeImplementorsVar": nodeImplementorsVar,
-		"nodePaginationNames": nodePaginationNames,
-		"orderFields":         orderFields,
-		"skipMode":            skipModeFromString,
+		"authorizePolicies":     authorizePolicies,
+		"fieldCollections":      fieldCollections,
+		"fieldMapping":          fieldMapping,
+		"fieldCollectedFor":     fieldCollectedFor,
+		"fieldWherePredicates":  fieldWherePredicates,
+		"federationEntities":    federationEntities,
+		"compositeIDNodes":      compositeIDNodes,
+		"filterEdges":           filterEdges,
+		"filterFields":          filterFields,
+		"filterNodes":           filterNodes,
+		"globalIDFields":        globalIDFields,
+		"gqlIDType":             gqlIDType,
+		"gqlMarshaler":          gqlMarshaler,
+		"gqlUnmarshaler":        gqlUnmarshaler,
+		"hasJSONPathValue":      hasJSONPathValue,
+		"hasWhereInput":         hasWhereInput,
+		"isOffsetPage":          isOffsetPage,
+		"isRelayConn":           isRelayConn,
+		"isSkipMode":            isSkipMode,
+		"maxPageSize":           maxPageSize,
+		"mutationFields":        mutationFields,
+		"mutationInputs":        mutationInputs,
+		"aggregateNodes":        aggregateNodes,
+		"complexityTypes":       complexityTypes,
+		"nodeAggregate":         nodeAggregate,
+		"nodeImplementors":      nodeImplementors,
+		"nodeImplementorsVar":   nodeImplementorsVar,
+		"nodeMutationNames":     nodeMutationNames,
+		"nodeSubscriptionNames": nodeSubscriptionNames,
+		"subscriptionNodes":     subscriptionNodes,
+		"upsertInputs":          upsertInputs,
+		"nodePaginationNames":   nodePaginationNames,
+		"orderFields":           orderFields,
+		"skipMode":              skipModeFromString,
 	}
 
 	//go:embed template/*
@@ -198,6 +243,11 @@ func fieldCollections(edges []*gen.Edge) ([]*fieldCollection, error) {
 type MutationDescriptor struct {
 	*gen.Type
 	IsCreate bool
+	// IsDelete, IsUpsert and IsBulk are set only on descriptors
+	// of Mutation fields. Delete mutations have no input.
+	IsDelete bool
+	IsUpsert bool
+	IsBulk   bool
 }
 
 // Input returns the input's name.
@@ -206,6 +256,9 @@ func (m *MutationDescriptor) Input() (string, error) {
 	if err != nil {
 		return "", err
 	}
+	if m.IsUpsert {
+		return fmt.Sprintf("Upsert%sInput", gqlType), nil
+	}
 	if m.IsCreate {
 		return fmt.Sprintf("Create%sInput", gqlType), nil
 	}
@@ -284,6 +337,64 @@ func (m *MutationDescriptor) InputEdges() ([]*gen.Edge, error) {
 	return edges, nil
 }
 
+// NestedEdgeDescriptor holds information about an edge
+// whose nodes are created together with the input.
+type NestedEdgeDescriptor struct {
+	*gen.Edge
+	// Input is the name of the input type used to create the edge nodes.
+	Input string
+}
+
+// Field returns the name of the input field, e.g. "CreateChildren".
+func (e *NestedEdgeDescriptor) Field() string {
+	return "Create" + pascal(e.Name)
+}
+
+// NestedEdges returns the list of edges annotated with NestedCreate
+// in the input type. Only create inputs have nested edges.
+func (m *MutationDescriptor) NestedEdges() ([]*NestedEdgeDescriptor, error) {
+	if !m.IsCreate {
+		return nil, nil
+	}
+	edges, err := m.InputEdges()
+	if err != nil {
+		return nil, err
+	}
+	var nested []*NestedEdgeDescriptor
+	for _, e := range edges {
+		ant, err := annotation(e.Annotations)
+		if err != nil {
+			return nil, err
+		}
+		if !ant.NestedCreate {
+			continue
+		}
+		if e.Ref != nil && !e.Ref.Optional {
+			return nil, fmt.Errorf("entgql: nested creation of edge %s.%s requires its inverse edge %s to be optional", m.Name, e.Name, e.Ref.Name)
+		}
+		if !hasCreateInput(e.Type) {
+			return nil, fmt.Errorf("entgql: nested creation of edge %s.%s requires a create input for type %s", m.Name, e.Name, e.Type.Name)
+		}
+		input, err := (&MutationDescriptor{Type: e.Type, IsCreate: true}).Input()
+		if err != nil {
+			return nil, err
+		}
+		nested = append(nested, &NestedEdgeDescriptor{Edge: e, Input: input})
+	}
+	return nested, nil
+}
+
+// hasCreateInput reports if a Create<T>Input is generated for the given type.
+func hasCreateInput(t *gen.Type) bool {
+	ant, err := annotation(t.Annotations)
+	if err != nil || ant.Skip.Is(SkipMutationCreateInput) {
+		return false
+	}
+	return slices.ContainsFunc(ant.MutationInputs, func(i MutationConfig) bool {
+		return i.IsCreate
+	})
+}
+
 func (m *MutationDescriptor) skip(immutable bool, skip SkipMode) bool {
 	if m.IsCreate {
 		return skip.Is(SkipMutationCreateInput)
@@ -301,6 +412,9 @@ func mutationInputs(nodes []*gen.Type) ([]*MutationDescriptor, error) {
 		}
 
 		for _, a := range ant.MutationInputs {
+			if a.IsDelete || a.IsUpsert {
+				continue
+			}
 			if (a.IsCreate && ant.Skip.Is(SkipMutationCreateInput)) ||
 				(!a.IsCreate && ant.Skip.Is(SkipMutationUpdateInput)) {
 				continue
@@ -315,6 +429,154 @@ func mutationInputs(nodes []*gen.Type) ([]*MutationDescriptor, error) {
 	return filteredNodes, nil
 }
 
+// UpsertDescriptor holds information about a GraphQL upsert input.
+type UpsertDescriptor struct {
+	*gen.Type
+	// Input, Create, ConflictTarget and UpsertField are the names
+	// of the upsert input, its create input and its enum types.
+	Input          string
+	Create         string
+	ConflictTarget string
+	UpsertField    string
+}
+
+// EnumValue holds a value of an enum type generated by entgql, such as
+// the conflict targets of upsert inputs or the group-by fields of aggregations.
+type EnumValue struct {
+	// Name of the GraphQL enum value, e.g. "NAME_OWNER".
+	Name string
+	// Ident is the suffix of the Go constant of the value, e.g. "NameOwner".
+	Ident string
+	// Fields holds the fields whose columns the value stands for.
+	Fields []*gen.Field
+}
+
+func newEnumValue(fields ...*gen.Field) *EnumValue {
+	names := make([]string, len(fields))
+	for i, f := range fields {
+		names[i] = snake(f.Name)
+	}
+	name := strings.Join(names, "_")
+	return &EnumValue{Name: strings.ToUpper(name), Ident: pascal(name), Fields: fields}
+}
+
+// newUpsertDescriptor returns the upsert descriptor of the given type.
+func newUpsertDescriptor(t *gen.Type) (*UpsertDescriptor, error) {
+	if enabled, err := t.Config.FeatureEnabled(gen.FeatureUpsert.Name); err != nil || !enabled {
+		return nil, fmt.Errorf("entgql: MutationUpsert of %s requires the %s feature flag", t.Name, gen.FeatureUpsert.Name)
+	}
+	if !hasCreateInput(t) {
+		return nil, fmt.Errorf("entgql: MutationUpsert of %s requires a create input", t.Name)
+	}
+	gqlType, _, err := gqlTypeFromNode(t)
+	if err != nil {
+		return nil, err
+	}
+	return &UpsertDescriptor{
+		Type:           t,
+		Input:          fmt.Sprintf("Upsert%sInput", gqlType),
+		Create:         fmt.Sprintf("Create%sInput", gqlType),
+		ConflictTarget: fmt.Sprintf("%sConflictTarget", gqlType),
+		UpsertField:    fmt.Sprintf("%sUpsertField", gqlType),
+	}, nil
+}
+
+// ConflictTargets returns the values of the conflict target enum. Unique
+// fields and unique indexes that are defined only on fields are valid targets.
+func (u *UpsertDescriptor) ConflictTargets() ([]*EnumValue, error) {
+	var targets []*EnumValue
+	for _, f := range u.Fields {
+		if f.Unique {
+			targets = append(targets, newEnumValue(f))
+		}
+	}
+	for _, idx := range u.Indexes {
+		if !idx.Unique {
+			continue
+		}
+		fields := make([]*gen.Field, 0, len(idx.Columns))
+		for _, c := range idx.Columns {
+			i := slices.IndexFunc(u.Fields, func(f *gen.Field) bool { return f.StorageKey() == c })
+			if i == -1 {
+				break
+			}
+			fields = append(fields, u.Fields[i])
+		}
+		if len(fields) == len(idx.Columns) {
+			targets = append(targets, newEnumValue(fields...))
+		}
+	}
+	if len(targets) == 0 {
+		return nil, fmt.Errorf("entgql: MutationUpsert of %s requires a unique field or index", u.Name)
+	}
+	return targets, nil
+}
+
+// UpsertFields returns the values of the enum of the fields
+// that can be updated on conflict.
+func (u *UpsertDescriptor) UpsertFields() ([]*EnumValue, error) {
+	fields, err := (&MutationDescriptor{Type: u.Type, IsCreate: true}).InputFields()
+	if err != nil {
+		return nil, err
+	}
+	values := make([]*EnumValue, 0, len(fields))
+	for _, f := range fields {
+		if !f.Immutable {
+			values = append(values, newEnumValue(f.Field))
+		}
+	}
+	return values, nil
+}
+
+// upsertInputs returns the list of upsert inputs.
+func upsertInputs(nodes []*gen.Type) ([]*UpsertDescriptor, error) {
+	var inputs []*UpsertDescriptor
+	for _, n := range nodes {
+		ant, err := annotation(n.Annotations)
+		if err != nil {
+			return nil, err
+		}
+		if !slices.ContainsFunc(ant.MutationInputs, func(i MutationConfig) bool { return i.IsUpsert }) {
+			continue
+		}
+		u, err := newUpsertDescriptor(n)
+		if err != nil {
+			return nil, err
+		}
+		inputs = append(inputs, u)
+	}
+	return inputs, nil
+}
+
+// mutationFields returns the list of Mutation fields
+// of the types that are included in the GraphQL schema.
+func mutationFields(nodes []*gen.Type) ([]*MutationDescriptor, error) {
+	filteredFields := make([]*MutationDescriptor, 0, len(nodes))
+	for _, n := range nodes {
+		ant, err := annotation(n.Annotations)
+		if err != nil {
+			return nil, err
+		}
+		if n.HasCompositeID() || ant.Skip.Is(SkipType) {
+			continue
+		}
+		for _, a := range ant.MutationInputs {
+			if !a.IsDelete && !a.IsUpsert && ((a.IsCreate && ant.Skip.Is(SkipMutationCreateInput)) ||
+				(!a.IsCreate && ant.Skip.Is(SkipMutationUpdateInput))) {
+				continue
+			}
+			filteredFields = append(filteredFields, &MutationDescriptor{
+				Type:     n,
+				IsCreate: a.IsCreate,
+				IsDelete: a.IsDelete,
+				IsUpsert: a.IsUpsert,
+				IsBulk:   a.IsBulk,
+			})
+		}
+	}
+	return filteredFields, nil
+}
+
 // filterNodes filters out nodes that should not be included in the GraphQL schema.
 func filterNodes(nodes []*gen.Type, skip SkipMode) ([]*gen.Type, error) {
 	filteredNodes := make([]*gen.Type, 0, len(nodes))
@@ -380,6 +642,39 @@ func fieldCollectedFor(f *gen.Field) ([]string, error) {
 	return ant.CollectedFor, nil
 }
 
+// authorizePolicies returns the policies of the Authorize annotations of the given field, or of
+// the given edge and the type it points to. It returns nil if none of them are annotated.
+func authorizePolicies(v any) (*AuthorizeConfig, error) {
+	var annotations []gen.Annotations
+	switch v := v.(type) {
+	case *gen.Field:
+		annotations = append(annotations, v.Annotations)
+	case *gen.Edge:
+		annotations = append(annotations, v.Annotations, v.Type.Annotations)
+	default:
+		return nil, fmt.Errorf("entgql: unexpected authorize type %T", v)
+	}
+	var config *AuthorizeConfig
+	for _, a := range annotations {
+		ant, err := annotation(a)
+		if err != nil {
+			return nil, err
+		}
+		if ant.Authorize == nil {
+			continue
+		}
+		if config == nil {
+			config = &AuthorizeConfig{}
+		}
+		for _, p := range ant.Authorize.Policies {
+			if !slices.Contains(config.Policies, p) {
+				config.Policies = append(config.Policies, p)
+			}
+		}
+	}
+	return config, nil
+}
+
 // OrderTerm is a struct that represents a single GraphQL order term.
 type OrderTerm struct {
 	// The type that owns the order field.
@@ -565,6 +860,24 @@ func isRelayConn(e *gen.Edge) (bool, error) {
 	return ant.RelayConnection, nil
 }
 
+// isOffsetPage reports if the given type or edge has the OffsetPagination annotation.
+func isOffsetPage(v any) (bool, error) {
+	var ants gen.Annotations
+	switch v := v.(type) {
+	case *gen.Type:
+		ants = v.Annotations
+	case *gen.Edge:
+		ants = v.Annotations
+	default:
+		return false, fmt.Errorf("entgql: unexpected offset pagination type %T", v)
+	}
+	ant, err := annotation(ants)
+	if err != nil {
+		return false, err
+	}
+	return ant.OffsetPagination, nil
+}
+
 // PaginationNames holds the names of the pagination fields.
 type PaginationNames struct {
 	Connection string
@@ -572,6 +885,7 @@ type PaginationNames struct {
 	Node       string
 	Order      string
 	OrderField string
+	Page       string
 	WhereInput string
 }
 
@@ -670,26 +984,80 @@ func (p *PaginationNames) ConnectionField(name string, hasOrderBy, multiOrder, h
 			},
 		},
 	}
+	def.Arguments = append(def.Arguments, p.filterArguments("connection", hasOrderBy, multiOrder, hasWhereInput)...)
+	return def
+}
+
+// PageTypeDef returns the definition of the offset-based page type.
+func (p *PaginationNames) PageTypeDef() *ast.Definition {
+	return &ast.Definition{
+		Name:        p.Page,
+		Kind:        ast.Object,
+		Description: "A page of items, queried by offset and limit.",
+		Fields: []*ast.FieldDefinition{
+			{
+				Name:        "nodes",
+				Type:        ast.NonNullListType(ast.NonNullNamedType(p.Node, nil), nil),
+				Description: "The items of the page.",
+			},
+			{
+				Name:        "totalCount",
+				Type:        ast.NonNullNamedType("Int", nil),
+				Description: "Identifies the total count of items in all pages.",
+			},
+			{
+				Name:        "pageInfo",
+				Type:        ast.NonNullNamedType(OffsetPageInfoType, nil),
+				Description: "Information to aid in pagination.",
+			},
+		},
+	}
+}
+
+// PageField returns the definition of an offset-based page field.
+func (p *PaginationNames) PageField(name string, hasOrderBy, multiOrder, hasWhereInput bool) *ast.FieldDefinition {
+	def := &ast.FieldDefinition{
+		Name: name,
+		Type: ast.NonNullNamedType(p.Page, nil),
+		Arguments: ast.ArgumentDefinitionList{
+			{
+				Name:        "offset",
+				Type:        ast.NamedType("Int", nil),
+				Description: "Returns the elements in the list that come after the first _n_ elements.",
+			},
+			{
+				Name:        "limit",
+				Type:        ast.NamedType("Int", nil),
+				Description: "Returns at most _n_ elements from the list.",
+			},
+		},
+	}
+	def.Arguments = append(def.Arguments, p.filterArguments("page", hasOrderBy, multiOrder, hasWhereInput)...)
+	return def
+}
+
+// filterArguments returns the orderBy and where arguments of a connection or a page field.
+func (p *PaginationNames) filterArguments(kind string, hasOrderBy, multiOrder, hasWhereInput bool) ast.ArgumentDefinitionList {
+	var args ast.ArgumentDefinitionList
 	if hasOrderBy {
 		orderT := ast.NamedType(p.Order, nil)
 		if multiOrder {
 			orderT = ast.ListType(ast.NonNullNamedType(p.Order, nil), nil)
 		}
-		def.Arguments = append(def.Arguments, &ast.ArgumentDefinition{
+		args = append(args, &ast.ArgumentDefinition{
 			Name:        "orderBy",
 			Type:        orderT,
-			Description: fmt.Sprintf("Ordering options for %s returned from the connection.", plural(p.Node)),
+			Description: fmt.Sprintf("Ordering options for %s returned from the %s.", plural(p.Node), kind),
 		})
 	}
 	if hasWhereInput {
-		def.Arguments = append(def.Arguments, &ast.ArgumentDefinition{
+		args = append(args, &ast.ArgumentDefinition{
 			Name:        "where",
 			Type:        ast.NamedType(p.WhereInput, nil),
-			Description: fmt.Sprintf("Filtering options for %s returned from the connection.", plural(p.Node)),
+			Description: fmt.Sprintf("Filtering options for %s returned from the %s.", plural(p.Node), kind),
 		})
 	}
-
-	return def
+	return args
 }
 
 func gqlTypeFromNode(t *gen.Type) (gqlType string, ant *Annotation, err error) {
@@ -720,14 +1088,689 @@ func paginationNames(node string) *PaginationNames {
 		Node:       node,
 		Order:      fmt.Sprintf("%sOrder", node),
 		OrderField: fmt.Sprintf("%sOrderField", node),
+		Page:       fmt.Sprintf("%sPage", node),
 		WhereInput: fmt.Sprintf("%sWhereInput", node),
 	}
 }
 
+// AggregateNames holds the names of the aggregation types of a type.
+type AggregateNames struct {
+	Aggregate    string
+	Sum          string
+	Avg          string
+	MinMax       string
+	GroupByField string
+}
+
+func aggregateNames(node string) *AggregateNames {
+	return &AggregateNames{
+		Aggregate:    fmt.Sprintf("%sAggregate", node),
+		Sum:          fmt.Sprintf("%sAggregateSum", node),
+		Avg:          fmt.Sprintf("%sAggregateAvg", node),
+		MinMax:       fmt.Sprintf("%sAggregateMinMax", node),
+		GroupByField: fmt.Sprintf("%sGroupByField", node),
+	}
+}
+
+// AggregateDescriptor holds information about the aggregate field of a type connection.
+type AggregateDescriptor struct {
+	*gen.Type
+	*AggregateNames
+	// Fields holds the aggregated fields.
+	Fields []*gen.Field
+	// GroupBy holds the values of the group-by enum.
+	GroupBy []*EnumValue
+}
+
+// Numeric returns the aggregated fields that have a sum and an average.
+func (a *AggregateDescriptor) Numeric() []*gen.Field {
+	var fields []*gen.Field
+	for _, f := range a.Fields {
+		if f.Type.Numeric() {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// aggregateFieldNames are the names of the AggregateNames.Aggregate fields
+// that can not be used by the grouped fields.
+var aggregateFieldNames = []string{"count", "sum", "avg", "min", "max"}
+
+// nodeAggregate returns the aggregate descriptor of the given type,
+// or nil if none of its fields are aggregated or grouped by.
+func nodeAggregate(t *gen.Type) (*AggregateDescriptor, error) {
+	var (
+		fields  []*gen.Field
+		groupBy []*EnumValue
+	)
+	for _, f := range t.Fields {
+		ant, err := annotation(f.Annotations)
+		if err != nil {
+			return nil, err
+		}
+		if ant.Skip.Is(SkipType) {
+			continue
+		}
+		if ant.Aggregate {
+			if !f.Type.Numeric() && f.Type.Type != field.TypeTime {
+				return nil, fmt.Errorf("entgql: aggregate field %s.%s must be numeric or time", t.Name, f.Name)
+			}
+			fields = append(fields, f)
+		}
+		if ant.GroupBy {
+			if !f.IsEnum() && !f.Type.Numeric() && f.Type.Type != field.TypeString && f.Type.Type != field.TypeBool {
+				return nil, fmt.Errorf("entgql: group-by field %s.%s must be an enum, string, bool or numeric", t.Name, f.Name)
+			}
+			if slices.Contains(aggregateFieldNames, camel(f.Name)) {
+				return nil, fmt.Errorf("entgql: group-by field %s.%s conflicts with the aggregate field %q", t.Name, f.Name, camel(f.Name))
+			}
+			groupBy = append(groupBy, newEnumValue(f))
+		}
+	}
+	if len(fields) == 0 && len(groupBy) == 0 {
+		return nil, nil
+	}
+	gqlType, ant, err := gqlTypeFromNode(t)
+	if err != nil {
+		return nil, err
+	}
+	if !ant.RelayConnection {
+		return nil, fmt.Errorf("entgql: aggregations of %s require the entgql.RelayConnection annotation", t.Name)
+	}
+	return &AggregateDescriptor{
+		Type:           t,
+		AggregateNames: aggregateNames(gqlType),
+		Fields:         fields,
+		GroupBy:        groupBy,
+	}, nil
+}
+
+// aggregateNodes returns the aggregate descriptors of the given nodes.
+func aggregateNodes(nodes []*gen.Type) ([]*AggregateDescriptor, error) {
+	var aggs []*AggregateDescriptor
+	for _, n := range nodes {
+		ant, err := annotation(n.Annotations)
+		if err != nil {
+			return nil, err
+		}
+		if ant.Skip.Is(SkipType) {
+			continue
+		}
+		a, err := nodeAggregate(n)
+		if err != nil {
+			return nil, err
+		}
+		if a != nil {
+			aggs = append(aggs, a)
+		}
+	}
+	return aggs, nil
+}
+
+// maxPageSize returns the maximum page size of the connections of the given type, or 0 if it has none.
+func maxPageSize(t *gen.Type) (int, error) {
+	ant, err := annotation(t.Annotations)
+	if err != nil {
+		return 0, err
+	}
+	if ant.MaxPageSize < 0 {
+		return 0, fmt.Errorf("entgql: MaxPageSize of %s must be positive", t.Name)
+	}
+	return ant.MaxPageSize, nil
+}
+
+// ComplexityType holds the complexity of the fields of a GraphQL object type.
+type ComplexityType struct {
+	// Name of the GraphQL type, e.g. "Query".
+	Name string
+	// Fields holds the fields whose complexity depends on the number of returned nodes.
+	Fields []*ComplexityField
+}
+
+// ComplexityField holds information about a GraphQL field that returns a connection or a list of nodes.
+type ComplexityField struct {
+	// Name of the GraphQL field, e.g. "todos".
+	Name string
+	// Connection reports if the field returns a connection. Otherwise,
+	// it returns the list of nodes of a non-unique edge or a query field.
+	Connection bool
+	// MaxPageSize is the maximum page size of the connection, or 0 if it has none.
+	MaxPageSize int
+}
+
+// complexityTypes returns the complexity of the connections and the lists of nodes
+// of the given types. The multiplicity of edges is derived from their cardinality,
+// as unique edges resolve to a single node and do not require a complexity function.
+func complexityTypes(nodes []*gen.Type) ([]*ComplexityType, error) {
+	var (
+		types []*ComplexityType
+		query = &ComplexityType{Name: QueryType}
+	)
+	newField := func(name string, t *gen.Type, connection bool) (*ComplexityField, error) {
+		size, err := maxPageSize(t)
+		if err != nil {
+			return nil, err
+		}
+		return &ComplexityField{Name: name, Connection: connection, MaxPageSize: size}, nil
+	}
+	for _, n := range nodes {
+		if n.HasCompositeID() {
+			continue
+		}
+		gqlType, ant, err := gqlTypeFromNode(n)
+		if err != nil {
+			return nil, err
+		}
+		if ant.Skip.Is(SkipType) {
+			continue
+		}
+		if ant.QueryField != nil {
+			f, err := newField(ant.QueryField.fieldName(gqlType), n, ant.RelayConnection)
+			if err != nil {
+				return nil, err
+			}
+			query.Fields = append(query.Fields, f)
+		}
+		edges, err := filterEdges(n.Edges, SkipType)
+		if err != nil {
+			return nil, err
+		}
+		ct := &ComplexityType{Name: gqlType}
+		for _, e := range edges {
+			if e.Unique || e.Type.HasCompositeID() {
+				continue
+			}
+			edgeAnt, err := annotation(e.Annotations)
+			if err != nil {
+				return nil, err
+			}
+			_, typeAnt, err := gqlTypeFromNode(e.Type)
+			if err != nil {
+				return nil, err
+			}
+			if typeAnt.Skip.Is(SkipType) {
+				continue
+			}
+			mappings := []string{camel(e.Name)}
+			if len(edgeAnt.Mapping) > 0 {
+				mappings = edgeAnt.Mapping
+			}
+			for _, name := range mappings {
+				// Relay connections defined on the edges of a non-connection edge-schema are generated as lists.
+				f, err := newField(name, e.Type, edgeAnt.RelayConnection && typeAnt.RelayConnection)
+				if err != nil {
+					return nil, err
+				}
+				ct.Fields = append(ct.Fields, f)
+			}
+		}
+		if len(ct.Fields) > 0 {
+			types = append(types, ct)
+		}
+	}
+	if len(query.Fields) > 0 {
+		types = append([]*ComplexityType{query}, types...)
+	}
+	return types, nil
+}
+
+// FederationEntity holds the Apollo Federation keys of a GraphQL object type.
+type FederationEntity struct {
+	// Type is the ent type of the entity.
+	Type *gen.Type
+	// Name of the GraphQL type, e.g. "Todo".
+	Name string
+	// Keys holds the keys of the entity, in the order they were defined.
+	Keys []*FederationEntityKey
+}
+
+// FederationEntityKey holds information about a key of a federation entity.
+type FederationEntityKey struct {
+	// Fields holds the fields of the key. The id field is represented by the ID of the type.
+	Fields []*gen.Field
+	// Names holds the GraphQL names of the fields.
+	Names []string
+	// FieldSet is the fields argument of the @key directive, e.g. "text status".
+	FieldSet string
+	// GoNames holds the names of the fields in the Input struct, as expected by gqlgen.
+	GoNames []string
+	// Input is the name of the representations type of the key, e.g. "TodoByIDsInput".
+	Input string
+	// Resolver is the name of the batched resolver of the key, e.g. "FindManyTodoByIDs".
+	Resolver string
+}
+
+// IsID reports if the key consists only of the id field.
+func (k *FederationEntityKey) IsID() bool {
+	return len(k.Fields) == 1 && k.Fields[0].Name == "id"
+}
+
+// federationEntities returns the types annotated with FederationKey, and their keys.
+func federationEntities(nodes []*gen.Type) ([]*FederationEntity, error) {
+	var entities []*FederationEntity
+	for _, n := range nodes {
+		gqlType, ant, err := gqlTypeFromNode(n)
+		if err != nil {
+			return nil, err
+		}
+		if len(ant.FederationKeys) == 0 || ant.Skip.Is(SkipType) {
+			continue
+		}
+		if n.HasCompositeID() {
+			return nil, fmt.Errorf("entgql: federation keys are not supported on type %s with a composite id", n.Name)
+		}
+		e := &FederationEntity{Type: n, Name: gqlType}
+		for _, fields := range ant.FederationKeys {
+			k, err := federationEntityKey(n, gqlType, fields)
+			if err != nil {
+				return nil, err
+			}
+			e.Keys = append(e.Keys, k)
+		}
+		entities = append(entities, e)
+	}
+	return entities, nil
+}
+
+// federationEntityKey returns the key of the given type defined by the given ent fields.
+func federationEntityKey(t *gen.Type, gqlType string, fields []string) (*FederationEntityKey, error) {
+	k := &FederationEntityKey{}
+	for _, name := range fields {
+		f := t.ID
+		if name != f.Name {
+			idx := slices.IndexFunc(t.Fields, func(f *gen.Field) bool { return f.Name == name })
+			if idx == -1 {
+				return nil, fmt.Errorf("entgql: federation key field %q was not found in type %s", name, t.Name)
+			}
+			f = t.Fields[idx]
+		}
+		switch {
+		case f.Nillable, f.IsJSON(), f.IsBytes(), f.IsOther(), f.IsTime():
+			return nil, fmt.Errorf("entgql: field %q of type %s cannot be used as a federation key", name, t.Name)
+		}
+		mapping, err := fieldMapping(f)
+		if err != nil {
+			return nil, err
+		}
+		if len(mapping) == 0 {
+			return nil, fmt.Errorf("entgql: federation key field %q of type %s is not exposed in the GraphQL schema", name, t.Name)
+		}
+		k.Fields = append(k.Fields, f)
+		k.Names = append(k.Names, mapping[0])
+		k.GoNames = append(k.GoNames, templates.ToGo(mapping[0]))
+	}
+	k.FieldSet = strings.Join(k.Names, " ")
+	name := fmt.Sprintf("%sBy%ss", gqlType, strings.Join(k.GoNames, "And"))
+	k.Input = name + "Input"
+	k.Resolver = "FindMany" + name
+	return k, nil
+}
+
+// WherePredicate holds information about a WhereInput predicate
+// of a field that was enabled by the WhereOps annotation.
+type WherePredicate struct {
+	// Name of the predicate, e.g. "HasKey".
+	Name string
+	// Func is the entgql function that implements the predicate.
+	Func string
+	// Type and GQLType are the Go and GraphQL types of the predicate input,
+	// or the types of its elements in case the input is a list.
+	Type    string
+	GQLType string
+	// List reports if the predicate accepts a list of values.
+	List bool
+	// Fallible reports if the predicate function returns an error.
+	Fallible bool
+}
+
+// JSONPathValueType is the GraphQL input type of the JSON predicates that match a value at a path.
+const JSONPathValueType = "JSONPathValue"
+
+// sliceElemTypes maps the supported slice fields to the Go and GraphQL types of their elements.
+var sliceElemTypes = map[string][2]string{
+	"[]string":  {"string", "String"},
+	"[]int":     {"int", "Int"},
+	"[]float64": {"float64", "Float"},
+}
+
+// fieldWherePredicates returns the WhereInput predicates enabled
+// by the WhereOps annotation of the given field.
+func fieldWherePredicates(f *gen.Field) ([]*WherePredicate, error) {
+	ant, err := annotation(f.Annotations)
+	if err != nil {
+		return nil, err
+	}
+	ops := ant.WhereOps
+	if ops == 0 || f.Sensitive() {
+		return nil, nil
+	}
+	var (
+		preds   []*WherePredicate
+		isJSON  = f.Type.Type == field.TypeJSON
+		elem    = sliceElemTypes[f.Type.String()]
+		isSlice = isJSON && elem[0] != ""
+	)
+	if ops.Is(WhereHasKey|WhereValueEQ) && !isJSON {
+		return nil, fmt.Errorf("entgql: HasKey and ValueEQ predicates of field %s require a JSON field", f.Name)
+	}
+	if ops.Is(WhereHasKey) {
+		preds = append(preds, &WherePredicate{Name: "HasKey", Func: "JSONHasKey", Type: "string", GQLType: "String", Fallible: true})
+	}
+	if ops.Is(WhereValueEQ) {
+		preds = append(preds, &WherePredicate{Name: "ValueEQ", Func: "JSONValueEQ", Type: JSONPathValueType, GQLType: JSONPathValueType, Fallible: true})
+	}
+	if ops.Is(WhereContains) {
+		switch {
+		case isSlice:
+			preds = append(preds, &WherePredicate{Name: "Contains", Func: "ValuesContain", Type: elem[0], GQLType: elem[1]})
+		case isJSON:
+			preds = append(preds, &WherePredicate{Name: "Contains", Func: "JSONContains", Type: JSONPathValueType, GQLType: JSONPathValueType, Fallible: true})
+		default:
+			return nil, fmt.Errorf("entgql: Contains predicate of field %s requires a JSON field", f.Name)
+		}
+	}
+	if ops.Is(WhereContainsAny) {
+		if !isSlice {
+			return nil, fmt.Errorf("entgql: ContainsAny predicate of field %s requires a []string, []int or []float64 field", f.Name)
+		}
+		preds = append(preds, &WherePredicate{Name: "ContainsAny", Func: "ValuesContainAny", Type: elem[0], GQLType: elem[1], List: true})
+	}
+	if ops.Is(WhereSearch) {
+		if f.Type.Type != field.TypeString {
+			return nil, fmt.Errorf("entgql: Search predicate of field %s requires a string field", f.Name)
+		}
+		preds = append(preds, &WherePredicate{Name: "Search", Func: "TextSearch", Type: "string", GQLType: "String"})
+	}
+	return preds, nil
+}
+
+// hasJSONPathValue reports if any of the WhereInput predicates
+// of the given nodes accepts the JSONPathValue input.
+func hasJSONPathValue(nodes []*gen.Type) (bool, error) {
+	for _, n := range nodes {
+		fields, err := filterFields(n.Fields, SkipWhereInput)
+		if err != nil {
+			return false, err
+		}
+		for _, f := range fields {
+			preds, err := fieldWherePredicates(f)
+			if err != nil {
+				return false, err
+			}
+			for _, p := range preds {
+				if p.Type == JSONPathValueType {
+					return true, nil
+				}
+			}
+		}
+	}
+	return false, nil
+}
+
+// MutationNames holds the names of the Mutation fields and payloads of a type.
+type MutationNames struct {
+	Node              string
+	NodeField         string
+	NodesField        string
+	Create            string
+	CreatePayload     string
+	CreateMany        string
+	CreateManyPayload string
+	Update            string
+	UpdatePayload     string
+	UpdateMany        string
+	UpdateManyPayload string
+	Delete            string
+	DeletePayload     string
+	DeleteMany        string
+	DeleteManyPayload string
+	Upsert            string
+	UpsertPayload     string
+}
+
+// PayloadDef returns the definition of a payload holding the mutated node.
+func (m *MutationNames) PayloadDef(name, field, verb string) *ast.Definition {
+	return &ast.Definition{
+		Name:        name,
+		Kind:        ast.Object,
+		Description: fmt.Sprintf("Return response for %s mutation.", field),
+		Fields: ast.FieldList{
+			{
+				Name:        m.NodeField,
+				Type:        ast.NonNullNamedType(m.Node, nil),
+				Description: fmt.Sprintf("%s %s.", verb, m.Node),
+			},
+		},
+	}
+}
+
+// DeletePayloadDef returns the definition of the payload returned by the delete mutation.
+func (m *MutationNames) DeletePayloadDef() *ast.Definition {
+	return &ast.Definition{
+		Name:        m.DeletePayload,
+		Kind:        ast.Object,
+		Description: fmt.Sprintf("Return response for %s mutation.", m.Delete),
+		Fields: ast.FieldList{
+			{
+				Name:        "deletedID",
+				Type:        ast.NonNullNamedType("ID", nil),
+				Description: fmt.Sprintf("ID of the deleted %s.", m.Node),
+			},
+		},
+	}
+}
+
+// ManyPayloadDef returns the definition of a payload holding the mutated nodes.
+func (m *MutationNames) ManyPayloadDef(name, field, verb string) *ast.Definition {
+	return &ast.Definition{
+		Name:        name,
+		Kind:        ast.Object,
+		Description: fmt.Sprintf("Return response for %s mutation.", field),
+		Fields: ast.FieldList{
+			{
+				Name:        m.NodesField,
+				Type:        ast.NonNullListType(ast.NonNullNamedType(m.Node, nil), nil),
+				Description: fmt.Sprintf("%s %s.", verb, plural(m.Node)),
+			},
+		},
+	}
+}
+
+// DeleteManyPayloadDef returns the definition of the payload returned by the bulk delete mutation.
+func (m *MutationNames) DeleteManyPayloadDef() *ast.Definition {
+	return &ast.Definition{
+		Name:        m.DeleteManyPayload,
+		Kind:        ast.Object,
+		Description: fmt.Sprintf("Return response for %s mutation.", m.DeleteMany),
+		Fields: ast.FieldList{
+			{
+				Name:        "deletedIDs",
+				Type:        ast.NonNullListType(ast.NonNullNamedType("ID", nil), nil),
+				Description: fmt.Sprintf("IDs of the deleted %s.", plural(m.Node)),
+			},
+		},
+	}
+}
+
+// nodeMutationNames returns the names of the Mutation fields and payloads for the node.
+func nodeMutationNames(t *gen.Type) (*MutationNames, error) {
+	node, _, err := gqlTypeFromNode(t)
+	if err != nil {
+		return nil, err
+	}
+	return mutationNames(node), nil
+}
+
+func mutationNames(node string) *MutationNames {
+	nodes := plural(node)
+	return &MutationNames{
+		Node:              node,
+		NodeField:         camel(snake(node)),
+		NodesField:        camel(snake(nodes)),
+		Create:            fmt.Sprintf("create%s", node),
+		CreatePayload:     fmt.Sprintf("Create%sPayload", node),
+		CreateMany:        fmt.Sprintf("createMany%s", node),
+		CreateManyPayload: fmt.Sprintf("CreateMany%sPayload", node),
+		Update:            fmt.Sprintf("update%s", node),
+		UpdatePayload:     fmt.Sprintf("Update%sPayload", node),
+		UpdateMany:        fmt.Sprintf("update%s", nodes),
+		UpdateManyPayload: fmt.Sprintf("Update%sPayload", nodes),
+		Delete:            fmt.Sprintf("delete%s", node),
+		DeletePayload:     fmt.Sprintf("Delete%sPayload", node),
+		DeleteMany:        fmt.Sprintf("delete%s", nodes),
+		DeleteManyPayload: fmt.Sprintf("Delete%sPayload", nodes),
+		Upsert:            fmt.Sprintf("upsert%s", node),
+		UpsertPayload:     fmt.Sprintf("Upsert%sPayload", node),
+	}
+}
+
+// SubscriptionNames holds the names of the Subscription fields of a type.
+// The names of the fields are used as the topics of their events.
+type SubscriptionNames struct {
+	Created string
+	Updated string
+	Deleted string
+}
+
+// nodeSubscriptionNames returns the names of the Subscription fields for the node.
+func nodeSubscriptionNames(t *gen.Type) (*SubscriptionNames, error) {
+	node, _, err := gqlTypeFromNode(t)
+	if err != nil {
+		return nil, err
+	}
+	return subscriptionNames(node), nil
+}
+
+func subscriptionNames(node string) *SubscriptionNames {
+	field := camel(snake(node))
+	return &SubscriptionNames{
+		Created: field + "Created",
+		Updated: field + "Updated",
+		Deleted: field + "Deleted",
+	}
+}
+
+// subscriptionNodes returns the nodes that have Subscription fields.
+func subscriptionNodes(nodes []*gen.Type) ([]*gen.Type, error) {
+	var subs []*gen.Type
+	for _, n := range nodes {
+		ant, err := annotation(n.Annotations)
+		if err != nil {
+			return nil, err
+		}
+		if ant.Subscriptions && !ant.Skip.Is(SkipType) {
+			subs = append(subs, n)
+		}
+	}
+	return subs, nil
+}
+
+// compositeIDNodes returns the types with a composite ID, like the edge schemas, that
+// are included in the GraphQL schema when the nodes are identified by global IDs.
+func compositeIDNodes(nodes []*gen.Type) ([]*gen.Type, error) {
+	var composite []*gen.Type
+	for _, n := range nodes {
+		if !n.HasCompositeID() {
+			continue
+		}
+		ant, err := annotation(n.Annotations)
+		if err != nil {
+			return nil, err
+		}
+		if !ant.Skip.Is(SkipType) {
+			composite = append(composite, n)
+		}
+	}
+	return composite, nil
+}
+
+// globalIDFields returns the ID fields of the GraphQL types, other than the id field
+// of the nodes, that hold the IDs of other nodes, mapped to the types of these nodes.
+// For example, the edge-fields of the nodes and the deletedID field of the payloads.
+func globalIDFields(nodes []*gen.Type) (map[string]map[string]string, error) {
+	fields := make(map[string]map[string]string)
+	add := func(typ, field, node string) {
+		if fields[typ] == nil {
+			fields[typ] = make(map[string]string)
+		}
+		fields[typ][field] = node
+	}
+	gqlNodes, err := filterNodes(nodes, SkipType)
+	if err != nil {
+		return nil, err
+	}
+	composite, err := compositeIDNodes(nodes)
+	if err != nil {
+		return nil, err
+	}
+	for _, n := range append(gqlNodes, composite...) {
+		gqlType, _, err := gqlTypeFromNode(n)
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range n.Fields {
+			if !f.IsEdgeField() {
+				continue
+			}
+			e, err := f.Edge()
+			if err != nil {
+				return nil, err
+			}
+			if e.Type.HasCompositeID() {
+				continue
+			}
+			ref, ant, err := gqlTypeFromNode(e.Type)
+			if err != nil {
+				return nil, err
+			}
+			if ant.Skip.Is(SkipType) {
+				continue
+			}
+			mapping, err := fieldMapping(f)
+			if err != nil {
+				return nil, err
+			}
+			for _, name := range mapping {
+				add(gqlType, name, ref)
+			}
+		}
+	}
+	muts, err := mutationFields(nodes)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range muts {
+		if !m.IsDelete {
+			continue
+		}
+		names, err := nodeMutationNames(m.Type)
+		if err != nil {
+			return nil, err
+		}
+		add(names.DeletePayload, "deletedID", names.Node)
+		if m.IsBulk {
+			add(names.DeleteManyPayload, "deletedIDs", names.Node)
+		}
+	}
+	subs, err := subscriptionNodes(nodes)
+	if err != nil {
+		return nil, err
+	}
+	for _, n := range subs {
+		gqlType, _, err := gqlTypeFromNode(n)
+		if err != nil {
+			return nil, err
+		}
+		add("Subscription", subscriptionNames(gqlType).Deleted, gqlType)
+	}
+	return fields, nil
+}
+
 // removeOldAssets removes files that were generated before v0.1.0.
 func removeOldAssets(next gen.Generator) gen.Generator {
 	const prefix = "gql_"
-	templates := []*gen.Template{WhereTemplate}
+	templates := []*gen.Template{WhereTemplate, MutationTemplate}
 	templates = append(templates, AllTemplates...)
 	return gen.GenerateFunc(func(g *gen.Graph) error {
 		for _, rootT := range templates {
@@ -769,6 +1812,9 @@ func skipMutationTemplate(g *gen.Graph) bool {
 			continue
 		}
 		for _, i := range ant.MutationInputs {
+			if i.IsDelete || i.IsUpsert {
+				continue
+			}
 			if (i.IsCreate && !ant.Skip.Is(SkipMutationCreateInput)) ||
 				(!i.IsCreate && !ant.Skip.Is(SkipMutationUpdateInput)) {
 				return false
@@ -778,6 +1824,31 @@ func skipMutationTemplate(g *gen.Graph) bool {
 	return true
 }
 
+func skipSubscriptionTemplate(g *gen.Graph) bool {
+	nodes, err := subscriptionNodes(g.Nodes)
+	return err != nil || len(nodes) == 0
+}
+
+func skipAggregateTemplate(g *gen.Graph) bool {
+	nodes, err := aggregateNodes(g.Nodes)
+	return err != nil || len(nodes) == 0
+}
+
+func skipComplexityTemplate(g *gen.Graph) bool {
+	types, err := complexityTypes(g.Nodes)
+	return err != nil || len(types) == 0
+}
+
+func skipFederationTemplate(g *gen.Graph) bool {
+	entities, err := federationEntities(g.Nodes)
+	return err != nil || len(entities) == 0
+}
+
+func skipMutationFieldsTemplate(g *gen.Graph) bool {
+	fields, err := mutationFields(g.Nodes)
+	return err != nil || len(fields) == 0
+}
+
 func nodeImplementors(n *gen.Type) (ifaces []string, err error) {
 	ant, err := annotation(n.Annotations)
 	if err != nil {
@@ -18,6 +18,7 @@ import (
 	"testing"
 
 	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/schema/field"
 	"github.com/stretchr/testify/require"
 )
 
@@ -218,3 +219,150 @@ func TestFilterFields(t *testing.T) {
 		},
 	}, fields)
 }
+
+func TestFieldWherePredicates(t *testing.T) {
+	newField := func(typ *field.TypeInfo, ops WhereOp) *gen.Field {
+		return &gen.Field{
+			Name: "f",
+			Type: typ,
+			Annotations: map[string]interface{}{
+				annotationName: map[string]interface{}{"WhereOps": ops},
+			},
+		}
+	}
+	var (
+		jsonMap = &field.TypeInfo{Type: field.TypeJSON, Ident: "map[string]interface {}"}
+		slice   = &field.TypeInfo{Type: field.TypeJSON, Ident: "[]string"}
+		text    = &field.TypeInfo{Type: field.TypeString}
+	)
+	preds, err := fieldWherePredicates(newField(jsonMap, WhereHasKey|WhereValueEQ|WhereContains))
+	require.NoError(t, err)
+	require.Equal(t, []*WherePredicate{
+		{Name: "HasKey", Func: "JSONHasKey", Type: "string", GQLType: "String", Fallible: true},
+		{Name: "ValueEQ", Func: "JSONValueEQ", Type: JSONPathValueType, GQLType: JSONPathValueType, Fallible: true},
+		{Name: "Contains", Func: "JSONContains", Type: JSONPathValueType, GQLType: JSONPathValueType, Fallible: true},
+	}, preds)
+
+	preds, err = fieldWherePredicates(newField(slice, WhereContains|WhereContainsAny))
+	require.NoError(t, err)
+	require.Equal(t, []*WherePredicate{
+		{Name: "Contains", Func: "ValuesContain", Type: "string", GQLType: "String"},
+		{Name: "ContainsAny", Func: "ValuesContainAny", Type: "string", GQLType: "String", List: true},
+	}, preds)
+
+	preds, err = fieldWherePredicates(newField(text, WhereSearch))
+	require.NoError(t, err)
+	require.Equal(t, []*WherePredicate{{Name: "Search", Func: "TextSearch", Type: "string", GQLType: "String"}}, preds)
+
+	_, err = fieldWherePredicates(newField(text, WhereHasKey))
+	require.EqualError(t, err, "entgql: HasKey and ValueEQ predicates of field f require a JSON field")
+	_, err = fieldWherePredicates(newField(jsonMap, WhereContainsAny))
+	require.EqualError(t, err, "entgql: ContainsAny predicate of field f requires a []string, []int or []float64 field")
+	_, err = fieldWherePredicates(newField(jsonMap, WhereSearch))
+	require.EqualError(t, err, "entgql: Search predicate of field f requires a string field")
+}
+
+func TestComplexityTypes(t *testing.T) {
+	user := &gen.Type{
+		Name: "User",
+		Annotations: map[string]interface{}{
+			annotationName: map[string]interface{}{"QueryField": map[string]interface{}{}, "RelayConnection": true, "MaxPageSize": 10},
+		},
+	}
+	group := &gen.Type{
+		Name: "Group",
+		Annotations: map[string]interface{}{
+			annotationName: map[string]interface{}{"QueryField": map[string]interface{}{}},
+		},
+	}
+	user.Edges = []*gen.Edge{
+		{Name: "groups", Type: group},
+		{Name: "friends", Type: user, Annotations: map[string]interface{}{
+			annotationName: map[string]interface{}{"RelayConnection": true, "Mapping": []string{"friends", "followers"}},
+		}},
+		{Name: "best_friend", Type: user, Unique: true},
+	}
+	group.Edges = []*gen.Edge{
+		{Name: "users", Type: user, Annotations: map[string]interface{}{
+			annotationName: map[string]interface{}{"RelayConnection": true},
+		}},
+	}
+	types, err := complexityTypes([]*gen.Type{user, group})
+	require.NoError(t, err)
+	require.Equal(t, []*ComplexityType{
+		{Name: "Query", Fields: []*ComplexityField{
+			{Name: "users", Connection: true, MaxPageSize: 10},
+			{Name: "groups"},
+		}},
+		{Name: "User", Fields: []*ComplexityField{
+			{Name: "groups"},
+			{Name: "friends", Connection: true, MaxPageSize: 10},
+			{Name: "followers", Connection: true, MaxPageSize: 10},
+		}},
+		{Name: "Group", Fields: []*ComplexityField{
+			{Name: "users", Connection: true, MaxPageSize: 10},
+		}},
+	}, types)
+
+	group.Annotations[annotationName].(map[string]interface{})["MaxPageSize"] = -1
+	_, err = complexityTypes([]*gen.Type{user, group})
+	require.EqualError(t, err, "entgql: MaxPageSize of Group must be positive")
+}
+
+func TestFederationEntities(t *testing.T) {
+	var (
+		id     = &gen.Field{Name: "id", Type: &field.TypeInfo{Type: field.TypeInt}}
+		name   = &gen.Field{Name: "name", Type: &field.TypeInfo{Type: field.TypeString}}
+		org    = &gen.Field{Name: "org_id", Type: &field.TypeInfo{Type: field.TypeInt}}
+		secret = &gen.Field{Name: "secret", Type: &field.TypeInfo{Type: field.TypeString}, Annotations: map[string]interface{}{
+			annotationName: map[string]interface{}{"Skip": SkipType},
+		}}
+		user = &gen.Type{
+			Name:   "User",
+			ID:     id,
+			Fields: []*gen.Field{name, org, secret},
+			Annotations: map[string]interface{}{
+				annotationName: map[string]interface{}{
+					"Type":           "Account",
+					"FederationKeys": [][]string{{"id"}, {"org_id", "name"}},
+				},
+			},
+		}
+		group = &gen.Type{Name: "Group", ID: id}
+	)
+	entities, err := federationEntities([]*gen.Type{user, group})
+	require.NoError(t, err)
+	require.Equal(t, []*FederationEntity{
+		{Type: user, Name: "Account", Keys: []*FederationEntityKey{
+			{
+				Fields:   []*gen.Field{id},
+				Names:    []string{"id"},
+				GoNames:  []string{"ID"},
+				FieldSet: "id",
+				Input:    "AccountByIDsInput",
+				Resolver: "FindManyAccountByIDs",
+			},
+			{
+				Fields:   []*gen.Field{org, name},
+				Names:    []string{"orgID", "name"},
+				GoNames:  []string{"OrgID", "Name"},
+				FieldSet: "orgID name",
+				Input:    "AccountByOrgIDAndNamesInput",
+				Resolver: "FindManyAccountByOrgIDAndNames",
+			},
+		}},
+	}, entities)
+	require.True(t, entities[0].Keys[0].IsID())
+	require.False(t, entities[0].Keys[1].IsID())
+
+	user.Annotations[annotationName].(map[string]interface{})["FederationKeys"] = [][]string{{"email"}}
+	_, err = federationEntities([]*gen.Type{user})
+	require.EqualError(t, err, `entgql: federation key field "email" was not found in type User`)
+	user.Annotations[annotationName].(map[string]interface{})["FederationKeys"] = [][]string{{"secret"}}
+	_, err = federationEntities([]*gen.Type{user})
+	require.EqualError(t, err, `entgql: federation key field "secret" of type User is not exposed in the GraphQL schema`)
+	user.Fields = append(user.Fields, &gen.Field{Name: "avatar", Type: &field.TypeInfo{Type: field.TypeBytes}})
+	user.Annotations[annotationName].(map[string]interface{})["FederationKeys"] = [][]string{{"avatar"}}
+	_, err = federationEntities([]*gen.Type{user})
+	require.EqualError(t, err, `entgql: field "avatar" of type User cannot be used as a federation key`)
+}
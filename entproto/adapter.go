@@ -28,6 +28,7 @@ import (
 	"github.com/jhump/protoreflect/desc/builder"
 	"google.golang.org/protobuf/types/descriptorpb"
 	_ "google.golang.org/protobuf/types/known/emptypb"
+	_ "google.golang.org/protobuf/types/known/fieldmaskpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	_ "google.golang.org/protobuf/types/known/wrapperspb" // needed to load wkt to global proto registry
 )
@@ -43,7 +44,10 @@ var (
 	wktsPaths          = map[string]string{
 		// TODO: handle more Well-Known proto types
 		"google.protobuf.Timestamp":   "google/protobuf/timestamp.proto",
+		"google.protobuf.Duration":    "google/protobuf/duration.proto",
+		"google.protobuf.Struct":      "google/protobuf/struct.proto",
 		"google.protobuf.Empty":       "google/protobuf/empty.proto",
+		"google.protobuf.FieldMask":   "google/protobuf/field_mask.proto",
 		"google.protobuf.Int32Value":  "google/protobuf/wrappers.proto",
 		"google.protobuf.Int64Value":  "google/protobuf/wrappers.proto",
 		"google.protobuf.UInt32Value": "google/protobuf/wrappers.proto",
@@ -149,13 +153,16 @@ func (a *Adapter) parse() error {
 			return err
 		}
 		if svcAnnotation.Generate {
-			svcResources, err := a.createServiceResources(genType, svcAnnotation.Methods)
+			svcResources, err := a.createServiceResources(genType, svcAnnotation)
 			if err != nil {
 				return err
 			}
 			fd.Service = append(fd.Service, svcResources.svc)
 			fd.MessageType = append(fd.MessageType, svcResources.svcMessages...)
 			fd.Dependency = append(fd.Dependency, "google/protobuf/empty.proto")
+			if svcAnnotation.ReadMask {
+				fd.Dependency = append(fd.Dependency, "google/protobuf/field_mask.proto")
+			}
 		}
 	}
 
@@ -17,31 +17,48 @@ package main
 import (
 	"flag"
 	"fmt"
+	"go/ast"
+	"go/token"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
 
+	"entgo.io/contrib/entproto"
 	entopts "entgo.io/contrib/entproto/cmd/protoc-gen-ent/options/ent"
 	"entgo.io/contrib/schemast"
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
 	"google.golang.org/protobuf/compiler/protogen"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/types/descriptorpb"
 )
 
-var schemaDir *string
+var (
+	schemaDir *string
+	merge     *bool
+)
 
 func main() {
 	var flags flag.FlagSet
 	schemaDir = flags.String("schemadir", "./ent/schema", "path to ent schema dir")
+	merge = flags.Bool("merge", false, "only update the fields and edges generated from proto, keeping hand-written code")
 	protogen.Options{
 		ParamFunc: flags.Set,
 	}.Run(func(gen *protogen.Plugin) error {
-		return printSchemas(*schemaDir, gen)
+		return printSchemas(*schemaDir, gen, *merge)
 	})
 }
 
-func printSchemas(schemaDir string, gen *protogen.Plugin) error {
+func printSchemas(schemaDir string, gen *protogen.Plugin, merge bool) error {
 	ctx, err := schemast.Load(schemaDir)
 	if err != nil {
 		return err
@@ -57,10 +74,15 @@ func printSchemas(schemaDir string, gen *protogen.Plugin) error {
 			if !ok || !opts.GetGen() {
 				continue
 			}
-			schema, err := toSchema(msg, opts)
+			schema, err := toSchema(gen, msg, opts)
 			if err != nil {
 				return err
 			}
+			if merge {
+				schema.Marker = entproto.Generated()
+				schema.MarkedHooks = []string{"runtime.OneOfHook"}
+				schema.MarkedAnnotations = []string{entproto.MessageAnnotation, entproto.ServiceAnnotation, "EntSQL"}
+			}
 			mutations = append(mutations, schema)
 		}
 	}
@@ -103,15 +125,28 @@ func edgeOpts(fld *protogen.Field) (*entopts.Edge, bool) {
 	return eop, ok
 }
 
-func toSchema(m *protogen.Message, opts *entopts.Schema) (*schemast.UpsertSchema, error) {
-	name := string(m.Desc.Name())
-	if opts.Name != nil {
-		name = opts.GetName()
-	}
+func toSchema(gen *protogen.Plugin, m *protogen.Message, opts *entopts.Schema) (*schemast.UpsertSchema, error) {
 	out := &schemast.UpsertSchema{
-		Name: name,
+		Name: schemaName(m),
+	}
+	if opts.Id != nil {
+		id, err := toIDField(opts.GetId())
+		if err != nil {
+			return nil, err
+		}
+		num := entproto.IDFieldNumber
+		for _, f := range m.Fields {
+			if f.Desc.Name() == "id" {
+				num = int(f.Desc.Number())
+			}
+		}
+		id.Descriptor().Annotations = append(id.Descriptor().Annotations, entproto.Field(num))
+		out.Fields = append(out.Fields, id)
 	}
 	for _, f := range m.Fields {
+		if opts.Id != nil && f.Desc.Name() == "id" {
+			continue
+		}
 		if isEdge(f) {
 			edg, err := toEdge(f)
 			if err != nil {
@@ -120,41 +155,319 @@ func toSchema(m *protogen.Message, opts *entopts.Schema) (*schemast.UpsertSchema
 			out.Edges = append(out.Edges, edg)
 			continue
 		}
-		fld, err := toField(f)
+		fld, err := toField(gen, f)
 		if err != nil {
 			return nil, err
 		}
 		out.Fields = append(out.Fields, fld)
 	}
+	for _, o := range m.Oneofs {
+		if hook, ok := oneofHook(o); ok {
+			out.Hooks = append(out.Hooks, hook)
+		}
+	}
+	for _, idx := range opts.GetIndexes() {
+		i := index.Fields(idx.GetFields()...).Edges(idx.GetEdges()...)
+		if idx.GetUnique() {
+			i.Unique()
+		}
+		if sk := idx.GetStorageKey(); sk != "" {
+			i.StorageKey(sk)
+		}
+		out.Indexes = append(out.Indexes, i)
+	}
+	msg, err := protoMessage(m, opts)
+	if err != nil {
+		return nil, err
+	}
+	if msg != nil {
+		out.Annotations = append(out.Annotations, msg)
+	}
+	if a := opts.GetAnnotations(); a != nil {
+		if a.GetEntprotoService() {
+			out.Annotations = append(out.Annotations, entproto.Service())
+		}
+		if t := a.GetTable(); t != "" {
+			out.Annotations = append(out.Annotations, entsql.Annotation{Table: t})
+		}
+	}
 	return out, nil
 }
 
+// protoMessage returns the entproto.Message annotation of the schema, preserving the package of the message.
+// entproto reserves the number 1 for the id field, so if another field uses it, the message can't be reproduced
+// and nil is returned, unless the annotation was explicitly requested.
+func protoMessage(m *protogen.Message, opts *entopts.Schema) (schema.Annotation, error) {
+	for _, f := range m.Fields {
+		if int(f.Desc.Number()) != entproto.IDFieldNumber || f.Desc.Name() == "id" {
+			continue
+		}
+		if opts.GetAnnotations().GetEntprotoMessage() {
+			return nil, fmt.Errorf("protoc-gen-ent: field %q of %q has number %d which is reserved for id by entproto",
+				f.Desc.Name(), m.Desc.Name(), entproto.IDFieldNumber)
+		}
+		return nil, nil
+	}
+	var mopts []entproto.MessageOption
+	if pkg := m.Desc.ParentFile().Package(); pkg != "" {
+		mopts = append(mopts, entproto.PackageName(string(pkg)))
+	}
+	return entproto.Message(mopts...), nil
+}
+
+// oneofHook returns a hook that enforces that at most one of the fields generated from the oneof is set.
+func oneofHook(o *protogen.Oneof) (schemast.Hook, bool) {
+	if o.Desc.IsSynthetic() {
+		return schemast.Hook{}, false
+	}
+	args := []ast.Expr{strLit(string(o.Desc.Name()))}
+	for _, f := range o.Fields {
+		if !isEdge(f) {
+			args = append(args, strLit(string(f.Desc.Name())))
+		}
+	}
+	if len(args) < 3 {
+		return schemast.Hook{}, false
+	}
+	return schemast.Hook{
+		Expr: &ast.CallExpr{
+			Fun: &ast.SelectorExpr{
+				X:   ast.NewIdent("runtime"),
+				Sel: ast.NewIdent("OneOfHook"),
+			},
+			Args: args,
+		},
+		Imports: []string{"entgo.io/contrib/entproto/runtime"},
+	}, true
+}
+
+func strLit(s string) *ast.BasicLit {
+	return &ast.BasicLit{Kind: token.STRING, Value: strconv.Quote(s)}
+}
+
+func toIDField(opts *entopts.Schema_ID) (ent.Field, error) {
+	var fld ent.Field
+	switch opts.GetType() {
+	case entopts.Schema_ID_INT:
+		fld = field.Int("id")
+	case entopts.Schema_ID_INT64:
+		fld = field.Int64("id")
+	case entopts.Schema_ID_UINT64:
+		fld = field.Uint64("id")
+	case entopts.Schema_ID_STRING:
+		fld = field.String("id")
+	case entopts.Schema_ID_UUID:
+		fld = field.UUID("id", uuid.UUID{}).Default(uuid.New)
+	default:
+		return nil, fmt.Errorf("protoc-gen-ent: unsupported id type %q", opts.GetType())
+	}
+	fld.Descriptor().StorageKey = opts.GetStorageKey()
+	return fld, nil
+}
+
+// isEdge reports whether the field defines an edge. Message fields are edges if they are annotated
+// with ent.edge or reference a message that generates an ent schema.
 func isEdge(f *protogen.Field) bool {
-	return f.Desc.Kind() == protoreflect.MessageKind
+	if f.Desc.Kind() != protoreflect.MessageKind || f.Desc.IsMap() || isWKT(f.Desc.Message()) {
+		return false
+	}
+	if opts, ok := edgeOpts(f); ok && opts != nil {
+		return true
+	}
+	opts, ok := schemaOpts(f.Message)
+	return ok && opts.GetGen()
+}
+
+// isWKT reports whether the message is one of the protobuf well-known types.
+func isWKT(m protoreflect.MessageDescriptor) bool {
+	return m.ParentFile().Package() == "google.protobuf"
 }
 
 func toEdge(f *protogen.Field) (ent.Edge, error) {
 	name := string(f.Desc.Name())
-	msgType := string(f.Desc.Message().Name())
 	opts, ok := edgeOpts(f)
-	if !ok {
+	if !ok || opts == nil {
 		return nil, fmt.Errorf("protoc-gen-ent: expected ent.edge option on field %q", name)
 	}
+	ref, err := edgeRef(f, opts)
+	if err != nil {
+		return nil, err
+	}
 	var e ent.Edge
-	switch {
-	// TODO(rotemtam): handle O2O/M2M same type
-	case opts.Ref != nil:
+	if ref != "" {
 		e = edge.From(name, placeholder.Type)
-	default:
+	} else {
 		e = edge.To(name, placeholder.Type)
 	}
-	e = withType(e, msgType)
+	e = withType(e, schemaName(f.Message))
 	applyEdgeOpts(e, opts)
+	e.Descriptor().RefName = ref
+	e.Descriptor().Annotations = append(e.Descriptor().Annotations, entproto.Field(int(f.Desc.Number())))
+	if !f.Desc.IsList() {
+		e.Descriptor().Unique = true
+	}
 	return e, nil
 }
 
-func toField(f *protogen.Field) (ent.Field, error) {
+// edgeRef returns the name of the edge that f is the inverse of, or an empty string if f is an
+// assoc edge. An explicit ref must name an edge of the referenced type pointing back to the type
+// of f. Otherwise, if f and an edge of the referenced type point to each other, one of them is
+// inferred to be the inverse. The singular side of an O2M relation becomes the inverse, and for
+// O2O and M2M relations, the edge that is defined last does.
+func edgeRef(f *protogen.Field, opts *entopts.Edge) (string, error) {
+	if opts.Ref != nil {
+		for _, back := range edgesTo(f.Message, f.Parent) {
+			if string(back.Desc.Name()) == opts.GetRef() && back != f {
+				return opts.GetRef(), nil
+			}
+		}
+		return "", fmt.Errorf("protoc-gen-ent: edge %q references unknown edge %q of %q", f.Desc.Name(), opts.GetRef(), f.Message.Desc.Name())
+	}
+	back := assocEdgesTo(f.Message, f.Parent, f)
+	if len(back) != 1 || len(assocEdgesTo(f.Parent, f.Message, back[0])) != 1 {
+		return "", nil
+	}
+	other := back[0]
+	switch {
+	case f.Desc.IsList() != other.Desc.IsList():
+		if f.Desc.IsList() {
+			return "", nil
+		}
+	case f.Parent.Desc.FullName() != other.Parent.Desc.FullName():
+		if f.Parent.Desc.FullName() < other.Parent.Desc.FullName() {
+			return "", nil
+		}
+	case f.Desc.Number() < other.Desc.Number():
+		return "", nil
+	}
+	return string(other.Desc.Name()), nil
+}
+
+// edgesTo returns the edges defined on the message from that point to the message to.
+func edgesTo(from, to *protogen.Message) []*protogen.Field {
+	var edges []*protogen.Field
+	for _, f := range from.Fields {
+		if isEdge(f) && f.Message.Desc.FullName() == to.Desc.FullName() {
+			edges = append(edges, f)
+		}
+	}
+	return edges
+}
+
+// assocEdgesTo returns the edges returned by edgesTo, except for inverse edges with an explicit
+// ref and the field exclude.
+func assocEdgesTo(from, to *protogen.Message, exclude *protogen.Field) []*protogen.Field {
+	var edges []*protogen.Field
+	for _, f := range edgesTo(from, to) {
+		if opts, ok := edgeOpts(f); f == exclude || ok && opts.GetRef() != "" {
+			continue
+		}
+		edges = append(edges, f)
+	}
+	return edges
+}
+
+// schemaName returns the name of the ent schema generated from the message.
+func schemaName(m *protogen.Message) string {
+	if opts, ok := schemaOpts(m); ok && opts.Name != nil {
+		return opts.GetName()
+	}
+	return string(m.Desc.Name())
+}
+
+func toField(gen *protogen.Plugin, f *protogen.Field) (ent.Field, error) {
 	name := string(f.Desc.Name())
+	var fld ent.Field
+	switch kind := f.Desc.Kind(); {
+	case f.Desc.IsMap():
+		key, err := goTypeIdent(gen, f.Message.Fields[0])
+		if err != nil {
+			return nil, err
+		}
+		val, err := goTypeIdent(gen, f.Message.Fields[1])
+		if err != nil {
+			return nil, err
+		}
+		fld = jsonField(name, "map["+key.ident+"]"+val.ident, val.pkgPath, reflect.Map)
+	case f.Desc.IsList() && kind == protoreflect.StringKind:
+		fld = field.Strings(name)
+	case f.Desc.IsList() && kind == protoreflect.DoubleKind:
+		fld = field.Floats(name)
+	case f.Desc.IsList():
+		elem, err := goTypeIdent(gen, f)
+		if err != nil {
+			return nil, err
+		}
+		fld = jsonField(name, "[]"+elem.ident, elem.pkgPath, reflect.Slice)
+	case kind == protoreflect.MessageKind && !isWKT(f.Desc.Message()):
+		typ, err := goTypeIdent(gen, f)
+		if err != nil {
+			return nil, err
+		}
+		fld = jsonField(name, strings.TrimPrefix(typ.ident, "*"), typ.pkgPath, reflect.Pointer)
+	default:
+		var err error
+		if fld, err = scalarField(name, f); err != nil {
+			return nil, err
+		}
+	}
+	// Members of a oneof are optional. Fields with explicit presence (proto3 optional) are also nillable.
+	if o := f.Desc.ContainingOneof(); o != nil {
+		fld.Descriptor().Optional = true
+		fld.Descriptor().Nillable = fld.Descriptor().Nillable || o.IsSynthetic()
+	}
+	if opts, ok := fieldOpts(f); ok && opts != nil {
+		var err error
+		if fld, err = applyFieldOpts(fld, opts); err != nil {
+			return nil, fmt.Errorf("protoc-gen-ent: field %q: %w", name, err)
+		}
+	}
+	fld.Descriptor().Annotations = append(fld.Descriptor().Annotations, protoFieldAnnotation(f, fld.Descriptor()))
+	return fld, nil
+}
+
+// protoFieldAnnotation returns the entproto.Field annotation of the field, preserving its number. The protobuf
+// type is set explicitly if it differs from the one entproto infers from the ent field. Fields entproto can't
+// represent, such as maps and embedded messages, are skipped.
+func protoFieldAnnotation(f *protogen.Field, d *field.Descriptor) schema.Annotation {
+	var (
+		num  = int(f.Desc.Number())
+		kind = f.Desc.Kind()
+		typ  = entproto.Type(descriptorpb.FieldDescriptorProto_Type(kind))
+	)
+	switch {
+	case f.Desc.IsMap():
+		return entproto.Skip()
+	case f.Desc.IsList():
+		// entproto infers repeated fields from the Go types of JSON fields, and can't override their types.
+		if kind == protoreflect.StringKind || kind == protoreflect.Uint32Kind || kind == protoreflect.Uint64Kind {
+			return entproto.Field(num)
+		}
+		return entproto.Skip()
+	case kind == protoreflect.MessageKind:
+		switch name := f.Desc.Message().FullName(); {
+		case name == "google.protobuf.Duration", name == "google.protobuf.Struct":
+			return entproto.Field(num, typ, entproto.TypeName(string(name)))
+		case isWKT(f.Desc.Message()):
+			return entproto.Field(num)
+		default:
+			return entproto.Skip()
+		}
+	case kind == protoreflect.EnumKind && d.Optional:
+		return entproto.Field(num, typ, entproto.TypeName(pascal(string(f.Desc.Name()))))
+	// Optional fields are mapped to wrapper types by entproto.
+	case d.Optional, kind == protoreflect.Sint32Kind, kind == protoreflect.Sint64Kind, kind == protoreflect.Sfixed32Kind,
+		kind == protoreflect.Sfixed64Kind, kind == protoreflect.Fixed32Kind, kind == protoreflect.Fixed64Kind, kind == protoreflect.FloatKind:
+		return entproto.Field(num, typ)
+	default:
+		return entproto.Field(num)
+	}
+}
+
+// pascal is the function entproto uses to name the enums of fields.
+var pascal = gen.Funcs["pascal"].(func(string) string)
+
+func scalarField(name string, f *protogen.Field) (ent.Field, error) {
 	var fld ent.Field
 	switch f.Desc.Kind() {
 	case protoreflect.StringKind:
@@ -190,23 +503,127 @@ func toField(f *protogen.Field) (ent.Field, error) {
 	case protoreflect.EnumKind:
 		pbEnum := f.Desc.Enum().Values()
 		values := make([]string, 0, pbEnum.Len())
+		numbers := make(map[string]int32, pbEnum.Len())
+		enum := field.Enum(name)
 		for i := 0; i < pbEnum.Len(); i++ {
 			values = append(values, string(pbEnum.Get(i).Name()))
+			numbers[string(pbEnum.Get(i).Name())] = int32(pbEnum.Get(i).Number())
+			// The zero value is the default of protobuf enums, and entproto expects it to be the default of the field.
+			if pbEnum.Get(i).Number() == 0 {
+				enum.Default(string(pbEnum.Get(i).Name()))
+			}
+		}
+		fld = enum.Values(values...).Annotations(entproto.Enum(numbers, entproto.OmitFieldPrefix()))
+	case protoreflect.MessageKind:
+		var err error
+		if fld, err = wktField(name, f.Desc.Message()); err != nil {
+			return nil, err
 		}
-		fld = field.Enum(name).Values(values...)
 	default:
 		return nil, fmt.Errorf("protoc-gen-ent: unsupported kind %q", f.Desc.Kind())
 	}
-	if opts, ok := fieldOpts(f); ok {
-		applyFieldOpts(fld, opts)
+	return fld, nil
+}
+
+// goType is the Go type of a protobuf field, ignoring its cardinality.
+type goType struct {
+	ident   string
+	pkgPath string
+}
+
+// goTypeIdent returns the Go type generated by protoc-gen-go for a single value of the field. Enums are
+// stored by their names, similar to singular enum fields.
+func goTypeIdent(gen *protogen.Plugin, f *protogen.Field) (goType, error) {
+	switch f.Desc.Kind() {
+	case protoreflect.StringKind, protoreflect.EnumKind:
+		return goType{ident: "string"}, nil
+	case protoreflect.BoolKind:
+		return goType{ident: "bool"}, nil
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return goType{ident: "int32"}, nil
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return goType{ident: "uint32"}, nil
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return goType{ident: "int64"}, nil
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return goType{ident: "uint64"}, nil
+	case protoreflect.FloatKind:
+		return goType{ident: "float32"}, nil
+	case protoreflect.DoubleKind:
+		return goType{ident: "float64"}, nil
+	case protoreflect.BytesKind:
+		return goType{ident: "[]byte"}, nil
+	case protoreflect.MessageKind:
+		file, ok := gen.FilesByPath[f.Message.Location.SourceFile]
+		if !ok {
+			return goType{}, fmt.Errorf("protoc-gen-ent: unknown file %q for message %q", f.Message.Location.SourceFile, f.Message.Desc.FullName())
+		}
+		return goType{
+			ident:   "*" + string(file.GoPackageName) + "." + f.Message.GoIdent.GoName,
+			pkgPath: string(f.Message.GoIdent.GoImportPath),
+		}, nil
+	default:
+		return goType{}, fmt.Errorf("protoc-gen-ent: unsupported kind %q", f.Desc.Kind())
+	}
+}
+
+// jsonField returns a JSON field of the given Go type. The field package derives the type information
+// from a Go value, which is not available for the types generated by protoc-gen-go, so it is set here.
+func jsonField(name, ident, pkgPath string, kind reflect.Kind) ent.Field {
+	fld := field.JSON(name, struct{}{})
+	info := fld.Descriptor().Info
+	info.Ident = ident
+	info.PkgPath = pkgPath
+	info.Nillable = true
+	info.RType = &field.RType{
+		Ident:   ident,
+		Kind:    kind,
+		PkgPath: pkgPath,
+	}
+	return fld
+}
+
+// wktField maps a field of a well-known message type to its ent equivalent. Wrapper types
+// are mapped to optional and nillable scalar fields.
+func wktField(name string, m protoreflect.MessageDescriptor) (ent.Field, error) {
+	var fld ent.Field
+	switch m.FullName() {
+	case "google.protobuf.Timestamp":
+		fld = field.Time(name)
+	case "google.protobuf.Duration":
+		fld = field.Int64(name).GoType(time.Duration(0))
+	case "google.protobuf.Struct":
+		fld = field.JSON(name, map[string]any{})
+	case "google.protobuf.StringValue":
+		fld = field.String(name).Optional().Nillable()
+	case "google.protobuf.BoolValue":
+		fld = field.Bool(name).Optional().Nillable()
+	case "google.protobuf.Int32Value":
+		fld = field.Int32(name).Optional().Nillable()
+	case "google.protobuf.Int64Value":
+		fld = field.Int64(name).Optional().Nillable()
+	case "google.protobuf.UInt32Value":
+		fld = field.Uint32(name).Optional().Nillable()
+	case "google.protobuf.UInt64Value":
+		fld = field.Uint64(name).Optional().Nillable()
+	case "google.protobuf.FloatValue":
+		fld = field.Float32(name).Optional().Nillable()
+	case "google.protobuf.DoubleValue":
+		fld = field.Float(name).Optional().Nillable()
+	case "google.protobuf.BytesValue":
+		fld = field.Bytes(name).Optional().Nillable()
+	default:
+		return nil, fmt.Errorf("protoc-gen-ent: unsupported message type %q on field %q", m.FullName(), name)
 	}
 	return fld, nil
 }
 
-func applyFieldOpts(fld ent.Field, opts *entopts.Field) {
+// applyFieldOpts applies the options to the field. Validators are
+// attached to the returned field using schemast.WithValidators.
+func applyFieldOpts(fld ent.Field, opts *entopts.Field) (ent.Field, error) {
 	d := fld.Descriptor()
-	d.Nillable = opts.GetNillable()
-	d.Optional = opts.GetOptional()
+	d.Nillable = d.Nillable || opts.GetNillable()
+	d.Optional = d.Optional || opts.GetOptional()
 	d.Unique = opts.GetUnique()
 	d.Sensitive = opts.GetSensitive()
 	d.Immutable = opts.GetImmutable()
@@ -214,12 +631,102 @@ func applyFieldOpts(fld ent.Field, opts *entopts.Field) {
 	d.Tag = opts.GetStructTag()
 	d.StorageKey = opts.GetStorageKey()
 	d.SchemaType = opts.GetSchemaType()
+	if opts.Default != nil {
+		v, err := defaultValue(d.Info.Type, opts.GetDefault())
+		if err != nil {
+			return nil, err
+		}
+		d.Default = v
+	}
+	if opts.Validators != nil {
+		vs, err := validators(d.Info.Type, opts.GetValidators())
+		if err != nil {
+			return nil, err
+		}
+		fld = schemast.WithValidators(fld, vs...)
+	}
+	return fld, nil
+}
+
+// defaultValue parses the default value of a field from its string representation.
+func defaultValue(t field.Type, v string) (any, error) {
+	switch {
+	case t == field.TypeString, t == field.TypeEnum:
+		return v, nil
+	case t == field.TypeBool:
+		return strconv.ParseBool(v)
+	case t.Float():
+		return strconv.ParseFloat(v, 64)
+	case t.Integer() && strings.HasPrefix(t.String(), "u"):
+		return strconv.ParseUint(v, 10, 64)
+	case t.Integer():
+		return strconv.ParseInt(v, 10, 64)
+	case t == field.TypeTime && v == "now":
+		return time.Now, nil
+	default:
+		return nil, fmt.Errorf("unsupported default value %q for type %s", v, t)
+	}
+}
+
+func validators(t field.Type, opts *entopts.Field_Validators) ([]schemast.Validator, error) {
+	var (
+		vs          []schemast.Validator
+		unsupported []string
+		isString    = t == field.TypeString || t == field.TypeBytes
+	)
+	if opts.MinLen != nil {
+		vs = append(vs, schemast.MinLen(int(opts.GetMinLen())))
+		if !isString {
+			unsupported = append(unsupported, "min_len")
+		}
+	}
+	if opts.MaxLen != nil {
+		vs = append(vs, schemast.MaxLen(int(opts.GetMaxLen())))
+		if !isString {
+			unsupported = append(unsupported, "max_len")
+		}
+	}
+	if opts.NotEmpty != nil && opts.GetNotEmpty() {
+		vs = append(vs, schemast.Validator{Method: "NotEmpty"})
+		if !isString {
+			unsupported = append(unsupported, "not_empty")
+		}
+	}
+	if opts.Match != nil {
+		re, err := regexp.Compile(opts.GetMatch())
+		if err != nil {
+			return nil, err
+		}
+		vs = append(vs, schemast.Match(re))
+		if t != field.TypeString {
+			unsupported = append(unsupported, "match")
+		}
+	}
+	if r := opts.GetRange(); r != nil {
+		if t.Integer() {
+			vs = append(vs, schemast.Range(int64(r.GetMin()), int64(r.GetMax())))
+		} else {
+			vs = append(vs, schemast.Range(r.GetMin(), r.GetMax()))
+		}
+		if !t.Numeric() {
+			unsupported = append(unsupported, "range")
+		}
+	}
+	if opts.GetPositive() {
+		vs = append(vs, schemast.Validator{Method: "Positive"})
+		if !t.Numeric() {
+			unsupported = append(unsupported, "positive")
+		}
+	}
+	if len(unsupported) > 0 {
+		return nil, fmt.Errorf("validators %s are not supported for type %s", strings.Join(unsupported, ", "), t)
+	}
+	return vs, nil
 }
 
 func applyEdgeOpts(edg ent.Edge, opts *entopts.Edge) {
 	d := edg.Descriptor()
 	d.Unique = opts.GetUnique()
-	d.RefName = opts.GetRef()
 	d.Required = opts.GetRequired()
 	d.Field = opts.GetField()
 	d.Tag = opts.GetStructTag()
@@ -21,6 +21,10 @@ import (
 	"strings"
 	"testing"
 
+	"entgo.io/contrib/entproto"
+	"entgo.io/ent/entc"
+	"entgo.io/ent/entc/gen"
+	"github.com/jhump/protoreflect/desc"
 	"github.com/jhump/protoreflect/desc/protoparse"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/protobuf/compiler/protogen"
@@ -63,10 +67,10 @@ func TestEdges_O2M(t *testing.T) {
 	require.NoError(t, err)
 	catContents, err := tt.fileContents("cat.go")
 	require.NoError(t, err)
-	require.Contains(t, catContents, `edge.To("owner", Human.Type)`)
+	require.Contains(t, catContents, `edge.To("owner", Human.Type).Unique()`)
 	humanContents, err := tt.fileContents("human.go")
 	require.NoError(t, err)
-	require.Contains(t, humanContents, `edge.From("cats", Cat.Type)`)
+	require.Contains(t, humanContents, `edge.From("cats", Cat.Type).Ref("owner")`)
 }
 
 func TestEdges_M2M(t *testing.T) {
@@ -80,6 +84,30 @@ func TestEdges_M2M(t *testing.T) {
 	require.Contains(t, categoryContents, `edge.From("articles", Article.Type)`)
 }
 
+func TestEdges_SameType(t *testing.T) {
+	tt, err := newGenTest(t, "testdata/edges_same_type.proto")
+	require.NoError(t, err)
+	person, err := tt.fileContents("person.go")
+	require.NoError(t, err)
+	require.Contains(t, person, `edge.To("friends", Person.Type)`)
+	require.Contains(t, person, `edge.To("spouse", Person.Type).Unique()`)
+	require.Contains(t, person, `edge.To("following", Person.Type)`)
+	require.Contains(t, person, `edge.From("followers", Person.Type).Ref("following")`)
+	require.Contains(t, person, `edge.From("team", Squad.Type).Ref("members").Unique()`)
+	squad, err := tt.fileContents("squad.go")
+	require.NoError(t, err)
+	require.Contains(t, squad, `edge.To("members", Person.Type)`)
+	node, err := tt.fileContents("node.go")
+	require.NoError(t, err)
+	require.Contains(t, node, `edge.From("parent", Node.Type).Ref("children").Unique()`)
+	require.Contains(t, node, `edge.To("children", Node.Type)`)
+}
+
+func TestEdges_UnknownRef(t *testing.T) {
+	_, err := newGenTest(t, "testdata/edge_bad_ref.proto")
+	require.EqualError(t, err, `protoc-gen-ent: edge "cars" references unknown edge "drivers" of "Car"`)
+}
+
 func TestEdges_NotAnnotated(t *testing.T) {
 	_, err := newGenTest(t, "testdata/edge_not_annotated.proto")
 	require.EqualError(t, err, `protoc-gen-ent: expected ent.edge option on field "wheel"`)
@@ -90,28 +118,291 @@ func TestEnum(t *testing.T) {
 	require.NoError(t, err)
 	contents, err := tt.fileContents("job.go")
 	require.NoError(t, err)
-	require.Contains(t, contents, `field.Enum("priority").Values("PRIORITY_UNSPECIFIED", "LOW", "HIGH")`)
+	require.Contains(t, contents, `field.Enum("priority").Values("PRIORITY_UNSPECIFIED", "LOW", "HIGH").Default("PRIORITY_UNSPECIFIED").Annotations(entproto.Enum(map[string]int32{"PRIORITY_UNSPECIFIED": 0, "LOW": 1, "HIGH": 2}, entproto.OmitFieldPrefix()), entproto.Field(3))`)
 	require.Contains(t, contents, `field.Enum("status").Values("STATUS_UNSPECIFIED", "PENDING", "ACTIVE", "COMPLETE", "FAILED")`)
 }
 
+func TestOneof(t *testing.T) {
+	tt, err := newGenTest(t, "testdata/oneof.proto")
+	require.NoError(t, err)
+	contents, err := tt.fileContents("contact.go")
+	require.NoError(t, err)
+	require.Contains(t, contents, `field.String("name")`)
+	require.Contains(t, contents, `field.String("nickname").Nillable().Optional()`)
+	require.Contains(t, contents, `field.String("email").Optional()`)
+	require.Contains(t, contents, `field.String("phone").Optional()`)
+	require.Contains(t, contents, `runtime.OneOfHook("channel", "email", "phone")`)
+	require.NotContains(t, contents, `runtime.OneOfHook("_nickname"`)
+	require.Contains(t, contents, `"entgo.io/contrib/entproto/runtime"`)
+}
+
+func TestWellKnownTypes(t *testing.T) {
+	tt, err := newGenTest(t, "testdata/wkt.proto")
+	require.NoError(t, err)
+	contents, err := tt.fileContents("event.go")
+	require.NoError(t, err)
+	require.Contains(t, contents, `field.Time("created_at")`)
+	require.Contains(t, contents, `field.Int64("timeout").GoType(time.Duration(0))`)
+	require.Contains(t, contents, `field.String("note").Nillable().Optional()`)
+	require.Contains(t, contents, `field.Int64("retries").Nillable().Optional().Comment("retry count")`)
+	require.Contains(t, contents, `field.JSON("metadata", map[string]interface{}{})`)
+	require.Contains(t, contents, `"time"`)
+	require.NotContains(t, contents, "edge.")
+}
+
+func TestJSONFields(t *testing.T) {
+	tt, err := newGenTest(t, "testdata/json.proto")
+	require.NoError(t, err)
+	contents, err := tt.fileContents("recipe.go")
+	require.NoError(t, err)
+	require.Contains(t, contents, `field.Strings("tags")`)
+	require.Contains(t, contents, `field.JSON("ratings", []int64{})`)
+	require.Contains(t, contents, `field.JSON("scores", []int32{})`)
+	require.Contains(t, contents, `field.Floats("weights")`)
+	require.Contains(t, contents, `field.JSON("flags", []bool{})`)
+	require.Contains(t, contents, `field.JSON("counts", map[string]int32{})`)
+	require.Contains(t, contents, `field.JSON("ingredients", map[string]*recipepb.Ingredient{})`)
+	require.Contains(t, contents, `field.JSON("main", &recipepb.Ingredient{}).Optional()`)
+	require.Contains(t, contents, `field.JSON("extras", []*recipepb.Ingredient{})`)
+	require.Contains(t, contents, `recipepb "entgo.io/contrib/entproto/cmd/protoc-gen-ent/testdata/jsonpb"`)
+	require.NotContains(t, contents, "edge.")
+	_, err = tt.fileContents("ingredient.go")
+	require.Error(t, err)
+}
+
+func TestSchemaOptions(t *testing.T) {
+	tt, err := newGenTest(t, "testdata/schema_opts.proto")
+	require.NoError(t, err)
+	contents, err := tt.fileContents("account.go")
+	require.NoError(t, err)
+	require.Contains(t, contents, `field.UUID("id", uuid.UUID{}).Default(uuid.New)`)
+	require.NotContains(t, contents, `field.String("id")`)
+	require.Contains(t, contents, `field.String("email").MaxLen(255).Match(regexp.MustCompile("^\\S+@\\S+$"))`)
+	require.Contains(t, contents, `field.String("name").Default("anonymous").NotEmpty()`)
+	require.Contains(t, contents, `field.Int32("age").Default(18).Range(0, 150)`)
+	require.Contains(t, contents, `field.Bool("active").Default(true)`)
+	require.Contains(t, contents, `field.Time("created_at").Immutable().Default(time.Now)`)
+	require.Contains(t, contents, `index.Fields("email").Unique()`)
+	require.Contains(t, contents, `index.Fields("name", "age").StorageKey("name_age")`)
+	require.Contains(t, contents, `entproto.Message(entproto.PackageName("testdata"))`)
+	require.Contains(t, contents, `entsql.Annotation{Table: "accounts"}`)
+	require.Contains(t, contents, `"entgo.io/ent/schema/index"`)
+}
+
+func TestSchemaOptions_InvalidValidator(t *testing.T) {
+	_, err := newGenTest(t, "testdata/invalid_validator.proto")
+	require.EqualError(t, err, `protoc-gen-ent: field "count": validators max_len are not supported for type int64`)
+}
+
+func TestMerge(t *testing.T) {
+	tt, err := newMergeTest(t, map[string]string{
+		"contact.go": `package schema
+
+import (
+	"entgo.io/contrib/entproto"
+	"entgo.io/contrib/entproto/runtime"
+	"entgo.io/ent"
+	"entgo.io/ent/hook"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+type Contact struct {
+	ent.Schema
+}
+
+func (Contact) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("name").Annotations(entproto.Generated()),
+		field.String("fax").Annotations(entproto.Generated()),
+		field.String("phone").Optional().Sensitive(),
+		field.Time("created_at"),
+	}
+}
+
+func (Contact) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("fax").Annotations(entproto.Generated()),
+		index.Fields("name"),
+	}
+}
+
+func (Contact) Hooks() []ent.Hook {
+	return []ent.Hook{
+		runtime.OneOfHook("channel", "email", "fax"),
+		hook.On(nil, ent.OpCreate),
+	}
+}
+
+func (Contact) Policy() ent.Policy {
+	return nil
+}
+`,
+	}, "testdata/oneof.proto")
+	require.NoError(t, err)
+	contents, err := tt.fileContents("contact.go")
+	require.NoError(t, err)
+	require.Contains(t, contents, `field.String("name").Annotations(entproto.Field(1), entproto.Generated())`)
+	require.Contains(t, contents, `field.String("email").Optional().Annotations(entproto.Field(3, entproto.Type(descriptorpb.FieldDescriptorProto_TYPE_STRING)), entproto.Generated())`)
+	require.Contains(t, contents, `field.String("phone").Optional().Sensitive()`)
+	require.Contains(t, contents, `field.Time("created_at")`)
+	require.Contains(t, contents, `func (Contact) Policy() ent.Policy`)
+	require.Contains(t, contents, `runtime.OneOfHook("channel", "email", "phone")`)
+	require.Contains(t, contents, `hook.On(nil, ent.OpCreate)`)
+	require.Contains(t, contents, `index.Fields("name")`)
+	require.NotContains(t, contents, `"fax"`)
+	require.NotContains(t, contents, `field.Int("name")`)
+	require.Equal(t, 1, strings.Count(contents, `field.String("phone")`))
+}
+
+func TestMerge_Annotations(t *testing.T) {
+	account := func(annots ...string) map[string]string {
+		return map[string]string{
+			"account.go": `package schema
+
+import (
+	"entgo.io/contrib/entproto"
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema"
+)
+
+type Account struct {
+	ent.Schema
+}
+
+func (Account) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		` + strings.Join(annots, ",\n\t\t") + `,
+	}
+}
+`,
+		}
+	}
+
+	t.Run("Changed", func(t *testing.T) {
+		tt, err := newMergeTest(t, account(
+			`entproto.Message(entproto.PackageName("legacy"))`,
+			`entsql.Annotation{Table: "legacy_accounts"}`,
+		), "testdata/schema_opts.proto")
+		require.NoError(t, err)
+		contents, err := tt.fileContents("account.go")
+		require.NoError(t, err)
+		// The package and the table were changed in the .proto file.
+		require.Contains(t, contents, `entproto.Message(entproto.PackageName("testdata"))`)
+		require.Contains(t, contents, `entsql.Annotation{Table: "accounts"}`)
+		require.NotContains(t, contents, "legacy")
+		require.Equal(t, 1, strings.Count(contents, "entproto.Message("))
+		require.Equal(t, 1, strings.Count(contents, "entsql.Annotation{"))
+	})
+
+	t.Run("RemovedService", func(t *testing.T) {
+		tt, err := newMergeTest(t, account(
+			`entproto.Service()`,
+			`schema.Comment("hand-written")`,
+		), "testdata/schema_opts.proto")
+		require.NoError(t, err)
+		contents, err := tt.fileContents("account.go")
+		require.NoError(t, err)
+		// The service option is not set in the .proto file, and hand-written annotations are kept.
+		require.NotContains(t, contents, "entproto.Service()")
+		require.Contains(t, contents, `schema.Comment("hand-written")`)
+		require.Contains(t, contents, `entsql.Annotation{Table: "accounts"}`)
+	})
+}
+
+func TestRoundTrip(t *testing.T) {
+	tt, err := newGenTest(t, "testdata/roundtrip.proto")
+	require.NoError(t, err)
+	author, err := tt.fileContents("author.go")
+	require.NoError(t, err)
+	require.Contains(t, author, `entproto.Message(entproto.PackageName("roundtrip"))`)
+	require.Contains(t, author, `field.Int32("rank").Annotations(entproto.Field(3, entproto.Type(descriptorpb.FieldDescriptorProto_TYPE_SINT32)))`)
+	require.Contains(t, author, `field.JSON("labels", map[string]string{}).Annotations(entproto.Skip())`)
+	require.Contains(t, author, `edge.To("books", Book.Type).Annotations(entproto.Field(12))`)
+	// Draft uses the number reserved for the id by entproto.
+	draft, err := tt.fileContents("draft.go")
+	require.NoError(t, err)
+	require.NotContains(t, draft, "entproto.Message(")
+
+	var graph *gen.Graph
+	err = inDir(tt.mod, func() (err error) {
+		graph, err = entc.LoadGraph(tt.dir, &gen.Config{})
+		return err
+	})
+	require.NoError(t, err)
+	adapter, err := entproto.LoadAdapter(graph)
+	require.NoError(t, err)
+	var parser protoparse.Parser
+	files, err := parser.ParseFiles("testdata/roundtrip.proto")
+	require.NoError(t, err)
+	for _, name := range []string{"Author", "Book"} {
+		want := files[0].FindMessage("roundtrip." + name)
+		got, err := adapter.GetMessageDescriptor(name)
+		require.NoError(t, err)
+		for _, wf := range want.GetFields() {
+			gf := got.FindFieldByNumber(wf.GetNumber())
+			if wf.IsMap() {
+				require.Nil(t, gf, "map fields are skipped")
+				continue
+			}
+			require.NotNil(t, gf, "field %q", wf.GetName())
+			require.Equal(t, wf.GetName(), gf.GetName())
+			require.Equal(t, wf.GetType(), gf.GetType(), "field %q", wf.GetName())
+			require.Equal(t, wf.IsRepeated(), gf.IsRepeated(), "field %q", wf.GetName())
+			if mt := wf.GetMessageType(); mt != nil {
+				require.Equal(t, mt.GetName(), gf.GetMessageType().GetName(), "field %q", wf.GetName())
+			}
+			if et := wf.GetEnumType(); et != nil {
+				for _, v := range et.GetValues() {
+					gv := gf.GetEnumType().FindValueByNumber(v.GetNumber())
+					require.NotNil(t, gv, "value %q", v.GetName())
+					require.Equal(t, v.GetName(), gv.GetName())
+				}
+			}
+		}
+	}
+}
+
 type genTest struct {
+	dir    string
+	mod    string
 	output map[string]string
 }
 
 func newGenTest(t *testing.T, files ...string) (*genTest, error) {
-	tmp, err := os.MkdirTemp("", "protoc-gen-ent-")
-	require.NoError(t, err)
-	t.Cleanup(func() {
-		os.RemoveAll(tmp)
-	})
+	return newMergeTest(t, nil, files...)
+}
+
+// newMergeTest runs the plugin in merge mode on a schema directory containing the existing files. If existing
+// is nil, the plugin runs in the default mode.
+func newMergeTest(t *testing.T, existing map[string]string, files ...string) (*genTest, error) {
+	mod := newModule(t)
+	tmp := filepath.Join(mod, "schema")
+	require.NoError(t, os.Mkdir(tmp, 0700))
+	for name, contents := range existing {
+		require.NoError(t, os.WriteFile(filepath.Join(tmp, name), []byte(contents), 0600))
+	}
 	var parser protoparse.Parser
 	var descs []*descriptorpb.FileDescriptorProto
 	tgts := []string{"google/protobuf/descriptor.proto", "options/ent/opts.proto"}
 	tgts = append(tgts, files...)
 	parsed, err := parser.ParseFiles(tgts...)
 	require.NoError(t, err)
+	seen := make(map[string]bool)
+	var add func(*desc.FileDescriptor)
+	add = func(fd *desc.FileDescriptor) {
+		if seen[fd.GetName()] {
+			return
+		}
+		seen[fd.GetName()] = true
+		for _, dep := range fd.GetDependencies() {
+			add(dep)
+		}
+		descs = append(descs, fd.AsFileDescriptorProto())
+	}
 	for _, p := range parsed {
-		descs = append(descs, p.AsFileDescriptorProto())
+		add(p)
 	}
 	gen, err := protogen.Options{}.New(&pluginpb.CodeGeneratorRequest{
 		FileToGenerate:  files,
@@ -122,7 +413,9 @@ func newGenTest(t *testing.T, files ...string) (*genTest, error) {
 	if err != nil {
 		return nil, err
 	}
-	err = printSchemas(tmp, gen)
+	err = inDir(mod, func() error {
+		return printSchemas(tmp, gen, existing != nil)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -144,7 +437,38 @@ func newGenTest(t *testing.T, files ...string) (*genTest, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &genTest{output: output}, nil
+	return &genTest{dir: tmp, mod: mod, output: output}, nil
+}
+
+// newModule creates a module in a temporary directory that requires the dependencies of this repository and
+// replaces entgo.io/contrib with it. Schemas are loaded as packages by the go command, so they are generated
+// inside of it.
+func newModule(t *testing.T) string {
+	root, err := filepath.Abs("../../..")
+	require.NoError(t, err)
+	gomod, err := os.ReadFile(filepath.Join(root, "go.mod"))
+	require.NoError(t, err)
+	gosum, err := os.ReadFile(filepath.Join(root, "go.sum"))
+	require.NoError(t, err)
+	mod := strings.Replace(string(gomod), "module entgo.io/contrib", "module protoc-gen-ent.test", 1)
+	mod += fmt.Sprintf("\nrequire entgo.io/contrib v0.0.0\n\nreplace entgo.io/contrib => %s\n", root)
+	tmp := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(tmp, "go.mod"), []byte(mod), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(tmp, "go.sum"), gosum, 0600))
+	return tmp
+}
+
+// inDir runs fn with dir as the working directory, from which the go command resolves the module.
+func inDir(dir string, fn func() error) error {
+	wd, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	if err := os.Chdir(dir); err != nil {
+		return err
+	}
+	defer os.Chdir(wd)
+	return fn()
 }
 
 func (g *genTest) fileContents(name string) (string, error) {
@@ -26,19 +26,28 @@ import (
 	"entgo.io/contrib/entproto"
 	"entgo.io/ent/entc"
 	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/schema/field"
 	"google.golang.org/protobuf/compiler/protogen"
 )
 
 var (
-	entSchemaPath *string
-	snake         = gen.Funcs["snake"].(func(string) string)
-	status        = protogen.GoImportPath("google.golang.org/grpc/status")
-	codes         = protogen.GoImportPath("google.golang.org/grpc/codes")
+	entSchemaPath   *string
+	connectHandlers *bool
+	connectSuffix   *string
+	testServers     *bool
+	instrument      *bool
+	snake           = gen.Funcs["snake"].(func(string) string)
+	status          = protogen.GoImportPath("google.golang.org/grpc/status")
+	codes           = protogen.GoImportPath("google.golang.org/grpc/codes")
 )
 
 func main() {
 	var flags flag.FlagSet
 	entSchemaPath = flags.String("schema_path", "", "ent schema path")
+	connectHandlers = flags.Bool("connect", false, "generate connect-go handlers for the services")
+	connectSuffix = flags.String("connect_package_suffix", "connect", "package suffix used by protoc-gen-connect-go")
+	testServers = flags.Bool("test_server", false, "generate in-memory test servers for the services")
+	instrument = flags.Bool("instrument", false, "instrument the services with OpenTelemetry traces and metrics")
 	protogen.Options{
 		ParamFunc: flags.Set,
 	}.Run(func(plg *protogen.Plugin) error {
@@ -78,6 +87,20 @@ func processFile(gen *protogen.Plugin, file *protogen.File, graph *gen.Graph) er
 		if err := sg.generate(); err != nil {
 			return err
 		}
+		if *testServers {
+			if err := newTestServerGenerator(gen, file, sg).generate(); err != nil {
+				return err
+			}
+		}
+		if *connectHandlers {
+			cg, err := newConnectGenerator(gen, file, sg)
+			if err != nil {
+				return err
+			}
+			if err := cg.generate(); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
@@ -112,20 +135,61 @@ func newServiceGenerator(plugin *protogen.Plugin, file *protogen.File, graph *ge
 		Service:       service,
 		EntType:       typ,
 		FieldMap:      fieldMap,
+		Instrument:    *instrument,
 	}, nil
 }
 
+// newConnectGenerator returns a generator for the connect-go handler of the service. The handler
+// is placed in the package generated by protoc-gen-connect-go, as it implements the handler
+// interface defined there and cannot be placed in the same package as the messages.
+func newConnectGenerator(plugin *protogen.Plugin, file *protogen.File, sg *serviceGenerator) (*serviceGenerator, error) {
+	if *connectSuffix == "" {
+		return nil, fmt.Errorf("entproto: connect_package_suffix must not be empty")
+	}
+	pkg := string(file.GoPackageName) + *connectSuffix
+	dir, base := path.Split(file.GeneratedFilenamePrefix)
+	filename := path.Join(dir, pkg, base+"_"+snake(sg.Service.GoName)+".go")
+	cg := *sg
+	cg.GeneratedFile = plugin.NewGeneratedFile(filename, protogen.GoImportPath(path.Join(string(file.GoImportPath), pkg)))
+	cg.Package = protogen.GoPackageName(pkg)
+	cg.template = "connect"
+	return &cg, nil
+}
+
+// newTestServerGenerator returns a generator for the in-memory test server of the service. Like
+// enttest, the server is placed in a separate package (e.g. entpbtest), as it depends on the testing
+// package and the SQLite driver, which should not be compiled into the package of the messages.
+func newTestServerGenerator(plugin *protogen.Plugin, file *protogen.File, sg *serviceGenerator) *serviceGenerator {
+	pkg := string(file.GoPackageName) + "test"
+	dir, base := path.Split(file.GeneratedFilenamePrefix)
+	filename := path.Join(dir, pkg, base+"_"+snake(sg.Service.GoName)+".go")
+	tg := *sg
+	tg.GeneratedFile = plugin.NewGeneratedFile(filename, protogen.GoImportPath(path.Join(string(file.GoImportPath), pkg)))
+	tg.Package = protogen.GoPackageName(pkg)
+	tg.template = "test_server"
+	return &tg
+}
+
 func (g *serviceGenerator) generate() error {
-	tmpl, err := gen.NewTemplate("service").
+	name := g.template
+	if name == "" {
+		name = "service"
+	}
+	tmpl, err := gen.NewTemplate(name).
 		Funcs(template.FuncMap{
 			"ident":        g.QualifiedGoIdent,
 			"entIdent":     g.entIdent,
 			"newConverter": g.newConverter,
 			"unquote":      strconv.Unquote,
+			"blankImport": func(pkg string) string {
+				g.Import(protogen.GoImportPath(pkg))
+				return ""
+			},
 			"qualify": func(pkg, ident string) string {
 				return g.QualifiedGoIdent(protogen.GoImportPath(pkg).Ident(ident))
 			},
 			"protoIdentNormalize": entproto.NormalizeEnumIdentifier,
+			"bitSize":             bitSize,
 			"statusErr": func(code, msg string) string {
 				return fmt.Sprintf("%s(%s, %q)",
 					g.QualifiedGoIdent(status.Ident("Error")),
@@ -152,7 +216,7 @@ func (g *serviceGenerator) generate() error {
 	if err != nil {
 		return err
 	}
-	if err := tmpl.ExecuteTemplate(g, "service", g); err != nil {
+	if err := tmpl.ExecuteTemplate(g, name, g); err != nil {
 		return fmt.Errorf("template execution failed: %w", err)
 	}
 	return nil
@@ -166,6 +230,14 @@ type (
 		Service    *protogen.Service
 		EntType    *gen.Type
 		FieldMap   entproto.FieldMap
+		// Instrument indicates if the service methods
+		// are wrapped with OpenTelemetry spans and metrics.
+		Instrument bool
+		// Package is the name of the package the file is generated
+		// into, if it is not the package of the messages. For example,
+		// the connect-go package of the handlers.
+		Package  protogen.GoPackageName
+		template string
 	}
 	methodInput struct {
 		G      *serviceGenerator
@@ -173,6 +245,57 @@ type (
 	}
 )
 
+// HasList reports if the service has a List method.
+func (g *serviceGenerator) HasList() bool {
+	for _, m := range g.Service.Methods {
+		if m.GoName == "List" {
+			return true
+		}
+	}
+	return false
+}
+
+// HasReadMask reports if any of the service methods accepts a read mask.
+func (g *serviceGenerator) HasReadMask() bool {
+	for _, m := range g.Service.Methods {
+		if hasReadMask(m) {
+			return true
+		}
+	}
+	return false
+}
+
+// HasReadMask reports if the method input has a read_mask field.
+func (m *methodInput) HasReadMask() bool {
+	return hasReadMask(m.Method)
+}
+
+func hasReadMask(m *protogen.Method) bool {
+	for _, f := range m.Input.Fields {
+		if f.Desc.Name() == "read_mask" && f.Message != nil && f.Message.Desc.FullName() == "google.protobuf.FieldMask" {
+			return true
+		}
+	}
+	return false
+}
+
+// bitSize returns the bit size of the given integer type, as
+// expected by strconv.ParseInt and strconv.ParseUint.
+func bitSize(t field.Type) int {
+	switch t {
+	case field.TypeInt8, field.TypeUint8:
+		return 8
+	case field.TypeInt16, field.TypeUint16:
+		return 16
+	case field.TypeInt32, field.TypeUint32:
+		return 32
+	case field.TypeInt64, field.TypeUint64:
+		return 64
+	default:
+		return 0
+	}
+}
+
 //go:embed template/*
 var templates embed.FS
 
@@ -0,0 +1,33 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entproto
+
+import (
+	"entgo.io/ent/schema"
+)
+
+const GeneratedAnnotation = "ProtoGenerated"
+
+type generated struct{}
+
+// Generated annotates a field or an edge to specify that it was generated by protoc-gen-ent from a .proto file.
+// When protoc-gen-ent runs in merge mode, it only updates or removes fields and edges with this annotation.
+func Generated() schema.Annotation {
+	return generated{}
+}
+
+func (generated) Name() string {
+	return GeneratedAnnotation
+}
@@ -3,10 +3,10 @@ package entpb
 
 import (
 	context "context"
-	base64 "encoding/base64"
 	entproto "entgo.io/contrib/entproto"
 	ent "entgo.io/contrib/entproto/internal/altdir/ent"
 	user "entgo.io/contrib/entproto/internal/altdir/ent/user"
+	runtime "entgo.io/contrib/entproto/runtime"
 	sqlgraph "entgo.io/ent/dialect/sql/sqlgraph"
 	fmt "fmt"
 	codes "google.golang.org/grpc/codes"
@@ -17,15 +17,32 @@ import (
 
 // UserService implements UserServiceServer
 type UserService struct {
-	client *ent.Client
+	client     *ent.Client
+	pageTokens *runtime.PageTokenCodec
 	UnimplementedUserServiceServer
 }
 
+// UserServiceOption configures a UserService.
+type UserServiceOption func(*UserService)
+
+// WithUserServicePageTokens sets the codec of the page tokens returned by List.
+// Defaults to unsigned tokens that never expire.
+func WithUserServicePageTokens(c *runtime.PageTokenCodec) UserServiceOption {
+	return func(svc *UserService) {
+		svc.pageTokens = c
+	}
+}
+
 // NewUserService returns a new UserService
-func NewUserService(client *ent.Client) *UserService {
-	return &UserService{
-		client: client,
+func NewUserService(client *ent.Client, opts ...UserServiceOption) *UserService {
+	svc := &UserService{
+		client:     client,
+		pageTokens: &runtime.PageTokenCodec{},
 	}
+	for _, opt := range opts {
+		opt(svc)
+	}
+	return svc
 }
 
 // toProtoUser transforms the ent type to the pb type
@@ -163,12 +180,16 @@ func (svc *UserService) List(ctx context.Context, req *ListUserRequest) (*ListUs
 	listQuery := svc.client.User.Query().
 		Order(ent.Desc(user.FieldID)).
 		Limit(pageSize + 1)
+	filter, err := runtime.FilterHash(req, "page_size", "page_token")
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+	}
 	if req.GetPageToken() != "" {
-		bytes, err := base64.StdEncoding.DecodeString(req.PageToken)
+		key, err := svc.pageTokens.Decode(req.GetPageToken(), filter)
 		if err != nil {
 			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
 		}
-		token, err := strconv.ParseInt(string(bytes), 10, 32)
+		token, err := strconv.ParseInt(key, 10, 0)
 		if err != nil {
 			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
 		}
@@ -187,8 +208,10 @@ func (svc *UserService) List(ctx context.Context, req *ListUserRequest) (*ListUs
 	case err == nil:
 		var nextPageToken string
 		if len(entList) == pageSize+1 {
-			nextPageToken = base64.StdEncoding.EncodeToString(
-				[]byte(fmt.Sprintf("%v", entList[len(entList)-1].ID)))
+			nextPageToken, err = svc.pageTokens.Encode(fmt.Sprint(entList[len(entList)-1].ID), filter)
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+			}
 			entList = entList[:len(entList)-1]
 		}
 		protoList, err := toProtoUserList(entList)
@@ -3,29 +3,130 @@ package entpb
 
 import (
 	context "context"
-	base64 "encoding/base64"
 	entproto "entgo.io/contrib/entproto"
 	ent "entgo.io/contrib/entproto/internal/todo/ent"
 	attachment "entgo.io/contrib/entproto/internal/todo/ent/attachment"
 	user "entgo.io/contrib/entproto/internal/todo/ent/user"
+	runtime "entgo.io/contrib/entproto/runtime"
 	sqlgraph "entgo.io/ent/dialect/sql/sqlgraph"
 	fmt "fmt"
 	uuid "github.com/google/uuid"
-	codes "google.golang.org/grpc/codes"
+	otel "go.opentelemetry.io/otel"
+	attribute "go.opentelemetry.io/otel/attribute"
+	codes "go.opentelemetry.io/otel/codes"
+	metric "go.opentelemetry.io/otel/metric"
+	trace "go.opentelemetry.io/otel/trace"
+	codes1 "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	time "time"
 )
 
 // AttachmentService implements AttachmentServiceServer
 type AttachmentService struct {
-	client *ent.Client
+	client     *ent.Client
+	pageTokens *runtime.PageTokenCodec
+	tracer     trace.Tracer
+	requests   metric.Int64Counter
+	duration   metric.Float64Histogram
+	rows       metric.Int64Histogram
 	UnimplementedAttachmentServiceServer
 }
 
+// AttachmentServiceOption configures a AttachmentService.
+type AttachmentServiceOption func(*AttachmentService)
+
+// WithAttachmentServicePageTokens sets the codec of the page tokens returned by List.
+// Defaults to unsigned tokens that never expire.
+func WithAttachmentServicePageTokens(c *runtime.PageTokenCodec) AttachmentServiceOption {
+	return func(svc *AttachmentService) {
+		svc.pageTokens = c
+	}
+}
+
+// WithAttachmentServiceTracerProvider sets the tracer provider used to create spans.
+// Defaults to the global tracer provider, which is a no-op unless registered.
+func WithAttachmentServiceTracerProvider(tp trace.TracerProvider) AttachmentServiceOption {
+	return func(svc *AttachmentService) {
+		svc.tracer = tp.Tracer("entgo.io/contrib/entproto")
+	}
+}
+
+// WithAttachmentServiceMeterProvider sets the meter provider used to record metrics.
+// Defaults to the global meter provider, which is a no-op unless registered.
+func WithAttachmentServiceMeterProvider(mp metric.MeterProvider) AttachmentServiceOption {
+	return func(svc *AttachmentService) {
+		svc.initMetrics(mp)
+	}
+}
+
 // NewAttachmentService returns a new AttachmentService
-func NewAttachmentService(client *ent.Client) *AttachmentService {
-	return &AttachmentService{
-		client: client,
+func NewAttachmentService(client *ent.Client, opts ...AttachmentServiceOption) *AttachmentService {
+	svc := &AttachmentService{
+		client:     client,
+		pageTokens: &runtime.PageTokenCodec{},
+	}
+	svc.tracer = otel.GetTracerProvider().Tracer("entgo.io/contrib/entproto")
+	svc.initMetrics(otel.GetMeterProvider())
+	for _, opt := range opts {
+		opt(svc)
+	}
+	return svc
+}
+
+// initMetrics creates the instruments used to record the metrics of the service.
+func (svc *AttachmentService) initMetrics(mp metric.MeterProvider) {
+	meter := mp.Meter("entgo.io/contrib/entproto")
+	var err error
+	if svc.requests, err = meter.Int64Counter("entgrpc.requests",
+		metric.WithDescription("Number of handled requests.")); err != nil {
+		otel.Handle(err)
+	}
+	if svc.duration, err = meter.Float64Histogram("entgrpc.duration",
+		metric.WithDescription("Duration of handled requests."), metric.WithUnit("s")); err != nil {
+		otel.Handle(err)
+	}
+	if svc.rows, err = meter.Int64Histogram("entgrpc.rows",
+		metric.WithDescription("Number of rows returned or affected by handled requests.")); err != nil {
+		otel.Handle(err)
+	}
+}
+
+// instrument starts a span for the given method, and returns a function that ends
+// the span and records the metrics of the method.
+func (svc *AttachmentService) instrument(ctx context.Context, method string) (context.Context, func(id any, rows int, err error)) {
+	start := time.Now()
+	attrs := []attribute.KeyValue{
+		attribute.String("rpc.system", "grpc"),
+		attribute.String("rpc.service", "entpb.AttachmentService"),
+		attribute.String("rpc.method", method),
+		attribute.String("ent.type", "Attachment"),
+	}
+	ctx, span := svc.tracer.Start(ctx, "entpb.AttachmentService/"+method, trace.WithAttributes(attrs...))
+	return ctx, func(id any, rows int, err error) {
+		switch id := id.(type) {
+		case nil:
+		case []byte:
+			span.SetAttributes(attribute.String("ent.id", fmt.Sprintf("%x", id)))
+		default:
+			span.SetAttributes(attribute.String("ent.id", fmt.Sprint(id)))
+		}
+		span.SetAttributes(attribute.Int("ent.rows", rows))
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+		set := metric.WithAttributes(append(attrs, attribute.String("rpc.grpc.status_code", status.Code(err).String()))...)
+		if svc.requests != nil {
+			svc.requests.Add(ctx, 1, set)
+		}
+		if svc.duration != nil {
+			svc.duration.Record(ctx, time.Since(start).Seconds(), set)
+		}
+		if svc.rows != nil {
+			svc.rows.Record(ctx, int64(rows), set)
+		}
 	}
 }
 
@@ -58,7 +159,7 @@ func toProtoAttachmentList(e []*ent.Attachment) ([]*Attachment, error) {
 	for _, entEntity := range e {
 		pbEntity, err := toProtoAttachment(entEntity)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+			return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
 		}
 		pbList = append(pbList, pbEntity)
 	}
@@ -67,6 +168,18 @@ func toProtoAttachmentList(e []*ent.Attachment) ([]*Attachment, error) {
 
 // Create implements AttachmentServiceServer.Create
 func (svc *AttachmentService) Create(ctx context.Context, req *CreateAttachmentRequest) (*Attachment, error) {
+	ctx, end := svc.instrument(ctx, "Create")
+	res, err := svc.create(ctx, req)
+	rows := 0
+	if err == nil {
+		rows = 1
+	}
+	end(res.GetId(), rows, err)
+	return res, err
+}
+
+// create implements Create without the instrumentation.
+func (svc *AttachmentService) create(ctx context.Context, req *CreateAttachmentRequest) (*Attachment, error) {
 	attachment := req.GetAttachment()
 	m, err := svc.createBuilder(attachment)
 	if err != nil {
@@ -77,28 +190,40 @@ func (svc *AttachmentService) Create(ctx context.Context, req *CreateAttachmentR
 	case err == nil:
 		proto, err := toProtoAttachment(res)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+			return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
 		}
 		return proto, nil
 	case sqlgraph.IsUniqueConstraintError(err):
-		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+		return nil, status.Errorf(codes1.AlreadyExists, "already exists: %s", err)
 	case ent.IsConstraintError(err):
-		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+		return nil, status.Errorf(codes1.InvalidArgument, "invalid argument: %s", err)
 	default:
-		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
 	}
 
 }
 
 // Get implements AttachmentServiceServer.Get
 func (svc *AttachmentService) Get(ctx context.Context, req *GetAttachmentRequest) (*Attachment, error) {
+	ctx, end := svc.instrument(ctx, "Get")
+	res, err := svc.get(ctx, req)
+	rows := 0
+	if err == nil {
+		rows = 1
+	}
+	end(req.GetId(), rows, err)
+	return res, err
+}
+
+// get implements Get without the instrumentation.
+func (svc *AttachmentService) get(ctx context.Context, req *GetAttachmentRequest) (*Attachment, error) {
 	var (
 		err error
 		get *ent.Attachment
 	)
 	var id uuid.UUID
 	if err := (&id).UnmarshalBinary(req.GetId()); err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+		return nil, status.Errorf(codes1.InvalidArgument, "invalid argument: %s", err)
 	}
 	switch req.GetView() {
 	case GetAttachmentRequest_VIEW_UNSPECIFIED, GetAttachmentRequest_BASIC:
@@ -114,25 +239,37 @@ func (svc *AttachmentService) Get(ctx context.Context, req *GetAttachmentRequest
 			}).
 			Only(ctx)
 	default:
-		return nil, status.Error(codes.InvalidArgument, "invalid argument: unknown view")
+		return nil, status.Error(codes1.InvalidArgument, "invalid argument: unknown view")
 	}
 	switch {
 	case err == nil:
 		return toProtoAttachment(get)
 	case ent.IsNotFound(err):
-		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+		return nil, status.Errorf(codes1.NotFound, "not found: %s", err)
 	default:
-		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
 	}
 
 }
 
 // Update implements AttachmentServiceServer.Update
 func (svc *AttachmentService) Update(ctx context.Context, req *UpdateAttachmentRequest) (*Attachment, error) {
+	ctx, end := svc.instrument(ctx, "Update")
+	res, err := svc.update(ctx, req)
+	rows := 0
+	if err == nil {
+		rows = 1
+	}
+	end(req.GetAttachment().GetId(), rows, err)
+	return res, err
+}
+
+// update implements Update without the instrumentation.
+func (svc *AttachmentService) update(ctx context.Context, req *UpdateAttachmentRequest) (*Attachment, error) {
 	attachment := req.GetAttachment()
 	var attachmentID uuid.UUID
 	if err := (&attachmentID).UnmarshalBinary(attachment.GetId()); err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+		return nil, status.Errorf(codes1.InvalidArgument, "invalid argument: %s", err)
 	}
 	m := svc.client.Attachment.UpdateOneID(attachmentID)
 	for _, item := range attachment.GetRecipients() {
@@ -149,40 +286,60 @@ func (svc *AttachmentService) Update(ctx context.Context, req *UpdateAttachmentR
 	case err == nil:
 		proto, err := toProtoAttachment(res)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+			return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
 		}
 		return proto, nil
 	case sqlgraph.IsUniqueConstraintError(err):
-		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+		return nil, status.Errorf(codes1.AlreadyExists, "already exists: %s", err)
 	case ent.IsConstraintError(err):
-		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+		return nil, status.Errorf(codes1.InvalidArgument, "invalid argument: %s", err)
 	default:
-		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
 	}
 
 }
 
 // Delete implements AttachmentServiceServer.Delete
 func (svc *AttachmentService) Delete(ctx context.Context, req *DeleteAttachmentRequest) (*emptypb.Empty, error) {
+	ctx, end := svc.instrument(ctx, "Delete")
+	res, err := svc.delete(ctx, req)
+	rows := 0
+	if err == nil {
+		rows = 1
+	}
+	end(req.GetId(), rows, err)
+	return res, err
+}
+
+// delete implements Delete without the instrumentation.
+func (svc *AttachmentService) delete(ctx context.Context, req *DeleteAttachmentRequest) (*emptypb.Empty, error) {
 	var err error
 	var id uuid.UUID
 	if err := (&id).UnmarshalBinary(req.GetId()); err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+		return nil, status.Errorf(codes1.InvalidArgument, "invalid argument: %s", err)
 	}
 	err = svc.client.Attachment.DeleteOneID(id).Exec(ctx)
 	switch {
 	case err == nil:
 		return &emptypb.Empty{}, nil
 	case ent.IsNotFound(err):
-		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+		return nil, status.Errorf(codes1.NotFound, "not found: %s", err)
 	default:
-		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
 	}
 
 }
 
 // List implements AttachmentServiceServer.List
 func (svc *AttachmentService) List(ctx context.Context, req *ListAttachmentRequest) (*ListAttachmentResponse, error) {
+	ctx, end := svc.instrument(ctx, "List")
+	res, err := svc.list(ctx, req)
+	end(nil, len(res.GetAttachmentList()), err)
+	return res, err
+}
+
+// list implements List without the instrumentation.
+func (svc *AttachmentService) list(ctx context.Context, req *ListAttachmentRequest) (*ListAttachmentResponse, error) {
 	var (
 		err      error
 		entList  []*ent.Attachment
@@ -191,21 +348,25 @@ func (svc *AttachmentService) List(ctx context.Context, req *ListAttachmentReque
 	pageSize = int(req.GetPageSize())
 	switch {
 	case pageSize < 0:
-		return nil, status.Errorf(codes.InvalidArgument, "page size cannot be less than zero")
+		return nil, status.Errorf(codes1.InvalidArgument, "page size cannot be less than zero")
 	case pageSize == 0 || pageSize > entproto.MaxPageSize:
 		pageSize = entproto.MaxPageSize
 	}
 	listQuery := svc.client.Attachment.Query().
 		Order(ent.Desc(attachment.FieldID)).
 		Limit(pageSize + 1)
+	filter, err := runtime.FilterHash(req, "page_size", "page_token")
+	if err != nil {
+		return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
+	}
 	if req.GetPageToken() != "" {
-		bytes, err := base64.StdEncoding.DecodeString(req.PageToken)
+		key, err := svc.pageTokens.Decode(req.GetPageToken(), filter)
 		if err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+			return nil, status.Errorf(codes1.InvalidArgument, "page token is invalid")
 		}
-		pageToken, err := uuid.ParseBytes(bytes)
+		pageToken, err := uuid.Parse(key)
 		if err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+			return nil, status.Errorf(codes1.InvalidArgument, "page token is invalid")
 		}
 		listQuery = listQuery.
 			Where(attachment.IDLTE(pageToken))
@@ -227,29 +388,39 @@ func (svc *AttachmentService) List(ctx context.Context, req *ListAttachmentReque
 	case err == nil:
 		var nextPageToken string
 		if len(entList) == pageSize+1 {
-			nextPageToken = base64.StdEncoding.EncodeToString(
-				[]byte(fmt.Sprintf("%v", entList[len(entList)-1].ID)))
+			nextPageToken, err = svc.pageTokens.Encode(fmt.Sprint(entList[len(entList)-1].ID), filter)
+			if err != nil {
+				return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
+			}
 			entList = entList[:len(entList)-1]
 		}
 		protoList, err := toProtoAttachmentList(entList)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+			return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
 		}
 		return &ListAttachmentResponse{
 			AttachmentList: protoList,
 			NextPageToken:  nextPageToken,
 		}, nil
 	default:
-		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
 	}
 
 }
 
 // BatchCreate implements AttachmentServiceServer.BatchCreate
 func (svc *AttachmentService) BatchCreate(ctx context.Context, req *BatchCreateAttachmentsRequest) (*BatchCreateAttachmentsResponse, error) {
+	ctx, end := svc.instrument(ctx, "BatchCreate")
+	res, err := svc.batchcreate(ctx, req)
+	end(nil, len(res.GetAttachments()), err)
+	return res, err
+}
+
+// batchcreate implements BatchCreate without the instrumentation.
+func (svc *AttachmentService) batchcreate(ctx context.Context, req *BatchCreateAttachmentsRequest) (*BatchCreateAttachmentsResponse, error) {
 	requests := req.GetRequests()
 	if len(requests) > entproto.MaxBatchCreateSize {
-		return nil, status.Errorf(codes.InvalidArgument, "batch size cannot be greater than %d", entproto.MaxBatchCreateSize)
+		return nil, status.Errorf(codes1.InvalidArgument, "batch size cannot be greater than %d", entproto.MaxBatchCreateSize)
 	}
 	bulk := make([]*ent.AttachmentCreate, len(requests))
 	for i, req := range requests {
@@ -265,17 +436,17 @@ func (svc *AttachmentService) BatchCreate(ctx context.Context, req *BatchCreateA
 	case err == nil:
 		protoList, err := toProtoAttachmentList(res)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+			return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
 		}
 		return &BatchCreateAttachmentsResponse{
 			Attachments: protoList,
 		}, nil
 	case sqlgraph.IsUniqueConstraintError(err):
-		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+		return nil, status.Errorf(codes1.AlreadyExists, "already exists: %s", err)
 	case ent.IsConstraintError(err):
-		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+		return nil, status.Errorf(codes1.InvalidArgument, "invalid argument: %s", err)
 	default:
-		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
 	}
 
 }
@@ -3,30 +3,131 @@ package entpb
 
 import (
 	context "context"
-	base64 "encoding/base64"
 	entproto "entgo.io/contrib/entproto"
 	ent "entgo.io/contrib/entproto/internal/todo/ent"
 	multiwordschema "entgo.io/contrib/entproto/internal/todo/ent/multiwordschema"
+	runtime "entgo.io/contrib/entproto/runtime"
 	sqlgraph "entgo.io/ent/dialect/sql/sqlgraph"
 	fmt "fmt"
-	codes "google.golang.org/grpc/codes"
+	otel "go.opentelemetry.io/otel"
+	attribute "go.opentelemetry.io/otel/attribute"
+	codes "go.opentelemetry.io/otel/codes"
+	metric "go.opentelemetry.io/otel/metric"
+	trace "go.opentelemetry.io/otel/trace"
+	codes1 "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	regexp "regexp"
 	strconv "strconv"
 	strings "strings"
+	time "time"
 )
 
 // MultiWordSchemaService implements MultiWordSchemaServiceServer
 type MultiWordSchemaService struct {
-	client *ent.Client
+	client     *ent.Client
+	pageTokens *runtime.PageTokenCodec
+	tracer     trace.Tracer
+	requests   metric.Int64Counter
+	duration   metric.Float64Histogram
+	rows       metric.Int64Histogram
 	UnimplementedMultiWordSchemaServiceServer
 }
 
+// MultiWordSchemaServiceOption configures a MultiWordSchemaService.
+type MultiWordSchemaServiceOption func(*MultiWordSchemaService)
+
+// WithMultiWordSchemaServicePageTokens sets the codec of the page tokens returned by List.
+// Defaults to unsigned tokens that never expire.
+func WithMultiWordSchemaServicePageTokens(c *runtime.PageTokenCodec) MultiWordSchemaServiceOption {
+	return func(svc *MultiWordSchemaService) {
+		svc.pageTokens = c
+	}
+}
+
+// WithMultiWordSchemaServiceTracerProvider sets the tracer provider used to create spans.
+// Defaults to the global tracer provider, which is a no-op unless registered.
+func WithMultiWordSchemaServiceTracerProvider(tp trace.TracerProvider) MultiWordSchemaServiceOption {
+	return func(svc *MultiWordSchemaService) {
+		svc.tracer = tp.Tracer("entgo.io/contrib/entproto")
+	}
+}
+
+// WithMultiWordSchemaServiceMeterProvider sets the meter provider used to record metrics.
+// Defaults to the global meter provider, which is a no-op unless registered.
+func WithMultiWordSchemaServiceMeterProvider(mp metric.MeterProvider) MultiWordSchemaServiceOption {
+	return func(svc *MultiWordSchemaService) {
+		svc.initMetrics(mp)
+	}
+}
+
 // NewMultiWordSchemaService returns a new MultiWordSchemaService
-func NewMultiWordSchemaService(client *ent.Client) *MultiWordSchemaService {
-	return &MultiWordSchemaService{
-		client: client,
+func NewMultiWordSchemaService(client *ent.Client, opts ...MultiWordSchemaServiceOption) *MultiWordSchemaService {
+	svc := &MultiWordSchemaService{
+		client:     client,
+		pageTokens: &runtime.PageTokenCodec{},
+	}
+	svc.tracer = otel.GetTracerProvider().Tracer("entgo.io/contrib/entproto")
+	svc.initMetrics(otel.GetMeterProvider())
+	for _, opt := range opts {
+		opt(svc)
+	}
+	return svc
+}
+
+// initMetrics creates the instruments used to record the metrics of the service.
+func (svc *MultiWordSchemaService) initMetrics(mp metric.MeterProvider) {
+	meter := mp.Meter("entgo.io/contrib/entproto")
+	var err error
+	if svc.requests, err = meter.Int64Counter("entgrpc.requests",
+		metric.WithDescription("Number of handled requests.")); err != nil {
+		otel.Handle(err)
+	}
+	if svc.duration, err = meter.Float64Histogram("entgrpc.duration",
+		metric.WithDescription("Duration of handled requests."), metric.WithUnit("s")); err != nil {
+		otel.Handle(err)
+	}
+	if svc.rows, err = meter.Int64Histogram("entgrpc.rows",
+		metric.WithDescription("Number of rows returned or affected by handled requests.")); err != nil {
+		otel.Handle(err)
+	}
+}
+
+// instrument starts a span for the given method, and returns a function that ends
+// the span and records the metrics of the method.
+func (svc *MultiWordSchemaService) instrument(ctx context.Context, method string) (context.Context, func(id any, rows int, err error)) {
+	start := time.Now()
+	attrs := []attribute.KeyValue{
+		attribute.String("rpc.system", "grpc"),
+		attribute.String("rpc.service", "entpb.MultiWordSchemaService"),
+		attribute.String("rpc.method", method),
+		attribute.String("ent.type", "MultiWordSchema"),
+	}
+	ctx, span := svc.tracer.Start(ctx, "entpb.MultiWordSchemaService/"+method, trace.WithAttributes(attrs...))
+	return ctx, func(id any, rows int, err error) {
+		switch id := id.(type) {
+		case nil:
+		case []byte:
+			span.SetAttributes(attribute.String("ent.id", fmt.Sprintf("%x", id)))
+		default:
+			span.SetAttributes(attribute.String("ent.id", fmt.Sprint(id)))
+		}
+		span.SetAttributes(attribute.Int("ent.rows", rows))
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+		set := metric.WithAttributes(append(attrs, attribute.String("rpc.grpc.status_code", status.Code(err).String()))...)
+		if svc.requests != nil {
+			svc.requests.Add(ctx, 1, set)
+		}
+		if svc.duration != nil {
+			svc.duration.Record(ctx, time.Since(start).Seconds(), set)
+		}
+		if svc.rows != nil {
+			svc.rows.Record(ctx, int64(rows), set)
+		}
 	}
 }
 
@@ -70,7 +171,7 @@ func toProtoMultiWordSchemaList(e []*ent.MultiWordSchema) ([]*MultiWordSchema, e
 	for _, entEntity := range e {
 		pbEntity, err := toProtoMultiWordSchema(entEntity)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+			return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
 		}
 		pbList = append(pbList, pbEntity)
 	}
@@ -79,6 +180,18 @@ func toProtoMultiWordSchemaList(e []*ent.MultiWordSchema) ([]*MultiWordSchema, e
 
 // Create implements MultiWordSchemaServiceServer.Create
 func (svc *MultiWordSchemaService) Create(ctx context.Context, req *CreateMultiWordSchemaRequest) (*MultiWordSchema, error) {
+	ctx, end := svc.instrument(ctx, "Create")
+	res, err := svc.create(ctx, req)
+	rows := 0
+	if err == nil {
+		rows = 1
+	}
+	end(res.GetId(), rows, err)
+	return res, err
+}
+
+// create implements Create without the instrumentation.
+func (svc *MultiWordSchemaService) create(ctx context.Context, req *CreateMultiWordSchemaRequest) (*MultiWordSchema, error) {
 	multiwordschema := req.GetMultiWordSchema()
 	m, err := svc.createBuilder(multiwordschema)
 	if err != nil {
@@ -89,21 +202,33 @@ func (svc *MultiWordSchemaService) Create(ctx context.Context, req *CreateMultiW
 	case err == nil:
 		proto, err := toProtoMultiWordSchema(res)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+			return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
 		}
 		return proto, nil
 	case sqlgraph.IsUniqueConstraintError(err):
-		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+		return nil, status.Errorf(codes1.AlreadyExists, "already exists: %s", err)
 	case ent.IsConstraintError(err):
-		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+		return nil, status.Errorf(codes1.InvalidArgument, "invalid argument: %s", err)
 	default:
-		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
 	}
 
 }
 
 // Get implements MultiWordSchemaServiceServer.Get
 func (svc *MultiWordSchemaService) Get(ctx context.Context, req *GetMultiWordSchemaRequest) (*MultiWordSchema, error) {
+	ctx, end := svc.instrument(ctx, "Get")
+	res, err := svc.get(ctx, req)
+	rows := 0
+	if err == nil {
+		rows = 1
+	}
+	end(req.GetId(), rows, err)
+	return res, err
+}
+
+// get implements Get without the instrumentation.
+func (svc *MultiWordSchemaService) get(ctx context.Context, req *GetMultiWordSchemaRequest) (*MultiWordSchema, error) {
 	var (
 		err error
 		get *ent.MultiWordSchema
@@ -117,21 +242,33 @@ func (svc *MultiWordSchemaService) Get(ctx context.Context, req *GetMultiWordSch
 			Where(multiwordschema.ID(id)).
 			Only(ctx)
 	default:
-		return nil, status.Error(codes.InvalidArgument, "invalid argument: unknown view")
+		return nil, status.Error(codes1.InvalidArgument, "invalid argument: unknown view")
 	}
 	switch {
 	case err == nil:
 		return toProtoMultiWordSchema(get)
 	case ent.IsNotFound(err):
-		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+		return nil, status.Errorf(codes1.NotFound, "not found: %s", err)
 	default:
-		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
 	}
 
 }
 
 // Update implements MultiWordSchemaServiceServer.Update
 func (svc *MultiWordSchemaService) Update(ctx context.Context, req *UpdateMultiWordSchemaRequest) (*MultiWordSchema, error) {
+	ctx, end := svc.instrument(ctx, "Update")
+	res, err := svc.update(ctx, req)
+	rows := 0
+	if err == nil {
+		rows = 1
+	}
+	end(req.GetMultiWordSchema().GetId(), rows, err)
+	return res, err
+}
+
+// update implements Update without the instrumentation.
+func (svc *MultiWordSchemaService) update(ctx context.Context, req *UpdateMultiWordSchemaRequest) (*MultiWordSchema, error) {
 	multiwordschema := req.GetMultiWordSchema()
 	multiwordschemaID := int(multiwordschema.GetId())
 	m := svc.client.MultiWordSchema.UpdateOneID(multiwordschemaID)
@@ -143,21 +280,33 @@ func (svc *MultiWordSchemaService) Update(ctx context.Context, req *UpdateMultiW
 	case err == nil:
 		proto, err := toProtoMultiWordSchema(res)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+			return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
 		}
 		return proto, nil
 	case sqlgraph.IsUniqueConstraintError(err):
-		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+		return nil, status.Errorf(codes1.AlreadyExists, "already exists: %s", err)
 	case ent.IsConstraintError(err):
-		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+		return nil, status.Errorf(codes1.InvalidArgument, "invalid argument: %s", err)
 	default:
-		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
 	}
 
 }
 
 // Delete implements MultiWordSchemaServiceServer.Delete
 func (svc *MultiWordSchemaService) Delete(ctx context.Context, req *DeleteMultiWordSchemaRequest) (*emptypb.Empty, error) {
+	ctx, end := svc.instrument(ctx, "Delete")
+	res, err := svc.delete(ctx, req)
+	rows := 0
+	if err == nil {
+		rows = 1
+	}
+	end(req.GetId(), rows, err)
+	return res, err
+}
+
+// delete implements Delete without the instrumentation.
+func (svc *MultiWordSchemaService) delete(ctx context.Context, req *DeleteMultiWordSchemaRequest) (*emptypb.Empty, error) {
 	var err error
 	id := int(req.GetId())
 	err = svc.client.MultiWordSchema.DeleteOneID(id).Exec(ctx)
@@ -165,15 +314,23 @@ func (svc *MultiWordSchemaService) Delete(ctx context.Context, req *DeleteMultiW
 	case err == nil:
 		return &emptypb.Empty{}, nil
 	case ent.IsNotFound(err):
-		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+		return nil, status.Errorf(codes1.NotFound, "not found: %s", err)
 	default:
-		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
 	}
 
 }
 
 // List implements MultiWordSchemaServiceServer.List
 func (svc *MultiWordSchemaService) List(ctx context.Context, req *ListMultiWordSchemaRequest) (*ListMultiWordSchemaResponse, error) {
+	ctx, end := svc.instrument(ctx, "List")
+	res, err := svc.list(ctx, req)
+	end(nil, len(res.GetMultiWordSchemaList()), err)
+	return res, err
+}
+
+// list implements List without the instrumentation.
+func (svc *MultiWordSchemaService) list(ctx context.Context, req *ListMultiWordSchemaRequest) (*ListMultiWordSchemaResponse, error) {
 	var (
 		err      error
 		entList  []*ent.MultiWordSchema
@@ -182,21 +339,25 @@ func (svc *MultiWordSchemaService) List(ctx context.Context, req *ListMultiWordS
 	pageSize = int(req.GetPageSize())
 	switch {
 	case pageSize < 0:
-		return nil, status.Errorf(codes.InvalidArgument, "page size cannot be less than zero")
+		return nil, status.Errorf(codes1.InvalidArgument, "page size cannot be less than zero")
 	case pageSize == 0 || pageSize > entproto.MaxPageSize:
 		pageSize = entproto.MaxPageSize
 	}
 	listQuery := svc.client.MultiWordSchema.Query().
 		Order(ent.Desc(multiwordschema.FieldID)).
 		Limit(pageSize + 1)
+	filter, err := runtime.FilterHash(req, "page_size", "page_token")
+	if err != nil {
+		return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
+	}
 	if req.GetPageToken() != "" {
-		bytes, err := base64.StdEncoding.DecodeString(req.PageToken)
+		key, err := svc.pageTokens.Decode(req.GetPageToken(), filter)
 		if err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+			return nil, status.Errorf(codes1.InvalidArgument, "page token is invalid")
 		}
-		token, err := strconv.ParseInt(string(bytes), 10, 32)
+		token, err := strconv.ParseInt(key, 10, 0)
 		if err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+			return nil, status.Errorf(codes1.InvalidArgument, "page token is invalid")
 		}
 		pageToken := int(token)
 		listQuery = listQuery.
@@ -213,29 +374,39 @@ func (svc *MultiWordSchemaService) List(ctx context.Context, req *ListMultiWordS
 	case err == nil:
 		var nextPageToken string
 		if len(entList) == pageSize+1 {
-			nextPageToken = base64.StdEncoding.EncodeToString(
-				[]byte(fmt.Sprintf("%v", entList[len(entList)-1].ID)))
+			nextPageToken, err = svc.pageTokens.Encode(fmt.Sprint(entList[len(entList)-1].ID), filter)
+			if err != nil {
+				return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
+			}
 			entList = entList[:len(entList)-1]
 		}
 		protoList, err := toProtoMultiWordSchemaList(entList)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+			return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
 		}
 		return &ListMultiWordSchemaResponse{
 			MultiWordSchemaList: protoList,
 			NextPageToken:       nextPageToken,
 		}, nil
 	default:
-		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
 	}
 
 }
 
 // BatchCreate implements MultiWordSchemaServiceServer.BatchCreate
 func (svc *MultiWordSchemaService) BatchCreate(ctx context.Context, req *BatchCreateMultiWordSchemasRequest) (*BatchCreateMultiWordSchemasResponse, error) {
+	ctx, end := svc.instrument(ctx, "BatchCreate")
+	res, err := svc.batchcreate(ctx, req)
+	end(nil, len(res.GetMultiWordSchemas()), err)
+	return res, err
+}
+
+// batchcreate implements BatchCreate without the instrumentation.
+func (svc *MultiWordSchemaService) batchcreate(ctx context.Context, req *BatchCreateMultiWordSchemasRequest) (*BatchCreateMultiWordSchemasResponse, error) {
 	requests := req.GetRequests()
 	if len(requests) > entproto.MaxBatchCreateSize {
-		return nil, status.Errorf(codes.InvalidArgument, "batch size cannot be greater than %d", entproto.MaxBatchCreateSize)
+		return nil, status.Errorf(codes1.InvalidArgument, "batch size cannot be greater than %d", entproto.MaxBatchCreateSize)
 	}
 	bulk := make([]*ent.MultiWordSchemaCreate, len(requests))
 	for i, req := range requests {
@@ -251,17 +422,17 @@ func (svc *MultiWordSchemaService) BatchCreate(ctx context.Context, req *BatchCr
 	case err == nil:
 		protoList, err := toProtoMultiWordSchemaList(res)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+			return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
 		}
 		return &BatchCreateMultiWordSchemasResponse{
 			MultiWordSchemas: protoList,
 		}, nil
 	case sqlgraph.IsUniqueConstraintError(err):
-		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+		return nil, status.Errorf(codes1.AlreadyExists, "already exists: %s", err)
 	case ent.IsConstraintError(err):
-		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+		return nil, status.Errorf(codes1.InvalidArgument, "invalid argument: %s", err)
 	default:
-		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
 	}
 
 }
@@ -3,31 +3,131 @@ package entpb
 
 import (
 	context "context"
-	base64 "encoding/base64"
 	entproto "entgo.io/contrib/entproto"
 	ent "entgo.io/contrib/entproto/internal/todo/ent"
 	nilexample "entgo.io/contrib/entproto/internal/todo/ent/nilexample"
 	runtime "entgo.io/contrib/entproto/runtime"
 	sqlgraph "entgo.io/ent/dialect/sql/sqlgraph"
 	fmt "fmt"
-	codes "google.golang.org/grpc/codes"
+	otel "go.opentelemetry.io/otel"
+	attribute "go.opentelemetry.io/otel/attribute"
+	codes "go.opentelemetry.io/otel/codes"
+	metric "go.opentelemetry.io/otel/metric"
+	trace "go.opentelemetry.io/otel/trace"
+	codes1 "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	strconv "strconv"
+	time "time"
 )
 
 // NilExampleService implements NilExampleServiceServer
 type NilExampleService struct {
-	client *ent.Client
+	client     *ent.Client
+	pageTokens *runtime.PageTokenCodec
+	tracer     trace.Tracer
+	requests   metric.Int64Counter
+	duration   metric.Float64Histogram
+	rows       metric.Int64Histogram
 	UnimplementedNilExampleServiceServer
 }
 
+// NilExampleServiceOption configures a NilExampleService.
+type NilExampleServiceOption func(*NilExampleService)
+
+// WithNilExampleServicePageTokens sets the codec of the page tokens returned by List.
+// Defaults to unsigned tokens that never expire.
+func WithNilExampleServicePageTokens(c *runtime.PageTokenCodec) NilExampleServiceOption {
+	return func(svc *NilExampleService) {
+		svc.pageTokens = c
+	}
+}
+
+// WithNilExampleServiceTracerProvider sets the tracer provider used to create spans.
+// Defaults to the global tracer provider, which is a no-op unless registered.
+func WithNilExampleServiceTracerProvider(tp trace.TracerProvider) NilExampleServiceOption {
+	return func(svc *NilExampleService) {
+		svc.tracer = tp.Tracer("entgo.io/contrib/entproto")
+	}
+}
+
+// WithNilExampleServiceMeterProvider sets the meter provider used to record metrics.
+// Defaults to the global meter provider, which is a no-op unless registered.
+func WithNilExampleServiceMeterProvider(mp metric.MeterProvider) NilExampleServiceOption {
+	return func(svc *NilExampleService) {
+		svc.initMetrics(mp)
+	}
+}
+
 // NewNilExampleService returns a new NilExampleService
-func NewNilExampleService(client *ent.Client) *NilExampleService {
-	return &NilExampleService{
-		client: client,
+func NewNilExampleService(client *ent.Client, opts ...NilExampleServiceOption) *NilExampleService {
+	svc := &NilExampleService{
+		client:     client,
+		pageTokens: &runtime.PageTokenCodec{},
+	}
+	svc.tracer = otel.GetTracerProvider().Tracer("entgo.io/contrib/entproto")
+	svc.initMetrics(otel.GetMeterProvider())
+	for _, opt := range opts {
+		opt(svc)
+	}
+	return svc
+}
+
+// initMetrics creates the instruments used to record the metrics of the service.
+func (svc *NilExampleService) initMetrics(mp metric.MeterProvider) {
+	meter := mp.Meter("entgo.io/contrib/entproto")
+	var err error
+	if svc.requests, err = meter.Int64Counter("entgrpc.requests",
+		metric.WithDescription("Number of handled requests.")); err != nil {
+		otel.Handle(err)
+	}
+	if svc.duration, err = meter.Float64Histogram("entgrpc.duration",
+		metric.WithDescription("Duration of handled requests."), metric.WithUnit("s")); err != nil {
+		otel.Handle(err)
+	}
+	if svc.rows, err = meter.Int64Histogram("entgrpc.rows",
+		metric.WithDescription("Number of rows returned or affected by handled requests.")); err != nil {
+		otel.Handle(err)
+	}
+}
+
+// instrument starts a span for the given method, and returns a function that ends
+// the span and records the metrics of the method.
+func (svc *NilExampleService) instrument(ctx context.Context, method string) (context.Context, func(id any, rows int, err error)) {
+	start := time.Now()
+	attrs := []attribute.KeyValue{
+		attribute.String("rpc.system", "grpc"),
+		attribute.String("rpc.service", "entpb.NilExampleService"),
+		attribute.String("rpc.method", method),
+		attribute.String("ent.type", "NilExample"),
+	}
+	ctx, span := svc.tracer.Start(ctx, "entpb.NilExampleService/"+method, trace.WithAttributes(attrs...))
+	return ctx, func(id any, rows int, err error) {
+		switch id := id.(type) {
+		case nil:
+		case []byte:
+			span.SetAttributes(attribute.String("ent.id", fmt.Sprintf("%x", id)))
+		default:
+			span.SetAttributes(attribute.String("ent.id", fmt.Sprint(id)))
+		}
+		span.SetAttributes(attribute.Int("ent.rows", rows))
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+		set := metric.WithAttributes(append(attrs, attribute.String("rpc.grpc.status_code", status.Code(err).String()))...)
+		if svc.requests != nil {
+			svc.requests.Add(ctx, 1, set)
+		}
+		if svc.duration != nil {
+			svc.duration.Record(ctx, time.Since(start).Seconds(), set)
+		}
+		if svc.rows != nil {
+			svc.rows.Record(ctx, int64(rows), set)
+		}
 	}
 }
 
@@ -53,7 +153,7 @@ func toProtoNilExampleList(e []*ent.NilExample) ([]*NilExample, error) {
 	for _, entEntity := range e {
 		pbEntity, err := toProtoNilExample(entEntity)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+			return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
 		}
 		pbList = append(pbList, pbEntity)
 	}
@@ -62,6 +162,18 @@ func toProtoNilExampleList(e []*ent.NilExample) ([]*NilExample, error) {
 
 // Create implements NilExampleServiceServer.Create
 func (svc *NilExampleService) Create(ctx context.Context, req *CreateNilExampleRequest) (*NilExample, error) {
+	ctx, end := svc.instrument(ctx, "Create")
+	res, err := svc.create(ctx, req)
+	rows := 0
+	if err == nil {
+		rows = 1
+	}
+	end(res.GetId(), rows, err)
+	return res, err
+}
+
+// create implements Create without the instrumentation.
+func (svc *NilExampleService) create(ctx context.Context, req *CreateNilExampleRequest) (*NilExample, error) {
 	nilexample := req.GetNilExample()
 	m, err := svc.createBuilder(nilexample)
 	if err != nil {
@@ -72,21 +184,33 @@ func (svc *NilExampleService) Create(ctx context.Context, req *CreateNilExampleR
 	case err == nil:
 		proto, err := toProtoNilExample(res)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+			return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
 		}
 		return proto, nil
 	case sqlgraph.IsUniqueConstraintError(err):
-		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+		return nil, status.Errorf(codes1.AlreadyExists, "already exists: %s", err)
 	case ent.IsConstraintError(err):
-		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+		return nil, status.Errorf(codes1.InvalidArgument, "invalid argument: %s", err)
 	default:
-		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
 	}
 
 }
 
 // Get implements NilExampleServiceServer.Get
 func (svc *NilExampleService) Get(ctx context.Context, req *GetNilExampleRequest) (*NilExample, error) {
+	ctx, end := svc.instrument(ctx, "Get")
+	res, err := svc.get(ctx, req)
+	rows := 0
+	if err == nil {
+		rows = 1
+	}
+	end(req.GetId(), rows, err)
+	return res, err
+}
+
+// get implements Get without the instrumentation.
+func (svc *NilExampleService) get(ctx context.Context, req *GetNilExampleRequest) (*NilExample, error) {
 	var (
 		err error
 		get *ent.NilExample
@@ -100,21 +224,33 @@ func (svc *NilExampleService) Get(ctx context.Context, req *GetNilExampleRequest
 			Where(nilexample.ID(id)).
 			Only(ctx)
 	default:
-		return nil, status.Error(codes.InvalidArgument, "invalid argument: unknown view")
+		return nil, status.Error(codes1.InvalidArgument, "invalid argument: unknown view")
 	}
 	switch {
 	case err == nil:
 		return toProtoNilExample(get)
 	case ent.IsNotFound(err):
-		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+		return nil, status.Errorf(codes1.NotFound, "not found: %s", err)
 	default:
-		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
 	}
 
 }
 
 // Update implements NilExampleServiceServer.Update
 func (svc *NilExampleService) Update(ctx context.Context, req *UpdateNilExampleRequest) (*NilExample, error) {
+	ctx, end := svc.instrument(ctx, "Update")
+	res, err := svc.update(ctx, req)
+	rows := 0
+	if err == nil {
+		rows = 1
+	}
+	end(req.GetNilExample().GetId(), rows, err)
+	return res, err
+}
+
+// update implements Update without the instrumentation.
+func (svc *NilExampleService) update(ctx context.Context, req *UpdateNilExampleRequest) (*NilExample, error) {
 	nilexample := req.GetNilExample()
 	nilexampleID := int(nilexample.GetId())
 	m := svc.client.NilExample.UpdateOneID(nilexampleID)
@@ -132,21 +268,33 @@ func (svc *NilExampleService) Update(ctx context.Context, req *UpdateNilExampleR
 	case err == nil:
 		proto, err := toProtoNilExample(res)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+			return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
 		}
 		return proto, nil
 	case sqlgraph.IsUniqueConstraintError(err):
-		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+		return nil, status.Errorf(codes1.AlreadyExists, "already exists: %s", err)
 	case ent.IsConstraintError(err):
-		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+		return nil, status.Errorf(codes1.InvalidArgument, "invalid argument: %s", err)
 	default:
-		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
 	}
 
 }
 
 // Delete implements NilExampleServiceServer.Delete
 func (svc *NilExampleService) Delete(ctx context.Context, req *DeleteNilExampleRequest) (*emptypb.Empty, error) {
+	ctx, end := svc.instrument(ctx, "Delete")
+	res, err := svc.delete(ctx, req)
+	rows := 0
+	if err == nil {
+		rows = 1
+	}
+	end(req.GetId(), rows, err)
+	return res, err
+}
+
+// delete implements Delete without the instrumentation.
+func (svc *NilExampleService) delete(ctx context.Context, req *DeleteNilExampleRequest) (*emptypb.Empty, error) {
 	var err error
 	id := int(req.GetId())
 	err = svc.client.NilExample.DeleteOneID(id).Exec(ctx)
@@ -154,15 +302,23 @@ func (svc *NilExampleService) Delete(ctx context.Context, req *DeleteNilExampleR
 	case err == nil:
 		return &emptypb.Empty{}, nil
 	case ent.IsNotFound(err):
-		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+		return nil, status.Errorf(codes1.NotFound, "not found: %s", err)
 	default:
-		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
 	}
 
 }
 
 // List implements NilExampleServiceServer.List
 func (svc *NilExampleService) List(ctx context.Context, req *ListNilExampleRequest) (*ListNilExampleResponse, error) {
+	ctx, end := svc.instrument(ctx, "List")
+	res, err := svc.list(ctx, req)
+	end(nil, len(res.GetNilExampleList()), err)
+	return res, err
+}
+
+// list implements List without the instrumentation.
+func (svc *NilExampleService) list(ctx context.Context, req *ListNilExampleRequest) (*ListNilExampleResponse, error) {
 	var (
 		err      error
 		entList  []*ent.NilExample
@@ -171,21 +327,25 @@ func (svc *NilExampleService) List(ctx context.Context, req *ListNilExampleReque
 	pageSize = int(req.GetPageSize())
 	switch {
 	case pageSize < 0:
-		return nil, status.Errorf(codes.InvalidArgument, "page size cannot be less than zero")
+		return nil, status.Errorf(codes1.InvalidArgument, "page size cannot be less than zero")
 	case pageSize == 0 || pageSize > entproto.MaxPageSize:
 		pageSize = entproto.MaxPageSize
 	}
 	listQuery := svc.client.NilExample.Query().
 		Order(ent.Desc(nilexample.FieldID)).
 		Limit(pageSize + 1)
+	filter, err := runtime.FilterHash(req, "page_size", "page_token")
+	if err != nil {
+		return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
+	}
 	if req.GetPageToken() != "" {
-		bytes, err := base64.StdEncoding.DecodeString(req.PageToken)
+		key, err := svc.pageTokens.Decode(req.GetPageToken(), filter)
 		if err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+			return nil, status.Errorf(codes1.InvalidArgument, "page token is invalid")
 		}
-		token, err := strconv.ParseInt(string(bytes), 10, 32)
+		token, err := strconv.ParseInt(key, 10, 0)
 		if err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+			return nil, status.Errorf(codes1.InvalidArgument, "page token is invalid")
 		}
 		pageToken := int(token)
 		listQuery = listQuery.
@@ -202,29 +362,39 @@ func (svc *NilExampleService) List(ctx context.Context, req *ListNilExampleReque
 	case err == nil:
 		var nextPageToken string
 		if len(entList) == pageSize+1 {
-			nextPageToken = base64.StdEncoding.EncodeToString(
-				[]byte(fmt.Sprintf("%v", entList[len(entList)-1].ID)))
+			nextPageToken, err = svc.pageTokens.Encode(fmt.Sprint(entList[len(entList)-1].ID), filter)
+			if err != nil {
+				return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
+			}
 			entList = entList[:len(entList)-1]
 		}
 		protoList, err := toProtoNilExampleList(entList)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+			return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
 		}
 		return &ListNilExampleResponse{
 			NilExampleList: protoList,
 			NextPageToken:  nextPageToken,
 		}, nil
 	default:
-		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
 	}
 
 }
 
 // BatchCreate implements NilExampleServiceServer.BatchCreate
 func (svc *NilExampleService) BatchCreate(ctx context.Context, req *BatchCreateNilExamplesRequest) (*BatchCreateNilExamplesResponse, error) {
+	ctx, end := svc.instrument(ctx, "BatchCreate")
+	res, err := svc.batchcreate(ctx, req)
+	end(nil, len(res.GetNilExamples()), err)
+	return res, err
+}
+
+// batchcreate implements BatchCreate without the instrumentation.
+func (svc *NilExampleService) batchcreate(ctx context.Context, req *BatchCreateNilExamplesRequest) (*BatchCreateNilExamplesResponse, error) {
 	requests := req.GetRequests()
 	if len(requests) > entproto.MaxBatchCreateSize {
-		return nil, status.Errorf(codes.InvalidArgument, "batch size cannot be greater than %d", entproto.MaxBatchCreateSize)
+		return nil, status.Errorf(codes1.InvalidArgument, "batch size cannot be greater than %d", entproto.MaxBatchCreateSize)
 	}
 	bulk := make([]*ent.NilExampleCreate, len(requests))
 	for i, req := range requests {
@@ -240,17 +410,17 @@ func (svc *NilExampleService) BatchCreate(ctx context.Context, req *BatchCreateN
 	case err == nil:
 		protoList, err := toProtoNilExampleList(res)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+			return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
 		}
 		return &BatchCreateNilExamplesResponse{
 			NilExamples: protoList,
 		}, nil
 	case sqlgraph.IsUniqueConstraintError(err):
-		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+		return nil, status.Errorf(codes1.AlreadyExists, "already exists: %s", err)
 	case ent.IsConstraintError(err):
-		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+		return nil, status.Errorf(codes1.InvalidArgument, "invalid argument: %s", err)
 	default:
-		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
 	}
 
 }
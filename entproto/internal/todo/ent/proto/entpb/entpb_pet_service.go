@@ -3,31 +3,132 @@ package entpb
 
 import (
 	context "context"
-	base64 "encoding/base64"
 	entproto "entgo.io/contrib/entproto"
 	ent "entgo.io/contrib/entproto/internal/todo/ent"
 	attachment "entgo.io/contrib/entproto/internal/todo/ent/attachment"
 	pet "entgo.io/contrib/entproto/internal/todo/ent/pet"
 	user "entgo.io/contrib/entproto/internal/todo/ent/user"
+	runtime "entgo.io/contrib/entproto/runtime"
 	sqlgraph "entgo.io/ent/dialect/sql/sqlgraph"
 	fmt "fmt"
 	uuid "github.com/google/uuid"
-	codes "google.golang.org/grpc/codes"
+	otel "go.opentelemetry.io/otel"
+	attribute "go.opentelemetry.io/otel/attribute"
+	codes "go.opentelemetry.io/otel/codes"
+	metric "go.opentelemetry.io/otel/metric"
+	trace "go.opentelemetry.io/otel/trace"
+	codes1 "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	strconv "strconv"
+	time "time"
 )
 
 // PetService implements PetServiceServer
 type PetService struct {
-	client *ent.Client
+	client     *ent.Client
+	pageTokens *runtime.PageTokenCodec
+	tracer     trace.Tracer
+	requests   metric.Int64Counter
+	duration   metric.Float64Histogram
+	rows       metric.Int64Histogram
 	UnimplementedPetServiceServer
 }
 
+// PetServiceOption configures a PetService.
+type PetServiceOption func(*PetService)
+
+// WithPetServicePageTokens sets the codec of the page tokens returned by List.
+// Defaults to unsigned tokens that never expire.
+func WithPetServicePageTokens(c *runtime.PageTokenCodec) PetServiceOption {
+	return func(svc *PetService) {
+		svc.pageTokens = c
+	}
+}
+
+// WithPetServiceTracerProvider sets the tracer provider used to create spans.
+// Defaults to the global tracer provider, which is a no-op unless registered.
+func WithPetServiceTracerProvider(tp trace.TracerProvider) PetServiceOption {
+	return func(svc *PetService) {
+		svc.tracer = tp.Tracer("entgo.io/contrib/entproto")
+	}
+}
+
+// WithPetServiceMeterProvider sets the meter provider used to record metrics.
+// Defaults to the global meter provider, which is a no-op unless registered.
+func WithPetServiceMeterProvider(mp metric.MeterProvider) PetServiceOption {
+	return func(svc *PetService) {
+		svc.initMetrics(mp)
+	}
+}
+
 // NewPetService returns a new PetService
-func NewPetService(client *ent.Client) *PetService {
-	return &PetService{
-		client: client,
+func NewPetService(client *ent.Client, opts ...PetServiceOption) *PetService {
+	svc := &PetService{
+		client:     client,
+		pageTokens: &runtime.PageTokenCodec{},
+	}
+	svc.tracer = otel.GetTracerProvider().Tracer("entgo.io/contrib/entproto")
+	svc.initMetrics(otel.GetMeterProvider())
+	for _, opt := range opts {
+		opt(svc)
+	}
+	return svc
+}
+
+// initMetrics creates the instruments used to record the metrics of the service.
+func (svc *PetService) initMetrics(mp metric.MeterProvider) {
+	meter := mp.Meter("entgo.io/contrib/entproto")
+	var err error
+	if svc.requests, err = meter.Int64Counter("entgrpc.requests",
+		metric.WithDescription("Number of handled requests.")); err != nil {
+		otel.Handle(err)
+	}
+	if svc.duration, err = meter.Float64Histogram("entgrpc.duration",
+		metric.WithDescription("Duration of handled requests."), metric.WithUnit("s")); err != nil {
+		otel.Handle(err)
+	}
+	if svc.rows, err = meter.Int64Histogram("entgrpc.rows",
+		metric.WithDescription("Number of rows returned or affected by handled requests.")); err != nil {
+		otel.Handle(err)
+	}
+}
+
+// instrument starts a span for the given method, and returns a function that ends
+// the span and records the metrics of the method.
+func (svc *PetService) instrument(ctx context.Context, method string) (context.Context, func(id any, rows int, err error)) {
+	start := time.Now()
+	attrs := []attribute.KeyValue{
+		attribute.String("rpc.system", "grpc"),
+		attribute.String("rpc.service", "entpb.PetService"),
+		attribute.String("rpc.method", method),
+		attribute.String("ent.type", "Pet"),
+	}
+	ctx, span := svc.tracer.Start(ctx, "entpb.PetService/"+method, trace.WithAttributes(attrs...))
+	return ctx, func(id any, rows int, err error) {
+		switch id := id.(type) {
+		case nil:
+		case []byte:
+			span.SetAttributes(attribute.String("ent.id", fmt.Sprintf("%x", id)))
+		default:
+			span.SetAttributes(attribute.String("ent.id", fmt.Sprint(id)))
+		}
+		span.SetAttributes(attribute.Int("ent.rows", rows))
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+		set := metric.WithAttributes(append(attrs, attribute.String("rpc.grpc.status_code", status.Code(err).String()))...)
+		if svc.requests != nil {
+			svc.requests.Add(ctx, 1, set)
+		}
+		if svc.duration != nil {
+			svc.duration.Record(ctx, time.Since(start).Seconds(), set)
+		}
+		if svc.rows != nil {
+			svc.rows.Record(ctx, int64(rows), set)
+		}
 	}
 }
 
@@ -60,7 +161,7 @@ func toProtoPetList(e []*ent.Pet) ([]*Pet, error) {
 	for _, entEntity := range e {
 		pbEntity, err := toProtoPet(entEntity)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+			return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
 		}
 		pbList = append(pbList, pbEntity)
 	}
@@ -69,6 +170,18 @@ func toProtoPetList(e []*ent.Pet) ([]*Pet, error) {
 
 // Create implements PetServiceServer.Create
 func (svc *PetService) Create(ctx context.Context, req *CreatePetRequest) (*Pet, error) {
+	ctx, end := svc.instrument(ctx, "Create")
+	res, err := svc.create(ctx, req)
+	rows := 0
+	if err == nil {
+		rows = 1
+	}
+	end(res.GetId(), rows, err)
+	return res, err
+}
+
+// create implements Create without the instrumentation.
+func (svc *PetService) create(ctx context.Context, req *CreatePetRequest) (*Pet, error) {
 	pet := req.GetPet()
 	m, err := svc.createBuilder(pet)
 	if err != nil {
@@ -79,21 +192,33 @@ func (svc *PetService) Create(ctx context.Context, req *CreatePetRequest) (*Pet,
 	case err == nil:
 		proto, err := toProtoPet(res)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+			return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
 		}
 		return proto, nil
 	case sqlgraph.IsUniqueConstraintError(err):
-		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+		return nil, status.Errorf(codes1.AlreadyExists, "already exists: %s", err)
 	case ent.IsConstraintError(err):
-		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+		return nil, status.Errorf(codes1.InvalidArgument, "invalid argument: %s", err)
 	default:
-		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
 	}
 
 }
 
 // Get implements PetServiceServer.Get
 func (svc *PetService) Get(ctx context.Context, req *GetPetRequest) (*Pet, error) {
+	ctx, end := svc.instrument(ctx, "Get")
+	res, err := svc.get(ctx, req)
+	rows := 0
+	if err == nil {
+		rows = 1
+	}
+	end(req.GetId(), rows, err)
+	return res, err
+}
+
+// get implements Get without the instrumentation.
+func (svc *PetService) get(ctx context.Context, req *GetPetRequest) (*Pet, error) {
 	var (
 		err error
 		get *ent.Pet
@@ -113,28 +238,40 @@ func (svc *PetService) Get(ctx context.Context, req *GetPetRequest) (*Pet, error
 			}).
 			Only(ctx)
 	default:
-		return nil, status.Error(codes.InvalidArgument, "invalid argument: unknown view")
+		return nil, status.Error(codes1.InvalidArgument, "invalid argument: unknown view")
 	}
 	switch {
 	case err == nil:
 		return toProtoPet(get)
 	case ent.IsNotFound(err):
-		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+		return nil, status.Errorf(codes1.NotFound, "not found: %s", err)
 	default:
-		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
 	}
 
 }
 
 // Update implements PetServiceServer.Update
 func (svc *PetService) Update(ctx context.Context, req *UpdatePetRequest) (*Pet, error) {
+	ctx, end := svc.instrument(ctx, "Update")
+	res, err := svc.update(ctx, req)
+	rows := 0
+	if err == nil {
+		rows = 1
+	}
+	end(req.GetPet().GetId(), rows, err)
+	return res, err
+}
+
+// update implements Update without the instrumentation.
+func (svc *PetService) update(ctx context.Context, req *UpdatePetRequest) (*Pet, error) {
 	pet := req.GetPet()
 	petID := int(pet.GetId())
 	m := svc.client.Pet.UpdateOneID(petID)
 	for _, item := range pet.GetAttachment() {
 		var attachment uuid.UUID
 		if err := (&attachment).UnmarshalBinary(item.GetId()); err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+			return nil, status.Errorf(codes1.InvalidArgument, "invalid argument: %s", err)
 		}
 		m.AddAttachmentIDs(attachment)
 	}
@@ -148,21 +285,33 @@ func (svc *PetService) Update(ctx context.Context, req *UpdatePetRequest) (*Pet,
 	case err == nil:
 		proto, err := toProtoPet(res)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+			return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
 		}
 		return proto, nil
 	case sqlgraph.IsUniqueConstraintError(err):
-		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+		return nil, status.Errorf(codes1.AlreadyExists, "already exists: %s", err)
 	case ent.IsConstraintError(err):
-		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+		return nil, status.Errorf(codes1.InvalidArgument, "invalid argument: %s", err)
 	default:
-		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
 	}
 
 }
 
 // Delete implements PetServiceServer.Delete
 func (svc *PetService) Delete(ctx context.Context, req *DeletePetRequest) (*emptypb.Empty, error) {
+	ctx, end := svc.instrument(ctx, "Delete")
+	res, err := svc.delete(ctx, req)
+	rows := 0
+	if err == nil {
+		rows = 1
+	}
+	end(req.GetId(), rows, err)
+	return res, err
+}
+
+// delete implements Delete without the instrumentation.
+func (svc *PetService) delete(ctx context.Context, req *DeletePetRequest) (*emptypb.Empty, error) {
 	var err error
 	id := int(req.GetId())
 	err = svc.client.Pet.DeleteOneID(id).Exec(ctx)
@@ -170,15 +319,23 @@ func (svc *PetService) Delete(ctx context.Context, req *DeletePetRequest) (*empt
 	case err == nil:
 		return &emptypb.Empty{}, nil
 	case ent.IsNotFound(err):
-		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+		return nil, status.Errorf(codes1.NotFound, "not found: %s", err)
 	default:
-		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
 	}
 
 }
 
 // List implements PetServiceServer.List
 func (svc *PetService) List(ctx context.Context, req *ListPetRequest) (*ListPetResponse, error) {
+	ctx, end := svc.instrument(ctx, "List")
+	res, err := svc.list(ctx, req)
+	end(nil, len(res.GetPetList()), err)
+	return res, err
+}
+
+// list implements List without the instrumentation.
+func (svc *PetService) list(ctx context.Context, req *ListPetRequest) (*ListPetResponse, error) {
 	var (
 		err      error
 		entList  []*ent.Pet
@@ -187,21 +344,25 @@ func (svc *PetService) List(ctx context.Context, req *ListPetRequest) (*ListPetR
 	pageSize = int(req.GetPageSize())
 	switch {
 	case pageSize < 0:
-		return nil, status.Errorf(codes.InvalidArgument, "page size cannot be less than zero")
+		return nil, status.Errorf(codes1.InvalidArgument, "page size cannot be less than zero")
 	case pageSize == 0 || pageSize > entproto.MaxPageSize:
 		pageSize = entproto.MaxPageSize
 	}
 	listQuery := svc.client.Pet.Query().
 		Order(ent.Desc(pet.FieldID)).
 		Limit(pageSize + 1)
+	filter, err := runtime.FilterHash(req, "page_size", "page_token")
+	if err != nil {
+		return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
+	}
 	if req.GetPageToken() != "" {
-		bytes, err := base64.StdEncoding.DecodeString(req.PageToken)
+		key, err := svc.pageTokens.Decode(req.GetPageToken(), filter)
 		if err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+			return nil, status.Errorf(codes1.InvalidArgument, "page token is invalid")
 		}
-		token, err := strconv.ParseInt(string(bytes), 10, 32)
+		token, err := strconv.ParseInt(key, 10, 0)
 		if err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+			return nil, status.Errorf(codes1.InvalidArgument, "page token is invalid")
 		}
 		pageToken := int(token)
 		listQuery = listQuery.
@@ -224,29 +385,39 @@ func (svc *PetService) List(ctx context.Context, req *ListPetRequest) (*ListPetR
 	case err == nil:
 		var nextPageToken string
 		if len(entList) == pageSize+1 {
-			nextPageToken = base64.StdEncoding.EncodeToString(
-				[]byte(fmt.Sprintf("%v", entList[len(entList)-1].ID)))
+			nextPageToken, err = svc.pageTokens.Encode(fmt.Sprint(entList[len(entList)-1].ID), filter)
+			if err != nil {
+				return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
+			}
 			entList = entList[:len(entList)-1]
 		}
 		protoList, err := toProtoPetList(entList)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+			return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
 		}
 		return &ListPetResponse{
 			PetList:       protoList,
 			NextPageToken: nextPageToken,
 		}, nil
 	default:
-		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
 	}
 
 }
 
 // BatchCreate implements PetServiceServer.BatchCreate
 func (svc *PetService) BatchCreate(ctx context.Context, req *BatchCreatePetsRequest) (*BatchCreatePetsResponse, error) {
+	ctx, end := svc.instrument(ctx, "BatchCreate")
+	res, err := svc.batchcreate(ctx, req)
+	end(nil, len(res.GetPets()), err)
+	return res, err
+}
+
+// batchcreate implements BatchCreate without the instrumentation.
+func (svc *PetService) batchcreate(ctx context.Context, req *BatchCreatePetsRequest) (*BatchCreatePetsResponse, error) {
 	requests := req.GetRequests()
 	if len(requests) > entproto.MaxBatchCreateSize {
-		return nil, status.Errorf(codes.InvalidArgument, "batch size cannot be greater than %d", entproto.MaxBatchCreateSize)
+		return nil, status.Errorf(codes1.InvalidArgument, "batch size cannot be greater than %d", entproto.MaxBatchCreateSize)
 	}
 	bulk := make([]*ent.PetCreate, len(requests))
 	for i, req := range requests {
@@ -262,17 +433,17 @@ func (svc *PetService) BatchCreate(ctx context.Context, req *BatchCreatePetsRequ
 	case err == nil:
 		protoList, err := toProtoPetList(res)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+			return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
 		}
 		return &BatchCreatePetsResponse{
 			Pets: protoList,
 		}, nil
 	case sqlgraph.IsUniqueConstraintError(err):
-		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+		return nil, status.Errorf(codes1.AlreadyExists, "already exists: %s", err)
 	case ent.IsConstraintError(err):
-		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+		return nil, status.Errorf(codes1.InvalidArgument, "invalid argument: %s", err)
 	default:
-		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
 	}
 
 }
@@ -282,7 +453,7 @@ func (svc *PetService) createBuilder(pet *Pet) (*ent.PetCreate, error) {
 	for _, item := range pet.GetAttachment() {
 		var attachment uuid.UUID
 		if err := (&attachment).UnmarshalBinary(item.GetId()); err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+			return nil, status.Errorf(codes1.InvalidArgument, "invalid argument: %s", err)
 		}
 		m.AddAttachmentIDs(attachment)
 	}
@@ -6,21 +6,113 @@ import (
 	entproto "entgo.io/contrib/entproto"
 	ent "entgo.io/contrib/entproto/internal/todo/ent"
 	sqlgraph "entgo.io/ent/dialect/sql/sqlgraph"
-	codes "google.golang.org/grpc/codes"
+	fmt "fmt"
+	otel "go.opentelemetry.io/otel"
+	attribute "go.opentelemetry.io/otel/attribute"
+	codes "go.opentelemetry.io/otel/codes"
+	metric "go.opentelemetry.io/otel/metric"
+	trace "go.opentelemetry.io/otel/trace"
+	codes1 "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	time "time"
 )
 
 // PonyService implements PonyServiceServer
 type PonyService struct {
-	client *ent.Client
+	client   *ent.Client
+	tracer   trace.Tracer
+	requests metric.Int64Counter
+	duration metric.Float64Histogram
+	rows     metric.Int64Histogram
 	UnimplementedPonyServiceServer
 }
 
+// PonyServiceOption configures a PonyService.
+type PonyServiceOption func(*PonyService)
+
+// WithPonyServiceTracerProvider sets the tracer provider used to create spans.
+// Defaults to the global tracer provider, which is a no-op unless registered.
+func WithPonyServiceTracerProvider(tp trace.TracerProvider) PonyServiceOption {
+	return func(svc *PonyService) {
+		svc.tracer = tp.Tracer("entgo.io/contrib/entproto")
+	}
+}
+
+// WithPonyServiceMeterProvider sets the meter provider used to record metrics.
+// Defaults to the global meter provider, which is a no-op unless registered.
+func WithPonyServiceMeterProvider(mp metric.MeterProvider) PonyServiceOption {
+	return func(svc *PonyService) {
+		svc.initMetrics(mp)
+	}
+}
+
 // NewPonyService returns a new PonyService
-func NewPonyService(client *ent.Client) *PonyService {
-	return &PonyService{
+func NewPonyService(client *ent.Client, opts ...PonyServiceOption) *PonyService {
+	svc := &PonyService{
 		client: client,
 	}
+	svc.tracer = otel.GetTracerProvider().Tracer("entgo.io/contrib/entproto")
+	svc.initMetrics(otel.GetMeterProvider())
+	for _, opt := range opts {
+		opt(svc)
+	}
+	return svc
+}
+
+// initMetrics creates the instruments used to record the metrics of the service.
+func (svc *PonyService) initMetrics(mp metric.MeterProvider) {
+	meter := mp.Meter("entgo.io/contrib/entproto")
+	var err error
+	if svc.requests, err = meter.Int64Counter("entgrpc.requests",
+		metric.WithDescription("Number of handled requests.")); err != nil {
+		otel.Handle(err)
+	}
+	if svc.duration, err = meter.Float64Histogram("entgrpc.duration",
+		metric.WithDescription("Duration of handled requests."), metric.WithUnit("s")); err != nil {
+		otel.Handle(err)
+	}
+	if svc.rows, err = meter.Int64Histogram("entgrpc.rows",
+		metric.WithDescription("Number of rows returned or affected by handled requests.")); err != nil {
+		otel.Handle(err)
+	}
+}
+
+// instrument starts a span for the given method, and returns a function that ends
+// the span and records the metrics of the method.
+func (svc *PonyService) instrument(ctx context.Context, method string) (context.Context, func(id any, rows int, err error)) {
+	start := time.Now()
+	attrs := []attribute.KeyValue{
+		attribute.String("rpc.system", "grpc"),
+		attribute.String("rpc.service", "entpb.PonyService"),
+		attribute.String("rpc.method", method),
+		attribute.String("ent.type", "Pony"),
+	}
+	ctx, span := svc.tracer.Start(ctx, "entpb.PonyService/"+method, trace.WithAttributes(attrs...))
+	return ctx, func(id any, rows int, err error) {
+		switch id := id.(type) {
+		case nil:
+		case []byte:
+			span.SetAttributes(attribute.String("ent.id", fmt.Sprintf("%x", id)))
+		default:
+			span.SetAttributes(attribute.String("ent.id", fmt.Sprint(id)))
+		}
+		span.SetAttributes(attribute.Int("ent.rows", rows))
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+		set := metric.WithAttributes(append(attrs, attribute.String("rpc.grpc.status_code", status.Code(err).String()))...)
+		if svc.requests != nil {
+			svc.requests.Add(ctx, 1, set)
+		}
+		if svc.duration != nil {
+			svc.duration.Record(ctx, time.Since(start).Seconds(), set)
+		}
+		if svc.rows != nil {
+			svc.rows.Record(ctx, int64(rows), set)
+		}
+	}
 }
 
 // toProtoPony transforms the ent type to the pb type
@@ -39,7 +131,7 @@ func toProtoPonyList(e []*ent.Pony) ([]*Pony, error) {
 	for _, entEntity := range e {
 		pbEntity, err := toProtoPony(entEntity)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+			return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
 		}
 		pbList = append(pbList, pbEntity)
 	}
@@ -48,9 +140,17 @@ func toProtoPonyList(e []*ent.Pony) ([]*Pony, error) {
 
 // BatchCreate implements PonyServiceServer.BatchCreate
 func (svc *PonyService) BatchCreate(ctx context.Context, req *BatchCreatePoniesRequest) (*BatchCreatePoniesResponse, error) {
+	ctx, end := svc.instrument(ctx, "BatchCreate")
+	res, err := svc.batchcreate(ctx, req)
+	end(nil, len(res.GetPonies()), err)
+	return res, err
+}
+
+// batchcreate implements BatchCreate without the instrumentation.
+func (svc *PonyService) batchcreate(ctx context.Context, req *BatchCreatePoniesRequest) (*BatchCreatePoniesResponse, error) {
 	requests := req.GetRequests()
 	if len(requests) > entproto.MaxBatchCreateSize {
-		return nil, status.Errorf(codes.InvalidArgument, "batch size cannot be greater than %d", entproto.MaxBatchCreateSize)
+		return nil, status.Errorf(codes1.InvalidArgument, "batch size cannot be greater than %d", entproto.MaxBatchCreateSize)
 	}
 	bulk := make([]*ent.PonyCreate, len(requests))
 	for i, req := range requests {
@@ -66,17 +166,17 @@ func (svc *PonyService) BatchCreate(ctx context.Context, req *BatchCreatePoniesR
 	case err == nil:
 		protoList, err := toProtoPonyList(res)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+			return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
 		}
 		return &BatchCreatePoniesResponse{
 			Ponies: protoList,
 		}, nil
 	case sqlgraph.IsUniqueConstraintError(err):
-		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+		return nil, status.Errorf(codes1.AlreadyExists, "already exists: %s", err)
 	case ent.IsConstraintError(err):
-		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+		return nil, status.Errorf(codes1.InvalidArgument, "invalid argument: %s", err)
 	default:
-		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
 	}
 
 }
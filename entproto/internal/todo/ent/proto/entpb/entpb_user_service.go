@@ -3,7 +3,6 @@ package entpb
 
 import (
 	context "context"
-	base64 "encoding/base64"
 	entproto "entgo.io/contrib/entproto"
 	ent "entgo.io/contrib/entproto/internal/todo/ent"
 	attachment "entgo.io/contrib/entproto/internal/todo/ent/attachment"
@@ -16,26 +15,128 @@ import (
 	errors "errors"
 	fmt "fmt"
 	uuid "github.com/google/uuid"
-	codes "google.golang.org/grpc/codes"
+	otel "go.opentelemetry.io/otel"
+	attribute "go.opentelemetry.io/otel/attribute"
+	codes "go.opentelemetry.io/otel/codes"
+	metric "go.opentelemetry.io/otel/metric"
+	trace "go.opentelemetry.io/otel/trace"
+	codes1 "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	regexp "regexp"
 	strconv "strconv"
 	strings "strings"
+	time "time"
 )
 
 // UserService implements UserServiceServer
 type UserService struct {
-	client *ent.Client
+	client     *ent.Client
+	pageTokens *runtime.PageTokenCodec
+	tracer     trace.Tracer
+	requests   metric.Int64Counter
+	duration   metric.Float64Histogram
+	rows       metric.Int64Histogram
 	UnimplementedUserServiceServer
 }
 
+// UserServiceOption configures a UserService.
+type UserServiceOption func(*UserService)
+
+// WithUserServicePageTokens sets the codec of the page tokens returned by List.
+// Defaults to unsigned tokens that never expire.
+func WithUserServicePageTokens(c *runtime.PageTokenCodec) UserServiceOption {
+	return func(svc *UserService) {
+		svc.pageTokens = c
+	}
+}
+
+// WithUserServiceTracerProvider sets the tracer provider used to create spans.
+// Defaults to the global tracer provider, which is a no-op unless registered.
+func WithUserServiceTracerProvider(tp trace.TracerProvider) UserServiceOption {
+	return func(svc *UserService) {
+		svc.tracer = tp.Tracer("entgo.io/contrib/entproto")
+	}
+}
+
+// WithUserServiceMeterProvider sets the meter provider used to record metrics.
+// Defaults to the global meter provider, which is a no-op unless registered.
+func WithUserServiceMeterProvider(mp metric.MeterProvider) UserServiceOption {
+	return func(svc *UserService) {
+		svc.initMetrics(mp)
+	}
+}
+
 // NewUserService returns a new UserService
-func NewUserService(client *ent.Client) *UserService {
-	return &UserService{
-		client: client,
+func NewUserService(client *ent.Client, opts ...UserServiceOption) *UserService {
+	svc := &UserService{
+		client:     client,
+		pageTokens: &runtime.PageTokenCodec{},
+	}
+	svc.tracer = otel.GetTracerProvider().Tracer("entgo.io/contrib/entproto")
+	svc.initMetrics(otel.GetMeterProvider())
+	for _, opt := range opts {
+		opt(svc)
+	}
+	return svc
+}
+
+// initMetrics creates the instruments used to record the metrics of the service.
+func (svc *UserService) initMetrics(mp metric.MeterProvider) {
+	meter := mp.Meter("entgo.io/contrib/entproto")
+	var err error
+	if svc.requests, err = meter.Int64Counter("entgrpc.requests",
+		metric.WithDescription("Number of handled requests.")); err != nil {
+		otel.Handle(err)
+	}
+	if svc.duration, err = meter.Float64Histogram("entgrpc.duration",
+		metric.WithDescription("Duration of handled requests."), metric.WithUnit("s")); err != nil {
+		otel.Handle(err)
+	}
+	if svc.rows, err = meter.Int64Histogram("entgrpc.rows",
+		metric.WithDescription("Number of rows returned or affected by handled requests.")); err != nil {
+		otel.Handle(err)
+	}
+}
+
+// instrument starts a span for the given method, and returns a function that ends
+// the span and records the metrics of the method.
+func (svc *UserService) instrument(ctx context.Context, method string) (context.Context, func(id any, rows int, err error)) {
+	start := time.Now()
+	attrs := []attribute.KeyValue{
+		attribute.String("rpc.system", "grpc"),
+		attribute.String("rpc.service", "entpb.UserService"),
+		attribute.String("rpc.method", method),
+		attribute.String("ent.type", "User"),
+	}
+	ctx, span := svc.tracer.Start(ctx, "entpb.UserService/"+method, trace.WithAttributes(attrs...))
+	return ctx, func(id any, rows int, err error) {
+		switch id := id.(type) {
+		case nil:
+		case []byte:
+			span.SetAttributes(attribute.String("ent.id", fmt.Sprintf("%x", id)))
+		default:
+			span.SetAttributes(attribute.String("ent.id", fmt.Sprint(id)))
+		}
+		span.SetAttributes(attribute.Int("ent.rows", rows))
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+		set := metric.WithAttributes(append(attrs, attribute.String("rpc.grpc.status_code", status.Code(err).String()))...)
+		if svc.requests != nil {
+			svc.requests.Add(ctx, 1, set)
+		}
+		if svc.duration != nil {
+			svc.duration.Record(ctx, time.Since(start).Seconds(), set)
+		}
+		if svc.rows != nil {
+			svc.rows.Record(ctx, int64(rows), set)
+		}
 	}
 }
 
@@ -240,15 +341,97 @@ func toProtoUserList(e []*ent.User) ([]*User, error) {
 	for _, entEntity := range e {
 		pbEntity, err := toProtoUser(entEntity)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+			return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
 		}
 		pbList = append(pbList, pbEntity)
 	}
 	return pbList, nil
 }
 
+// selectUserFields returns the ent fields to select for the paths of the read mask.
+// The ID and the fields that are needed to load the edges are always selected.
+func selectUserFields(mask *fieldmaskpb.FieldMask) ([]string, error) {
+	if len(mask.GetPaths()) == 0 {
+		return nil, nil
+	}
+	fields := []string{
+		user.FieldID,
+	}
+	for _, p := range mask.GetPaths() {
+		name, _, _ := strings.Cut(p, ".")
+		switch name {
+		case "account_balance":
+			fields = append(fields, user.FieldAccountBalance)
+		case "b_user_1":
+			fields = append(fields, user.FieldBUser1)
+		case "banned":
+			fields = append(fields, user.FieldBanned)
+		case "big_int":
+			fields = append(fields, user.FieldBigInt)
+		case "crm_id":
+			fields = append(fields, user.FieldCrmID)
+		case "custom_pb":
+			fields = append(fields, user.FieldCustomPb)
+		case "device_type":
+			fields = append(fields, user.FieldDeviceType)
+		case "exp":
+			fields = append(fields, user.FieldExp)
+		case "external_id":
+			fields = append(fields, user.FieldExternalID)
+		case "height_in_cm":
+			fields = append(fields, user.FieldHeightInCm)
+		case "int32s":
+			fields = append(fields, user.FieldInt32s)
+		case "int64s":
+			fields = append(fields, user.FieldInt64s)
+		case "joined":
+			fields = append(fields, user.FieldJoined)
+		case "labels":
+			fields = append(fields, user.FieldLabels)
+		case "mime_type":
+			fields = append(fields, user.FieldMimeType)
+		case "omit_prefix":
+			fields = append(fields, user.FieldOmitPrefix)
+		case "opt_bool":
+			fields = append(fields, user.FieldOptBool)
+		case "opt_num":
+			fields = append(fields, user.FieldOptNum)
+		case "opt_str":
+			fields = append(fields, user.FieldOptStr)
+		case "points":
+			fields = append(fields, user.FieldPoints)
+		case "status":
+			fields = append(fields, user.FieldStatus)
+		case "type":
+			fields = append(fields, user.FieldType)
+		case "uint32s":
+			fields = append(fields, user.FieldUint32s)
+		case "uint64s":
+			fields = append(fields, user.FieldUint64s)
+		case "user_name":
+			fields = append(fields, user.FieldUserName)
+		case "id", "attachment", "group", "pet", "received_1":
+		default:
+			return nil, fmt.Errorf("unknown read mask path %q", p)
+		}
+	}
+	return fields, nil
+}
+
 // Create implements UserServiceServer.Create
 func (svc *UserService) Create(ctx context.Context, req *CreateUserRequest) (*User, error) {
+	ctx, end := svc.instrument(ctx, "Create")
+	res, err := svc.create(ctx, req)
+	rows := 0
+	if err == nil {
+		rows = 1
+	}
+	end(res.GetId(), rows, err)
+	return res, err
+}
+
+// create implements Create without the instrumentation.
+func (svc *UserService) create(ctx context.Context, req *CreateUserRequest) (*User, error) {
 	user := req.GetUser()
 	m, err := svc.createBuilder(user)
 	if err != nil {
@@ -259,29 +442,48 @@ func (svc *UserService) Create(ctx context.Context, req *CreateUserRequest) (*Us
 	case err == nil:
 		proto, err := toProtoUser(res)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+			return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
 		}
 		return proto, nil
 	case sqlgraph.IsUniqueConstraintError(err):
-		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+		return nil, status.Errorf(codes1.AlreadyExists, "already exists: %s", err)
 	case ent.IsConstraintError(err):
-		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+		return nil, status.Errorf(codes1.InvalidArgument, "invalid argument: %s", err)
 	default:
-		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
 	}
 
 }
 
 // Get implements UserServiceServer.Get
 func (svc *UserService) Get(ctx context.Context, req *GetUserRequest) (*User, error) {
+	ctx, end := svc.instrument(ctx, "Get")
+	res, err := svc.get(ctx, req)
+	rows := 0
+	if err == nil {
+		rows = 1
+	}
+	end(req.GetId(), rows, err)
+	return res, err
+}
+
+// get implements Get without the instrumentation.
+func (svc *UserService) get(ctx context.Context, req *GetUserRequest) (*User, error) {
 	var (
 		err error
 		get *ent.User
 	)
 	id := uint32(req.GetId())
+	fields, err := selectUserFields(req.GetReadMask())
+	if err != nil {
+		return nil, status.Errorf(codes1.InvalidArgument, "invalid argument: %s", err)
+	}
 	switch req.GetView() {
 	case GetUserRequest_VIEW_UNSPECIFIED, GetUserRequest_BASIC:
-		get, err = svc.client.User.Get(ctx, id)
+		get, err = svc.client.User.Query().
+			Where(user.ID(id)).
+			Select(fields...).
+			Only(ctx)
 	case GetUserRequest_WITH_EDGE_IDS:
 		get, err = svc.client.User.Query().
 			Where(user.ID(id)).
@@ -297,23 +499,41 @@ func (svc *UserService) Get(ctx context.Context, req *GetUserRequest) (*User, er
 			WithReceived1(func(query *ent.AttachmentQuery) {
 				query.Select(attachment.FieldID)
 			}).
+			Select(fields...).
 			Only(ctx)
 	default:
-		return nil, status.Error(codes.InvalidArgument, "invalid argument: unknown view")
+		return nil, status.Error(codes1.InvalidArgument, "invalid argument: unknown view")
 	}
 	switch {
 	case err == nil:
-		return toProtoUser(get)
+		v, err := toProtoUser(get)
+		if err != nil {
+			return nil, err
+		}
+		runtime.ApplyReadMask(v, req.GetReadMask(), "id")
+		return v, nil
 	case ent.IsNotFound(err):
-		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+		return nil, status.Errorf(codes1.NotFound, "not found: %s", err)
 	default:
-		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
 	}
 
 }
 
 // Update implements UserServiceServer.Update
 func (svc *UserService) Update(ctx context.Context, req *UpdateUserRequest) (*User, error) {
+	ctx, end := svc.instrument(ctx, "Update")
+	res, err := svc.update(ctx, req)
+	rows := 0
+	if err == nil {
+		rows = 1
+	}
+	end(req.GetUser().GetId(), rows, err)
+	return res, err
+}
+
+// update implements Update without the instrumentation.
+func (svc *UserService) update(ctx context.Context, req *UpdateUserRequest) (*User, error) {
 	user := req.GetUser()
 	userID := uint32(user.GetId())
 	m := svc.client.User.UpdateOneID(userID)
@@ -328,13 +548,13 @@ func (svc *UserService) Update(ctx context.Context, req *UpdateUserRequest) (*Us
 	if user.GetBigInt() != nil {
 		userBigInt := schema.BigInt{}
 		if err := (&userBigInt).Scan(user.GetBigInt().GetValue()); err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+			return nil, status.Errorf(codes1.InvalidArgument, "invalid argument: %s", err)
 		}
 		m.SetBigInt(userBigInt)
 	}
 	var userCrmID uuid.UUID
 	if err := (&userCrmID).UnmarshalBinary(user.GetCrmId()); err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+		return nil, status.Errorf(codes1.InvalidArgument, "invalid argument: %s", err)
 	}
 	m.SetCrmID(userCrmID)
 	userCustomPb := uint8(user.GetCustomPb())
@@ -396,7 +616,7 @@ func (svc *UserService) Update(ctx context.Context, req *UpdateUserRequest) (*Us
 	if user.GetAttachment() != nil {
 		var userAttachment uuid.UUID
 		if err := (&userAttachment).UnmarshalBinary(user.GetAttachment().GetId()); err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+			return nil, status.Errorf(codes1.InvalidArgument, "invalid argument: %s", err)
 		}
 		m.SetAttachmentID(userAttachment)
 	}
@@ -411,7 +631,7 @@ func (svc *UserService) Update(ctx context.Context, req *UpdateUserRequest) (*Us
 	for _, item := range user.GetReceived_1() {
 		var received1 uuid.UUID
 		if err := (&received1).UnmarshalBinary(item.GetId()); err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+			return nil, status.Errorf(codes1.InvalidArgument, "invalid argument: %s", err)
 		}
 		m.AddReceived1IDs(received1)
 	}
@@ -421,21 +641,33 @@ func (svc *UserService) Update(ctx context.Context, req *UpdateUserRequest) (*Us
 	case err == nil:
 		proto, err := toProtoUser(res)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+			return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
 		}
 		return proto, nil
 	case sqlgraph.IsUniqueConstraintError(err):
-		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+		return nil, status.Errorf(codes1.AlreadyExists, "already exists: %s", err)
 	case ent.IsConstraintError(err):
-		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+		return nil, status.Errorf(codes1.InvalidArgument, "invalid argument: %s", err)
 	default:
-		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
 	}
 
 }
 
 // Delete implements UserServiceServer.Delete
 func (svc *UserService) Delete(ctx context.Context, req *DeleteUserRequest) (*emptypb.Empty, error) {
+	ctx, end := svc.instrument(ctx, "Delete")
+	res, err := svc.delete(ctx, req)
+	rows := 0
+	if err == nil {
+		rows = 1
+	}
+	end(req.GetId(), rows, err)
+	return res, err
+}
+
+// delete implements Delete without the instrumentation.
+func (svc *UserService) delete(ctx context.Context, req *DeleteUserRequest) (*emptypb.Empty, error) {
 	var err error
 	id := uint32(req.GetId())
 	err = svc.client.User.DeleteOneID(id).Exec(ctx)
@@ -443,15 +675,23 @@ func (svc *UserService) Delete(ctx context.Context, req *DeleteUserRequest) (*em
 	case err == nil:
 		return &emptypb.Empty{}, nil
 	case ent.IsNotFound(err):
-		return nil, status.Errorf(codes.NotFound, "not found: %s", err)
+		return nil, status.Errorf(codes1.NotFound, "not found: %s", err)
 	default:
-		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
 	}
 
 }
 
 // List implements UserServiceServer.List
 func (svc *UserService) List(ctx context.Context, req *ListUserRequest) (*ListUserResponse, error) {
+	ctx, end := svc.instrument(ctx, "List")
+	res, err := svc.list(ctx, req)
+	end(nil, len(res.GetUserList()), err)
+	return res, err
+}
+
+// list implements List without the instrumentation.
+func (svc *UserService) list(ctx context.Context, req *ListUserRequest) (*ListUserResponse, error) {
 	var (
 		err      error
 		entList  []*ent.User
@@ -460,21 +700,29 @@ func (svc *UserService) List(ctx context.Context, req *ListUserRequest) (*ListUs
 	pageSize = int(req.GetPageSize())
 	switch {
 	case pageSize < 0:
-		return nil, status.Errorf(codes.InvalidArgument, "page size cannot be less than zero")
+		return nil, status.Errorf(codes1.InvalidArgument, "page size cannot be less than zero")
 	case pageSize == 0 || pageSize > entproto.MaxPageSize:
 		pageSize = entproto.MaxPageSize
 	}
+	fields, err := selectUserFields(req.GetReadMask())
+	if err != nil {
+		return nil, status.Errorf(codes1.InvalidArgument, "invalid argument: %s", err)
+	}
 	listQuery := svc.client.User.Query().
 		Order(ent.Desc(user.FieldID)).
 		Limit(pageSize + 1)
+	filter, err := runtime.FilterHash(req, "page_size", "page_token")
+	if err != nil {
+		return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
+	}
 	if req.GetPageToken() != "" {
-		bytes, err := base64.StdEncoding.DecodeString(req.PageToken)
+		key, err := svc.pageTokens.Decode(req.GetPageToken(), filter)
 		if err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+			return nil, status.Errorf(codes1.InvalidArgument, "page token is invalid")
 		}
-		token, err := strconv.ParseInt(string(bytes), 10, 32)
+		token, err := strconv.ParseUint(key, 10, 32)
 		if err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "page token is invalid")
+			return nil, status.Errorf(codes1.InvalidArgument, "page token is invalid")
 		}
 		pageToken := uint32(token)
 		listQuery = listQuery.
@@ -482,7 +730,7 @@ func (svc *UserService) List(ctx context.Context, req *ListUserRequest) (*ListUs
 	}
 	switch req.GetView() {
 	case ListUserRequest_VIEW_UNSPECIFIED, ListUserRequest_BASIC:
-		entList, err = listQuery.All(ctx)
+		entList, err = listQuery.Select(fields...).All(ctx)
 	case ListUserRequest_WITH_EDGE_IDS:
 		entList, err = listQuery.
 			WithAttachment(func(query *ent.AttachmentQuery) {
@@ -497,35 +745,49 @@ func (svc *UserService) List(ctx context.Context, req *ListUserRequest) (*ListUs
 			WithReceived1(func(query *ent.AttachmentQuery) {
 				query.Select(attachment.FieldID)
 			}).
+			Select(fields...).
 			All(ctx)
 	}
 	switch {
 	case err == nil:
 		var nextPageToken string
 		if len(entList) == pageSize+1 {
-			nextPageToken = base64.StdEncoding.EncodeToString(
-				[]byte(fmt.Sprintf("%v", entList[len(entList)-1].ID)))
+			nextPageToken, err = svc.pageTokens.Encode(fmt.Sprint(entList[len(entList)-1].ID), filter)
+			if err != nil {
+				return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
+			}
 			entList = entList[:len(entList)-1]
 		}
 		protoList, err := toProtoUserList(entList)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+			return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
+		}
+		for _, v := range protoList {
+			runtime.ApplyReadMask(v, req.GetReadMask(), "id")
 		}
 		return &ListUserResponse{
 			UserList:      protoList,
 			NextPageToken: nextPageToken,
 		}, nil
 	default:
-		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
 	}
 
 }
 
 // BatchCreate implements UserServiceServer.BatchCreate
 func (svc *UserService) BatchCreate(ctx context.Context, req *BatchCreateUsersRequest) (*BatchCreateUsersResponse, error) {
+	ctx, end := svc.instrument(ctx, "BatchCreate")
+	res, err := svc.batchcreate(ctx, req)
+	end(nil, len(res.GetUsers()), err)
+	return res, err
+}
+
+// batchcreate implements BatchCreate without the instrumentation.
+func (svc *UserService) batchcreate(ctx context.Context, req *BatchCreateUsersRequest) (*BatchCreateUsersResponse, error) {
 	requests := req.GetRequests()
 	if len(requests) > entproto.MaxBatchCreateSize {
-		return nil, status.Errorf(codes.InvalidArgument, "batch size cannot be greater than %d", entproto.MaxBatchCreateSize)
+		return nil, status.Errorf(codes1.InvalidArgument, "batch size cannot be greater than %d", entproto.MaxBatchCreateSize)
 	}
 	bulk := make([]*ent.UserCreate, len(requests))
 	for i, req := range requests {
@@ -541,17 +803,17 @@ func (svc *UserService) BatchCreate(ctx context.Context, req *BatchCreateUsersRe
 	case err == nil:
 		protoList, err := toProtoUserList(res)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+			return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
 		}
 		return &BatchCreateUsersResponse{
 			Users: protoList,
 		}, nil
 	case sqlgraph.IsUniqueConstraintError(err):
-		return nil, status.Errorf(codes.AlreadyExists, "already exists: %s", err)
+		return nil, status.Errorf(codes1.AlreadyExists, "already exists: %s", err)
 	case ent.IsConstraintError(err):
-		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+		return nil, status.Errorf(codes1.InvalidArgument, "invalid argument: %s", err)
 	default:
-		return nil, status.Errorf(codes.Internal, "internal error: %s", err)
+		return nil, status.Errorf(codes1.Internal, "internal error: %s", err)
 	}
 
 }
@@ -569,13 +831,13 @@ func (svc *UserService) createBuilder(user *User) (*ent.UserCreate, error) {
 	if user.GetBigInt() != nil {
 		userBigInt := schema.BigInt{}
 		if err := (&userBigInt).Scan(user.GetBigInt().GetValue()); err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+			return nil, status.Errorf(codes1.InvalidArgument, "invalid argument: %s", err)
 		}
 		m.SetBigInt(userBigInt)
 	}
 	var userCrmID uuid.UUID
 	if err := (&userCrmID).UnmarshalBinary(user.GetCrmId()); err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+		return nil, status.Errorf(codes1.InvalidArgument, "invalid argument: %s", err)
 	}
 	m.SetCrmID(userCrmID)
 	userCustomPb := uint8(user.GetCustomPb())
@@ -639,7 +901,7 @@ func (svc *UserService) createBuilder(user *User) (*ent.UserCreate, error) {
 	if user.GetAttachment() != nil {
 		var userAttachment uuid.UUID
 		if err := (&userAttachment).UnmarshalBinary(user.GetAttachment().GetId()); err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+			return nil, status.Errorf(codes1.InvalidArgument, "invalid argument: %s", err)
 		}
 		m.SetAttachmentID(userAttachment)
 	}
@@ -654,7 +916,7 @@ func (svc *UserService) createBuilder(user *User) (*ent.UserCreate, error) {
 	for _, item := range user.GetReceived_1() {
 		var received1 uuid.UUID
 		if err := (&received1).UnmarshalBinary(item.GetId()); err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "invalid argument: %s", err)
+			return nil, status.Errorf(codes1.InvalidArgument, "invalid argument: %s", err)
 		}
 		m.AddReceived1IDs(received1)
 	}
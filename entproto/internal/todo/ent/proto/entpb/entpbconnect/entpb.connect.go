@@ -0,0 +1,1224 @@
+// Code generated by entproto. DO NOT EDIT.
+
+// Code generated by protoc-gen-connect-go. DO NOT EDIT.
+//
+// Source: entpb/entpb.proto
+
+package entpbconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	entpb "entgo.io/contrib/entproto/internal/todo/ent/proto/entpb"
+	errors "errors"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	http "net/http"
+	strings "strings"
+)
+
+// This is a compile-time assertion to ensure that this generated file and the connect package are
+// compatible. If you get a compiler error that this constant is not defined, this code was
+// generated with a version of connect newer than the one compiled into your binary. You can fix the
+// problem by either regenerating this code with an older version of connect or updating the connect
+// version compiled into your binary.
+const _ = connect.IsAtLeastVersion1_13_0
+
+const (
+	// AttachmentServiceName is the fully-qualified name of the AttachmentService service.
+	AttachmentServiceName = "entpb.AttachmentService"
+	// MultiWordSchemaServiceName is the fully-qualified name of the MultiWordSchemaService service.
+	MultiWordSchemaServiceName = "entpb.MultiWordSchemaService"
+	// NilExampleServiceName is the fully-qualified name of the NilExampleService service.
+	NilExampleServiceName = "entpb.NilExampleService"
+	// PetServiceName is the fully-qualified name of the PetService service.
+	PetServiceName = "entpb.PetService"
+	// PonyServiceName is the fully-qualified name of the PonyService service.
+	PonyServiceName = "entpb.PonyService"
+	// UserServiceName is the fully-qualified name of the UserService service.
+	UserServiceName = "entpb.UserService"
+)
+
+// These constants are the fully-qualified names of the RPCs defined in this package. They're
+// exposed at runtime as Spec.Procedure and as the final two segments of the HTTP route.
+//
+// Note that these are different from the fully-qualified method names used by
+// google.golang.org/protobuf/reflect/protoreflect. To convert from these constants to
+// reflection-formatted method names, remove the leading slash and convert the remaining slash to a
+// period.
+const (
+	// AttachmentServiceCreateProcedure is the fully-qualified name of the AttachmentService's Create
+	// RPC.
+	AttachmentServiceCreateProcedure = "/entpb.AttachmentService/Create"
+	// AttachmentServiceGetProcedure is the fully-qualified name of the AttachmentService's Get RPC.
+	AttachmentServiceGetProcedure = "/entpb.AttachmentService/Get"
+	// AttachmentServiceUpdateProcedure is the fully-qualified name of the AttachmentService's Update
+	// RPC.
+	AttachmentServiceUpdateProcedure = "/entpb.AttachmentService/Update"
+	// AttachmentServiceDeleteProcedure is the fully-qualified name of the AttachmentService's Delete
+	// RPC.
+	AttachmentServiceDeleteProcedure = "/entpb.AttachmentService/Delete"
+	// AttachmentServiceListProcedure is the fully-qualified name of the AttachmentService's List RPC.
+	AttachmentServiceListProcedure = "/entpb.AttachmentService/List"
+	// AttachmentServiceBatchCreateProcedure is the fully-qualified name of the AttachmentService's
+	// BatchCreate RPC.
+	AttachmentServiceBatchCreateProcedure = "/entpb.AttachmentService/BatchCreate"
+	// MultiWordSchemaServiceCreateProcedure is the fully-qualified name of the MultiWordSchemaService's
+	// Create RPC.
+	MultiWordSchemaServiceCreateProcedure = "/entpb.MultiWordSchemaService/Create"
+	// MultiWordSchemaServiceGetProcedure is the fully-qualified name of the MultiWordSchemaService's
+	// Get RPC.
+	MultiWordSchemaServiceGetProcedure = "/entpb.MultiWordSchemaService/Get"
+	// MultiWordSchemaServiceUpdateProcedure is the fully-qualified name of the MultiWordSchemaService's
+	// Update RPC.
+	MultiWordSchemaServiceUpdateProcedure = "/entpb.MultiWordSchemaService/Update"
+	// MultiWordSchemaServiceDeleteProcedure is the fully-qualified name of the MultiWordSchemaService's
+	// Delete RPC.
+	MultiWordSchemaServiceDeleteProcedure = "/entpb.MultiWordSchemaService/Delete"
+	// MultiWordSchemaServiceListProcedure is the fully-qualified name of the MultiWordSchemaService's
+	// List RPC.
+	MultiWordSchemaServiceListProcedure = "/entpb.MultiWordSchemaService/List"
+	// MultiWordSchemaServiceBatchCreateProcedure is the fully-qualified name of the
+	// MultiWordSchemaService's BatchCreate RPC.
+	MultiWordSchemaServiceBatchCreateProcedure = "/entpb.MultiWordSchemaService/BatchCreate"
+	// NilExampleServiceCreateProcedure is the fully-qualified name of the NilExampleService's Create
+	// RPC.
+	NilExampleServiceCreateProcedure = "/entpb.NilExampleService/Create"
+	// NilExampleServiceGetProcedure is the fully-qualified name of the NilExampleService's Get RPC.
+	NilExampleServiceGetProcedure = "/entpb.NilExampleService/Get"
+	// NilExampleServiceUpdateProcedure is the fully-qualified name of the NilExampleService's Update
+	// RPC.
+	NilExampleServiceUpdateProcedure = "/entpb.NilExampleService/Update"
+	// NilExampleServiceDeleteProcedure is the fully-qualified name of the NilExampleService's Delete
+	// RPC.
+	NilExampleServiceDeleteProcedure = "/entpb.NilExampleService/Delete"
+	// NilExampleServiceListProcedure is the fully-qualified name of the NilExampleService's List RPC.
+	NilExampleServiceListProcedure = "/entpb.NilExampleService/List"
+	// NilExampleServiceBatchCreateProcedure is the fully-qualified name of the NilExampleService's
+	// BatchCreate RPC.
+	NilExampleServiceBatchCreateProcedure = "/entpb.NilExampleService/BatchCreate"
+	// PetServiceCreateProcedure is the fully-qualified name of the PetService's Create RPC.
+	PetServiceCreateProcedure = "/entpb.PetService/Create"
+	// PetServiceGetProcedure is the fully-qualified name of the PetService's Get RPC.
+	PetServiceGetProcedure = "/entpb.PetService/Get"
+	// PetServiceUpdateProcedure is the fully-qualified name of the PetService's Update RPC.
+	PetServiceUpdateProcedure = "/entpb.PetService/Update"
+	// PetServiceDeleteProcedure is the fully-qualified name of the PetService's Delete RPC.
+	PetServiceDeleteProcedure = "/entpb.PetService/Delete"
+	// PetServiceListProcedure is the fully-qualified name of the PetService's List RPC.
+	PetServiceListProcedure = "/entpb.PetService/List"
+	// PetServiceBatchCreateProcedure is the fully-qualified name of the PetService's BatchCreate RPC.
+	PetServiceBatchCreateProcedure = "/entpb.PetService/BatchCreate"
+	// PonyServiceBatchCreateProcedure is the fully-qualified name of the PonyService's BatchCreate RPC.
+	PonyServiceBatchCreateProcedure = "/entpb.PonyService/BatchCreate"
+	// UserServiceCreateProcedure is the fully-qualified name of the UserService's Create RPC.
+	UserServiceCreateProcedure = "/entpb.UserService/Create"
+	// UserServiceGetProcedure is the fully-qualified name of the UserService's Get RPC.
+	UserServiceGetProcedure = "/entpb.UserService/Get"
+	// UserServiceUpdateProcedure is the fully-qualified name of the UserService's Update RPC.
+	UserServiceUpdateProcedure = "/entpb.UserService/Update"
+	// UserServiceDeleteProcedure is the fully-qualified name of the UserService's Delete RPC.
+	UserServiceDeleteProcedure = "/entpb.UserService/Delete"
+	// UserServiceListProcedure is the fully-qualified name of the UserService's List RPC.
+	UserServiceListProcedure = "/entpb.UserService/List"
+	// UserServiceBatchCreateProcedure is the fully-qualified name of the UserService's BatchCreate RPC.
+	UserServiceBatchCreateProcedure = "/entpb.UserService/BatchCreate"
+)
+
+// These variables are the protoreflect.Descriptor objects for the RPCs defined in this package.
+var (
+	attachmentServiceServiceDescriptor                = entpb.File_entpb_entpb_proto.Services().ByName("AttachmentService")
+	attachmentServiceCreateMethodDescriptor           = attachmentServiceServiceDescriptor.Methods().ByName("Create")
+	attachmentServiceGetMethodDescriptor              = attachmentServiceServiceDescriptor.Methods().ByName("Get")
+	attachmentServiceUpdateMethodDescriptor           = attachmentServiceServiceDescriptor.Methods().ByName("Update")
+	attachmentServiceDeleteMethodDescriptor           = attachmentServiceServiceDescriptor.Methods().ByName("Delete")
+	attachmentServiceListMethodDescriptor             = attachmentServiceServiceDescriptor.Methods().ByName("List")
+	attachmentServiceBatchCreateMethodDescriptor      = attachmentServiceServiceDescriptor.Methods().ByName("BatchCreate")
+	multiWordSchemaServiceServiceDescriptor           = entpb.File_entpb_entpb_proto.Services().ByName("MultiWordSchemaService")
+	multiWordSchemaServiceCreateMethodDescriptor      = multiWordSchemaServiceServiceDescriptor.Methods().ByName("Create")
+	multiWordSchemaServiceGetMethodDescriptor         = multiWordSchemaServiceServiceDescriptor.Methods().ByName("Get")
+	multiWordSchemaServiceUpdateMethodDescriptor      = multiWordSchemaServiceServiceDescriptor.Methods().ByName("Update")
+	multiWordSchemaServiceDeleteMethodDescriptor      = multiWordSchemaServiceServiceDescriptor.Methods().ByName("Delete")
+	multiWordSchemaServiceListMethodDescriptor        = multiWordSchemaServiceServiceDescriptor.Methods().ByName("List")
+	multiWordSchemaServiceBatchCreateMethodDescriptor = multiWordSchemaServiceServiceDescriptor.Methods().ByName("BatchCreate")
+	nilExampleServiceServiceDescriptor                = entpb.File_entpb_entpb_proto.Services().ByName("NilExampleService")
+	nilExampleServiceCreateMethodDescriptor           = nilExampleServiceServiceDescriptor.Methods().ByName("Create")
+	nilExampleServiceGetMethodDescriptor              = nilExampleServiceServiceDescriptor.Methods().ByName("Get")
+	nilExampleServiceUpdateMethodDescriptor           = nilExampleServiceServiceDescriptor.Methods().ByName("Update")
+	nilExampleServiceDeleteMethodDescriptor           = nilExampleServiceServiceDescriptor.Methods().ByName("Delete")
+	nilExampleServiceListMethodDescriptor             = nilExampleServiceServiceDescriptor.Methods().ByName("List")
+	nilExampleServiceBatchCreateMethodDescriptor      = nilExampleServiceServiceDescriptor.Methods().ByName("BatchCreate")
+	petServiceServiceDescriptor                       = entpb.File_entpb_entpb_proto.Services().ByName("PetService")
+	petServiceCreateMethodDescriptor                  = petServiceServiceDescriptor.Methods().ByName("Create")
+	petServiceGetMethodDescriptor                     = petServiceServiceDescriptor.Methods().ByName("Get")
+	petServiceUpdateMethodDescriptor                  = petServiceServiceDescriptor.Methods().ByName("Update")
+	petServiceDeleteMethodDescriptor                  = petServiceServiceDescriptor.Methods().ByName("Delete")
+	petServiceListMethodDescriptor                    = petServiceServiceDescriptor.Methods().ByName("List")
+	petServiceBatchCreateMethodDescriptor             = petServiceServiceDescriptor.Methods().ByName("BatchCreate")
+	ponyServiceServiceDescriptor                      = entpb.File_entpb_entpb_proto.Services().ByName("PonyService")
+	ponyServiceBatchCreateMethodDescriptor            = ponyServiceServiceDescriptor.Methods().ByName("BatchCreate")
+	userServiceServiceDescriptor                      = entpb.File_entpb_entpb_proto.Services().ByName("UserService")
+	userServiceCreateMethodDescriptor                 = userServiceServiceDescriptor.Methods().ByName("Create")
+	userServiceGetMethodDescriptor                    = userServiceServiceDescriptor.Methods().ByName("Get")
+	userServiceUpdateMethodDescriptor                 = userServiceServiceDescriptor.Methods().ByName("Update")
+	userServiceDeleteMethodDescriptor                 = userServiceServiceDescriptor.Methods().ByName("Delete")
+	userServiceListMethodDescriptor                   = userServiceServiceDescriptor.Methods().ByName("List")
+	userServiceBatchCreateMethodDescriptor            = userServiceServiceDescriptor.Methods().ByName("BatchCreate")
+)
+
+// AttachmentServiceClient is a client for the entpb.AttachmentService service.
+type AttachmentServiceClient interface {
+	Create(context.Context, *connect.Request[entpb.CreateAttachmentRequest]) (*connect.Response[entpb.Attachment], error)
+	Get(context.Context, *connect.Request[entpb.GetAttachmentRequest]) (*connect.Response[entpb.Attachment], error)
+	Update(context.Context, *connect.Request[entpb.UpdateAttachmentRequest]) (*connect.Response[entpb.Attachment], error)
+	Delete(context.Context, *connect.Request[entpb.DeleteAttachmentRequest]) (*connect.Response[emptypb.Empty], error)
+	List(context.Context, *connect.Request[entpb.ListAttachmentRequest]) (*connect.Response[entpb.ListAttachmentResponse], error)
+	BatchCreate(context.Context, *connect.Request[entpb.BatchCreateAttachmentsRequest]) (*connect.Response[entpb.BatchCreateAttachmentsResponse], error)
+}
+
+// NewAttachmentServiceClient constructs a client for the entpb.AttachmentService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewAttachmentServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) AttachmentServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &attachmentServiceClient{
+		create: connect.NewClient[entpb.CreateAttachmentRequest, entpb.Attachment](
+			httpClient,
+			baseURL+AttachmentServiceCreateProcedure,
+			connect.WithSchema(attachmentServiceCreateMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		get: connect.NewClient[entpb.GetAttachmentRequest, entpb.Attachment](
+			httpClient,
+			baseURL+AttachmentServiceGetProcedure,
+			connect.WithSchema(attachmentServiceGetMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		update: connect.NewClient[entpb.UpdateAttachmentRequest, entpb.Attachment](
+			httpClient,
+			baseURL+AttachmentServiceUpdateProcedure,
+			connect.WithSchema(attachmentServiceUpdateMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		delete: connect.NewClient[entpb.DeleteAttachmentRequest, emptypb.Empty](
+			httpClient,
+			baseURL+AttachmentServiceDeleteProcedure,
+			connect.WithSchema(attachmentServiceDeleteMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		list: connect.NewClient[entpb.ListAttachmentRequest, entpb.ListAttachmentResponse](
+			httpClient,
+			baseURL+AttachmentServiceListProcedure,
+			connect.WithSchema(attachmentServiceListMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		batchCreate: connect.NewClient[entpb.BatchCreateAttachmentsRequest, entpb.BatchCreateAttachmentsResponse](
+			httpClient,
+			baseURL+AttachmentServiceBatchCreateProcedure,
+			connect.WithSchema(attachmentServiceBatchCreateMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// attachmentServiceClient implements AttachmentServiceClient.
+type attachmentServiceClient struct {
+	create      *connect.Client[entpb.CreateAttachmentRequest, entpb.Attachment]
+	get         *connect.Client[entpb.GetAttachmentRequest, entpb.Attachment]
+	update      *connect.Client[entpb.UpdateAttachmentRequest, entpb.Attachment]
+	delete      *connect.Client[entpb.DeleteAttachmentRequest, emptypb.Empty]
+	list        *connect.Client[entpb.ListAttachmentRequest, entpb.ListAttachmentResponse]
+	batchCreate *connect.Client[entpb.BatchCreateAttachmentsRequest, entpb.BatchCreateAttachmentsResponse]
+}
+
+// Create calls entpb.AttachmentService.Create.
+func (c *attachmentServiceClient) Create(ctx context.Context, req *connect.Request[entpb.CreateAttachmentRequest]) (*connect.Response[entpb.Attachment], error) {
+	return c.create.CallUnary(ctx, req)
+}
+
+// Get calls entpb.AttachmentService.Get.
+func (c *attachmentServiceClient) Get(ctx context.Context, req *connect.Request[entpb.GetAttachmentRequest]) (*connect.Response[entpb.Attachment], error) {
+	return c.get.CallUnary(ctx, req)
+}
+
+// Update calls entpb.AttachmentService.Update.
+func (c *attachmentServiceClient) Update(ctx context.Context, req *connect.Request[entpb.UpdateAttachmentRequest]) (*connect.Response[entpb.Attachment], error) {
+	return c.update.CallUnary(ctx, req)
+}
+
+// Delete calls entpb.AttachmentService.Delete.
+func (c *attachmentServiceClient) Delete(ctx context.Context, req *connect.Request[entpb.DeleteAttachmentRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.delete.CallUnary(ctx, req)
+}
+
+// List calls entpb.AttachmentService.List.
+func (c *attachmentServiceClient) List(ctx context.Context, req *connect.Request[entpb.ListAttachmentRequest]) (*connect.Response[entpb.ListAttachmentResponse], error) {
+	return c.list.CallUnary(ctx, req)
+}
+
+// BatchCreate calls entpb.AttachmentService.BatchCreate.
+func (c *attachmentServiceClient) BatchCreate(ctx context.Context, req *connect.Request[entpb.BatchCreateAttachmentsRequest]) (*connect.Response[entpb.BatchCreateAttachmentsResponse], error) {
+	return c.batchCreate.CallUnary(ctx, req)
+}
+
+// AttachmentServiceHandler is an implementation of the entpb.AttachmentService service.
+type AttachmentServiceHandler interface {
+	Create(context.Context, *connect.Request[entpb.CreateAttachmentRequest]) (*connect.Response[entpb.Attachment], error)
+	Get(context.Context, *connect.Request[entpb.GetAttachmentRequest]) (*connect.Response[entpb.Attachment], error)
+	Update(context.Context, *connect.Request[entpb.UpdateAttachmentRequest]) (*connect.Response[entpb.Attachment], error)
+	Delete(context.Context, *connect.Request[entpb.DeleteAttachmentRequest]) (*connect.Response[emptypb.Empty], error)
+	List(context.Context, *connect.Request[entpb.ListAttachmentRequest]) (*connect.Response[entpb.ListAttachmentResponse], error)
+	BatchCreate(context.Context, *connect.Request[entpb.BatchCreateAttachmentsRequest]) (*connect.Response[entpb.BatchCreateAttachmentsResponse], error)
+}
+
+// NewAttachmentServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewAttachmentServiceHandler(svc AttachmentServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	attachmentServiceCreateHandler := connect.NewUnaryHandler(
+		AttachmentServiceCreateProcedure,
+		svc.Create,
+		connect.WithSchema(attachmentServiceCreateMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	attachmentServiceGetHandler := connect.NewUnaryHandler(
+		AttachmentServiceGetProcedure,
+		svc.Get,
+		connect.WithSchema(attachmentServiceGetMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	attachmentServiceUpdateHandler := connect.NewUnaryHandler(
+		AttachmentServiceUpdateProcedure,
+		svc.Update,
+		connect.WithSchema(attachmentServiceUpdateMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	attachmentServiceDeleteHandler := connect.NewUnaryHandler(
+		AttachmentServiceDeleteProcedure,
+		svc.Delete,
+		connect.WithSchema(attachmentServiceDeleteMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	attachmentServiceListHandler := connect.NewUnaryHandler(
+		AttachmentServiceListProcedure,
+		svc.List,
+		connect.WithSchema(attachmentServiceListMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	attachmentServiceBatchCreateHandler := connect.NewUnaryHandler(
+		AttachmentServiceBatchCreateProcedure,
+		svc.BatchCreate,
+		connect.WithSchema(attachmentServiceBatchCreateMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/entpb.AttachmentService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case AttachmentServiceCreateProcedure:
+			attachmentServiceCreateHandler.ServeHTTP(w, r)
+		case AttachmentServiceGetProcedure:
+			attachmentServiceGetHandler.ServeHTTP(w, r)
+		case AttachmentServiceUpdateProcedure:
+			attachmentServiceUpdateHandler.ServeHTTP(w, r)
+		case AttachmentServiceDeleteProcedure:
+			attachmentServiceDeleteHandler.ServeHTTP(w, r)
+		case AttachmentServiceListProcedure:
+			attachmentServiceListHandler.ServeHTTP(w, r)
+		case AttachmentServiceBatchCreateProcedure:
+			attachmentServiceBatchCreateHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedAttachmentServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedAttachmentServiceHandler struct{}
+
+func (UnimplementedAttachmentServiceHandler) Create(context.Context, *connect.Request[entpb.CreateAttachmentRequest]) (*connect.Response[entpb.Attachment], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("entpb.AttachmentService.Create is not implemented"))
+}
+
+func (UnimplementedAttachmentServiceHandler) Get(context.Context, *connect.Request[entpb.GetAttachmentRequest]) (*connect.Response[entpb.Attachment], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("entpb.AttachmentService.Get is not implemented"))
+}
+
+func (UnimplementedAttachmentServiceHandler) Update(context.Context, *connect.Request[entpb.UpdateAttachmentRequest]) (*connect.Response[entpb.Attachment], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("entpb.AttachmentService.Update is not implemented"))
+}
+
+func (UnimplementedAttachmentServiceHandler) Delete(context.Context, *connect.Request[entpb.DeleteAttachmentRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("entpb.AttachmentService.Delete is not implemented"))
+}
+
+func (UnimplementedAttachmentServiceHandler) List(context.Context, *connect.Request[entpb.ListAttachmentRequest]) (*connect.Response[entpb.ListAttachmentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("entpb.AttachmentService.List is not implemented"))
+}
+
+func (UnimplementedAttachmentServiceHandler) BatchCreate(context.Context, *connect.Request[entpb.BatchCreateAttachmentsRequest]) (*connect.Response[entpb.BatchCreateAttachmentsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("entpb.AttachmentService.BatchCreate is not implemented"))
+}
+
+// MultiWordSchemaServiceClient is a client for the entpb.MultiWordSchemaService service.
+type MultiWordSchemaServiceClient interface {
+	Create(context.Context, *connect.Request[entpb.CreateMultiWordSchemaRequest]) (*connect.Response[entpb.MultiWordSchema], error)
+	Get(context.Context, *connect.Request[entpb.GetMultiWordSchemaRequest]) (*connect.Response[entpb.MultiWordSchema], error)
+	Update(context.Context, *connect.Request[entpb.UpdateMultiWordSchemaRequest]) (*connect.Response[entpb.MultiWordSchema], error)
+	Delete(context.Context, *connect.Request[entpb.DeleteMultiWordSchemaRequest]) (*connect.Response[emptypb.Empty], error)
+	List(context.Context, *connect.Request[entpb.ListMultiWordSchemaRequest]) (*connect.Response[entpb.ListMultiWordSchemaResponse], error)
+	BatchCreate(context.Context, *connect.Request[entpb.BatchCreateMultiWordSchemasRequest]) (*connect.Response[entpb.BatchCreateMultiWordSchemasResponse], error)
+}
+
+// NewMultiWordSchemaServiceClient constructs a client for the entpb.MultiWordSchemaService service.
+// By default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped
+// responses, and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewMultiWordSchemaServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) MultiWordSchemaServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &multiWordSchemaServiceClient{
+		create: connect.NewClient[entpb.CreateMultiWordSchemaRequest, entpb.MultiWordSchema](
+			httpClient,
+			baseURL+MultiWordSchemaServiceCreateProcedure,
+			connect.WithSchema(multiWordSchemaServiceCreateMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		get: connect.NewClient[entpb.GetMultiWordSchemaRequest, entpb.MultiWordSchema](
+			httpClient,
+			baseURL+MultiWordSchemaServiceGetProcedure,
+			connect.WithSchema(multiWordSchemaServiceGetMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		update: connect.NewClient[entpb.UpdateMultiWordSchemaRequest, entpb.MultiWordSchema](
+			httpClient,
+			baseURL+MultiWordSchemaServiceUpdateProcedure,
+			connect.WithSchema(multiWordSchemaServiceUpdateMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		delete: connect.NewClient[entpb.DeleteMultiWordSchemaRequest, emptypb.Empty](
+			httpClient,
+			baseURL+MultiWordSchemaServiceDeleteProcedure,
+			connect.WithSchema(multiWordSchemaServiceDeleteMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		list: connect.NewClient[entpb.ListMultiWordSchemaRequest, entpb.ListMultiWordSchemaResponse](
+			httpClient,
+			baseURL+MultiWordSchemaServiceListProcedure,
+			connect.WithSchema(multiWordSchemaServiceListMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		batchCreate: connect.NewClient[entpb.BatchCreateMultiWordSchemasRequest, entpb.BatchCreateMultiWordSchemasResponse](
+			httpClient,
+			baseURL+MultiWordSchemaServiceBatchCreateProcedure,
+			connect.WithSchema(multiWordSchemaServiceBatchCreateMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// multiWordSchemaServiceClient implements MultiWordSchemaServiceClient.
+type multiWordSchemaServiceClient struct {
+	create      *connect.Client[entpb.CreateMultiWordSchemaRequest, entpb.MultiWordSchema]
+	get         *connect.Client[entpb.GetMultiWordSchemaRequest, entpb.MultiWordSchema]
+	update      *connect.Client[entpb.UpdateMultiWordSchemaRequest, entpb.MultiWordSchema]
+	delete      *connect.Client[entpb.DeleteMultiWordSchemaRequest, emptypb.Empty]
+	list        *connect.Client[entpb.ListMultiWordSchemaRequest, entpb.ListMultiWordSchemaResponse]
+	batchCreate *connect.Client[entpb.BatchCreateMultiWordSchemasRequest, entpb.BatchCreateMultiWordSchemasResponse]
+}
+
+// Create calls entpb.MultiWordSchemaService.Create.
+func (c *multiWordSchemaServiceClient) Create(ctx context.Context, req *connect.Request[entpb.CreateMultiWordSchemaRequest]) (*connect.Response[entpb.MultiWordSchema], error) {
+	return c.create.CallUnary(ctx, req)
+}
+
+// Get calls entpb.MultiWordSchemaService.Get.
+func (c *multiWordSchemaServiceClient) Get(ctx context.Context, req *connect.Request[entpb.GetMultiWordSchemaRequest]) (*connect.Response[entpb.MultiWordSchema], error) {
+	return c.get.CallUnary(ctx, req)
+}
+
+// Update calls entpb.MultiWordSchemaService.Update.
+func (c *multiWordSchemaServiceClient) Update(ctx context.Context, req *connect.Request[entpb.UpdateMultiWordSchemaRequest]) (*connect.Response[entpb.MultiWordSchema], error) {
+	return c.update.CallUnary(ctx, req)
+}
+
+// Delete calls entpb.MultiWordSchemaService.Delete.
+func (c *multiWordSchemaServiceClient) Delete(ctx context.Context, req *connect.Request[entpb.DeleteMultiWordSchemaRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.delete.CallUnary(ctx, req)
+}
+
+// List calls entpb.MultiWordSchemaService.List.
+func (c *multiWordSchemaServiceClient) List(ctx context.Context, req *connect.Request[entpb.ListMultiWordSchemaRequest]) (*connect.Response[entpb.ListMultiWordSchemaResponse], error) {
+	return c.list.CallUnary(ctx, req)
+}
+
+// BatchCreate calls entpb.MultiWordSchemaService.BatchCreate.
+func (c *multiWordSchemaServiceClient) BatchCreate(ctx context.Context, req *connect.Request[entpb.BatchCreateMultiWordSchemasRequest]) (*connect.Response[entpb.BatchCreateMultiWordSchemasResponse], error) {
+	return c.batchCreate.CallUnary(ctx, req)
+}
+
+// MultiWordSchemaServiceHandler is an implementation of the entpb.MultiWordSchemaService service.
+type MultiWordSchemaServiceHandler interface {
+	Create(context.Context, *connect.Request[entpb.CreateMultiWordSchemaRequest]) (*connect.Response[entpb.MultiWordSchema], error)
+	Get(context.Context, *connect.Request[entpb.GetMultiWordSchemaRequest]) (*connect.Response[entpb.MultiWordSchema], error)
+	Update(context.Context, *connect.Request[entpb.UpdateMultiWordSchemaRequest]) (*connect.Response[entpb.MultiWordSchema], error)
+	Delete(context.Context, *connect.Request[entpb.DeleteMultiWordSchemaRequest]) (*connect.Response[emptypb.Empty], error)
+	List(context.Context, *connect.Request[entpb.ListMultiWordSchemaRequest]) (*connect.Response[entpb.ListMultiWordSchemaResponse], error)
+	BatchCreate(context.Context, *connect.Request[entpb.BatchCreateMultiWordSchemasRequest]) (*connect.Response[entpb.BatchCreateMultiWordSchemasResponse], error)
+}
+
+// NewMultiWordSchemaServiceHandler builds an HTTP handler from the service implementation. It
+// returns the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewMultiWordSchemaServiceHandler(svc MultiWordSchemaServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	multiWordSchemaServiceCreateHandler := connect.NewUnaryHandler(
+		MultiWordSchemaServiceCreateProcedure,
+		svc.Create,
+		connect.WithSchema(multiWordSchemaServiceCreateMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	multiWordSchemaServiceGetHandler := connect.NewUnaryHandler(
+		MultiWordSchemaServiceGetProcedure,
+		svc.Get,
+		connect.WithSchema(multiWordSchemaServiceGetMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	multiWordSchemaServiceUpdateHandler := connect.NewUnaryHandler(
+		MultiWordSchemaServiceUpdateProcedure,
+		svc.Update,
+		connect.WithSchema(multiWordSchemaServiceUpdateMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	multiWordSchemaServiceDeleteHandler := connect.NewUnaryHandler(
+		MultiWordSchemaServiceDeleteProcedure,
+		svc.Delete,
+		connect.WithSchema(multiWordSchemaServiceDeleteMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	multiWordSchemaServiceListHandler := connect.NewUnaryHandler(
+		MultiWordSchemaServiceListProcedure,
+		svc.List,
+		connect.WithSchema(multiWordSchemaServiceListMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	multiWordSchemaServiceBatchCreateHandler := connect.NewUnaryHandler(
+		MultiWordSchemaServiceBatchCreateProcedure,
+		svc.BatchCreate,
+		connect.WithSchema(multiWordSchemaServiceBatchCreateMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/entpb.MultiWordSchemaService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case MultiWordSchemaServiceCreateProcedure:
+			multiWordSchemaServiceCreateHandler.ServeHTTP(w, r)
+		case MultiWordSchemaServiceGetProcedure:
+			multiWordSchemaServiceGetHandler.ServeHTTP(w, r)
+		case MultiWordSchemaServiceUpdateProcedure:
+			multiWordSchemaServiceUpdateHandler.ServeHTTP(w, r)
+		case MultiWordSchemaServiceDeleteProcedure:
+			multiWordSchemaServiceDeleteHandler.ServeHTTP(w, r)
+		case MultiWordSchemaServiceListProcedure:
+			multiWordSchemaServiceListHandler.ServeHTTP(w, r)
+		case MultiWordSchemaServiceBatchCreateProcedure:
+			multiWordSchemaServiceBatchCreateHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedMultiWordSchemaServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedMultiWordSchemaServiceHandler struct{}
+
+func (UnimplementedMultiWordSchemaServiceHandler) Create(context.Context, *connect.Request[entpb.CreateMultiWordSchemaRequest]) (*connect.Response[entpb.MultiWordSchema], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("entpb.MultiWordSchemaService.Create is not implemented"))
+}
+
+func (UnimplementedMultiWordSchemaServiceHandler) Get(context.Context, *connect.Request[entpb.GetMultiWordSchemaRequest]) (*connect.Response[entpb.MultiWordSchema], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("entpb.MultiWordSchemaService.Get is not implemented"))
+}
+
+func (UnimplementedMultiWordSchemaServiceHandler) Update(context.Context, *connect.Request[entpb.UpdateMultiWordSchemaRequest]) (*connect.Response[entpb.MultiWordSchema], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("entpb.MultiWordSchemaService.Update is not implemented"))
+}
+
+func (UnimplementedMultiWordSchemaServiceHandler) Delete(context.Context, *connect.Request[entpb.DeleteMultiWordSchemaRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("entpb.MultiWordSchemaService.Delete is not implemented"))
+}
+
+func (UnimplementedMultiWordSchemaServiceHandler) List(context.Context, *connect.Request[entpb.ListMultiWordSchemaRequest]) (*connect.Response[entpb.ListMultiWordSchemaResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("entpb.MultiWordSchemaService.List is not implemented"))
+}
+
+func (UnimplementedMultiWordSchemaServiceHandler) BatchCreate(context.Context, *connect.Request[entpb.BatchCreateMultiWordSchemasRequest]) (*connect.Response[entpb.BatchCreateMultiWordSchemasResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("entpb.MultiWordSchemaService.BatchCreate is not implemented"))
+}
+
+// NilExampleServiceClient is a client for the entpb.NilExampleService service.
+type NilExampleServiceClient interface {
+	Create(context.Context, *connect.Request[entpb.CreateNilExampleRequest]) (*connect.Response[entpb.NilExample], error)
+	Get(context.Context, *connect.Request[entpb.GetNilExampleRequest]) (*connect.Response[entpb.NilExample], error)
+	Update(context.Context, *connect.Request[entpb.UpdateNilExampleRequest]) (*connect.Response[entpb.NilExample], error)
+	Delete(context.Context, *connect.Request[entpb.DeleteNilExampleRequest]) (*connect.Response[emptypb.Empty], error)
+	List(context.Context, *connect.Request[entpb.ListNilExampleRequest]) (*connect.Response[entpb.ListNilExampleResponse], error)
+	BatchCreate(context.Context, *connect.Request[entpb.BatchCreateNilExamplesRequest]) (*connect.Response[entpb.BatchCreateNilExamplesResponse], error)
+}
+
+// NewNilExampleServiceClient constructs a client for the entpb.NilExampleService service. By
+// default, it uses the Connect protocol with the binary Protobuf Codec, asks for gzipped responses,
+// and sends uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the
+// connect.WithGRPC() or connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewNilExampleServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) NilExampleServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &nilExampleServiceClient{
+		create: connect.NewClient[entpb.CreateNilExampleRequest, entpb.NilExample](
+			httpClient,
+			baseURL+NilExampleServiceCreateProcedure,
+			connect.WithSchema(nilExampleServiceCreateMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		get: connect.NewClient[entpb.GetNilExampleRequest, entpb.NilExample](
+			httpClient,
+			baseURL+NilExampleServiceGetProcedure,
+			connect.WithSchema(nilExampleServiceGetMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		update: connect.NewClient[entpb.UpdateNilExampleRequest, entpb.NilExample](
+			httpClient,
+			baseURL+NilExampleServiceUpdateProcedure,
+			connect.WithSchema(nilExampleServiceUpdateMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		delete: connect.NewClient[entpb.DeleteNilExampleRequest, emptypb.Empty](
+			httpClient,
+			baseURL+NilExampleServiceDeleteProcedure,
+			connect.WithSchema(nilExampleServiceDeleteMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		list: connect.NewClient[entpb.ListNilExampleRequest, entpb.ListNilExampleResponse](
+			httpClient,
+			baseURL+NilExampleServiceListProcedure,
+			connect.WithSchema(nilExampleServiceListMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		batchCreate: connect.NewClient[entpb.BatchCreateNilExamplesRequest, entpb.BatchCreateNilExamplesResponse](
+			httpClient,
+			baseURL+NilExampleServiceBatchCreateProcedure,
+			connect.WithSchema(nilExampleServiceBatchCreateMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// nilExampleServiceClient implements NilExampleServiceClient.
+type nilExampleServiceClient struct {
+	create      *connect.Client[entpb.CreateNilExampleRequest, entpb.NilExample]
+	get         *connect.Client[entpb.GetNilExampleRequest, entpb.NilExample]
+	update      *connect.Client[entpb.UpdateNilExampleRequest, entpb.NilExample]
+	delete      *connect.Client[entpb.DeleteNilExampleRequest, emptypb.Empty]
+	list        *connect.Client[entpb.ListNilExampleRequest, entpb.ListNilExampleResponse]
+	batchCreate *connect.Client[entpb.BatchCreateNilExamplesRequest, entpb.BatchCreateNilExamplesResponse]
+}
+
+// Create calls entpb.NilExampleService.Create.
+func (c *nilExampleServiceClient) Create(ctx context.Context, req *connect.Request[entpb.CreateNilExampleRequest]) (*connect.Response[entpb.NilExample], error) {
+	return c.create.CallUnary(ctx, req)
+}
+
+// Get calls entpb.NilExampleService.Get.
+func (c *nilExampleServiceClient) Get(ctx context.Context, req *connect.Request[entpb.GetNilExampleRequest]) (*connect.Response[entpb.NilExample], error) {
+	return c.get.CallUnary(ctx, req)
+}
+
+// Update calls entpb.NilExampleService.Update.
+func (c *nilExampleServiceClient) Update(ctx context.Context, req *connect.Request[entpb.UpdateNilExampleRequest]) (*connect.Response[entpb.NilExample], error) {
+	return c.update.CallUnary(ctx, req)
+}
+
+// Delete calls entpb.NilExampleService.Delete.
+func (c *nilExampleServiceClient) Delete(ctx context.Context, req *connect.Request[entpb.DeleteNilExampleRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.delete.CallUnary(ctx, req)
+}
+
+// List calls entpb.NilExampleService.List.
+func (c *nilExampleServiceClient) List(ctx context.Context, req *connect.Request[entpb.ListNilExampleRequest]) (*connect.Response[entpb.ListNilExampleResponse], error) {
+	return c.list.CallUnary(ctx, req)
+}
+
+// BatchCreate calls entpb.NilExampleService.BatchCreate.
+func (c *nilExampleServiceClient) BatchCreate(ctx context.Context, req *connect.Request[entpb.BatchCreateNilExamplesRequest]) (*connect.Response[entpb.BatchCreateNilExamplesResponse], error) {
+	return c.batchCreate.CallUnary(ctx, req)
+}
+
+// NilExampleServiceHandler is an implementation of the entpb.NilExampleService service.
+type NilExampleServiceHandler interface {
+	Create(context.Context, *connect.Request[entpb.CreateNilExampleRequest]) (*connect.Response[entpb.NilExample], error)
+	Get(context.Context, *connect.Request[entpb.GetNilExampleRequest]) (*connect.Response[entpb.NilExample], error)
+	Update(context.Context, *connect.Request[entpb.UpdateNilExampleRequest]) (*connect.Response[entpb.NilExample], error)
+	Delete(context.Context, *connect.Request[entpb.DeleteNilExampleRequest]) (*connect.Response[emptypb.Empty], error)
+	List(context.Context, *connect.Request[entpb.ListNilExampleRequest]) (*connect.Response[entpb.ListNilExampleResponse], error)
+	BatchCreate(context.Context, *connect.Request[entpb.BatchCreateNilExamplesRequest]) (*connect.Response[entpb.BatchCreateNilExamplesResponse], error)
+}
+
+// NewNilExampleServiceHandler builds an HTTP handler from the service implementation. It returns
+// the path on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewNilExampleServiceHandler(svc NilExampleServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	nilExampleServiceCreateHandler := connect.NewUnaryHandler(
+		NilExampleServiceCreateProcedure,
+		svc.Create,
+		connect.WithSchema(nilExampleServiceCreateMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	nilExampleServiceGetHandler := connect.NewUnaryHandler(
+		NilExampleServiceGetProcedure,
+		svc.Get,
+		connect.WithSchema(nilExampleServiceGetMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	nilExampleServiceUpdateHandler := connect.NewUnaryHandler(
+		NilExampleServiceUpdateProcedure,
+		svc.Update,
+		connect.WithSchema(nilExampleServiceUpdateMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	nilExampleServiceDeleteHandler := connect.NewUnaryHandler(
+		NilExampleServiceDeleteProcedure,
+		svc.Delete,
+		connect.WithSchema(nilExampleServiceDeleteMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	nilExampleServiceListHandler := connect.NewUnaryHandler(
+		NilExampleServiceListProcedure,
+		svc.List,
+		connect.WithSchema(nilExampleServiceListMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	nilExampleServiceBatchCreateHandler := connect.NewUnaryHandler(
+		NilExampleServiceBatchCreateProcedure,
+		svc.BatchCreate,
+		connect.WithSchema(nilExampleServiceBatchCreateMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/entpb.NilExampleService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case NilExampleServiceCreateProcedure:
+			nilExampleServiceCreateHandler.ServeHTTP(w, r)
+		case NilExampleServiceGetProcedure:
+			nilExampleServiceGetHandler.ServeHTTP(w, r)
+		case NilExampleServiceUpdateProcedure:
+			nilExampleServiceUpdateHandler.ServeHTTP(w, r)
+		case NilExampleServiceDeleteProcedure:
+			nilExampleServiceDeleteHandler.ServeHTTP(w, r)
+		case NilExampleServiceListProcedure:
+			nilExampleServiceListHandler.ServeHTTP(w, r)
+		case NilExampleServiceBatchCreateProcedure:
+			nilExampleServiceBatchCreateHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedNilExampleServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedNilExampleServiceHandler struct{}
+
+func (UnimplementedNilExampleServiceHandler) Create(context.Context, *connect.Request[entpb.CreateNilExampleRequest]) (*connect.Response[entpb.NilExample], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("entpb.NilExampleService.Create is not implemented"))
+}
+
+func (UnimplementedNilExampleServiceHandler) Get(context.Context, *connect.Request[entpb.GetNilExampleRequest]) (*connect.Response[entpb.NilExample], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("entpb.NilExampleService.Get is not implemented"))
+}
+
+func (UnimplementedNilExampleServiceHandler) Update(context.Context, *connect.Request[entpb.UpdateNilExampleRequest]) (*connect.Response[entpb.NilExample], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("entpb.NilExampleService.Update is not implemented"))
+}
+
+func (UnimplementedNilExampleServiceHandler) Delete(context.Context, *connect.Request[entpb.DeleteNilExampleRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("entpb.NilExampleService.Delete is not implemented"))
+}
+
+func (UnimplementedNilExampleServiceHandler) List(context.Context, *connect.Request[entpb.ListNilExampleRequest]) (*connect.Response[entpb.ListNilExampleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("entpb.NilExampleService.List is not implemented"))
+}
+
+func (UnimplementedNilExampleServiceHandler) BatchCreate(context.Context, *connect.Request[entpb.BatchCreateNilExamplesRequest]) (*connect.Response[entpb.BatchCreateNilExamplesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("entpb.NilExampleService.BatchCreate is not implemented"))
+}
+
+// PetServiceClient is a client for the entpb.PetService service.
+type PetServiceClient interface {
+	Create(context.Context, *connect.Request[entpb.CreatePetRequest]) (*connect.Response[entpb.Pet], error)
+	Get(context.Context, *connect.Request[entpb.GetPetRequest]) (*connect.Response[entpb.Pet], error)
+	Update(context.Context, *connect.Request[entpb.UpdatePetRequest]) (*connect.Response[entpb.Pet], error)
+	Delete(context.Context, *connect.Request[entpb.DeletePetRequest]) (*connect.Response[emptypb.Empty], error)
+	List(context.Context, *connect.Request[entpb.ListPetRequest]) (*connect.Response[entpb.ListPetResponse], error)
+	BatchCreate(context.Context, *connect.Request[entpb.BatchCreatePetsRequest]) (*connect.Response[entpb.BatchCreatePetsResponse], error)
+}
+
+// NewPetServiceClient constructs a client for the entpb.PetService service. By default, it uses the
+// Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewPetServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) PetServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &petServiceClient{
+		create: connect.NewClient[entpb.CreatePetRequest, entpb.Pet](
+			httpClient,
+			baseURL+PetServiceCreateProcedure,
+			connect.WithSchema(petServiceCreateMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		get: connect.NewClient[entpb.GetPetRequest, entpb.Pet](
+			httpClient,
+			baseURL+PetServiceGetProcedure,
+			connect.WithSchema(petServiceGetMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		update: connect.NewClient[entpb.UpdatePetRequest, entpb.Pet](
+			httpClient,
+			baseURL+PetServiceUpdateProcedure,
+			connect.WithSchema(petServiceUpdateMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		delete: connect.NewClient[entpb.DeletePetRequest, emptypb.Empty](
+			httpClient,
+			baseURL+PetServiceDeleteProcedure,
+			connect.WithSchema(petServiceDeleteMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		list: connect.NewClient[entpb.ListPetRequest, entpb.ListPetResponse](
+			httpClient,
+			baseURL+PetServiceListProcedure,
+			connect.WithSchema(petServiceListMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		batchCreate: connect.NewClient[entpb.BatchCreatePetsRequest, entpb.BatchCreatePetsResponse](
+			httpClient,
+			baseURL+PetServiceBatchCreateProcedure,
+			connect.WithSchema(petServiceBatchCreateMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// petServiceClient implements PetServiceClient.
+type petServiceClient struct {
+	create      *connect.Client[entpb.CreatePetRequest, entpb.Pet]
+	get         *connect.Client[entpb.GetPetRequest, entpb.Pet]
+	update      *connect.Client[entpb.UpdatePetRequest, entpb.Pet]
+	delete      *connect.Client[entpb.DeletePetRequest, emptypb.Empty]
+	list        *connect.Client[entpb.ListPetRequest, entpb.ListPetResponse]
+	batchCreate *connect.Client[entpb.BatchCreatePetsRequest, entpb.BatchCreatePetsResponse]
+}
+
+// Create calls entpb.PetService.Create.
+func (c *petServiceClient) Create(ctx context.Context, req *connect.Request[entpb.CreatePetRequest]) (*connect.Response[entpb.Pet], error) {
+	return c.create.CallUnary(ctx, req)
+}
+
+// Get calls entpb.PetService.Get.
+func (c *petServiceClient) Get(ctx context.Context, req *connect.Request[entpb.GetPetRequest]) (*connect.Response[entpb.Pet], error) {
+	return c.get.CallUnary(ctx, req)
+}
+
+// Update calls entpb.PetService.Update.
+func (c *petServiceClient) Update(ctx context.Context, req *connect.Request[entpb.UpdatePetRequest]) (*connect.Response[entpb.Pet], error) {
+	return c.update.CallUnary(ctx, req)
+}
+
+// Delete calls entpb.PetService.Delete.
+func (c *petServiceClient) Delete(ctx context.Context, req *connect.Request[entpb.DeletePetRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.delete.CallUnary(ctx, req)
+}
+
+// List calls entpb.PetService.List.
+func (c *petServiceClient) List(ctx context.Context, req *connect.Request[entpb.ListPetRequest]) (*connect.Response[entpb.ListPetResponse], error) {
+	return c.list.CallUnary(ctx, req)
+}
+
+// BatchCreate calls entpb.PetService.BatchCreate.
+func (c *petServiceClient) BatchCreate(ctx context.Context, req *connect.Request[entpb.BatchCreatePetsRequest]) (*connect.Response[entpb.BatchCreatePetsResponse], error) {
+	return c.batchCreate.CallUnary(ctx, req)
+}
+
+// PetServiceHandler is an implementation of the entpb.PetService service.
+type PetServiceHandler interface {
+	Create(context.Context, *connect.Request[entpb.CreatePetRequest]) (*connect.Response[entpb.Pet], error)
+	Get(context.Context, *connect.Request[entpb.GetPetRequest]) (*connect.Response[entpb.Pet], error)
+	Update(context.Context, *connect.Request[entpb.UpdatePetRequest]) (*connect.Response[entpb.Pet], error)
+	Delete(context.Context, *connect.Request[entpb.DeletePetRequest]) (*connect.Response[emptypb.Empty], error)
+	List(context.Context, *connect.Request[entpb.ListPetRequest]) (*connect.Response[entpb.ListPetResponse], error)
+	BatchCreate(context.Context, *connect.Request[entpb.BatchCreatePetsRequest]) (*connect.Response[entpb.BatchCreatePetsResponse], error)
+}
+
+// NewPetServiceHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewPetServiceHandler(svc PetServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	petServiceCreateHandler := connect.NewUnaryHandler(
+		PetServiceCreateProcedure,
+		svc.Create,
+		connect.WithSchema(petServiceCreateMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	petServiceGetHandler := connect.NewUnaryHandler(
+		PetServiceGetProcedure,
+		svc.Get,
+		connect.WithSchema(petServiceGetMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	petServiceUpdateHandler := connect.NewUnaryHandler(
+		PetServiceUpdateProcedure,
+		svc.Update,
+		connect.WithSchema(petServiceUpdateMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	petServiceDeleteHandler := connect.NewUnaryHandler(
+		PetServiceDeleteProcedure,
+		svc.Delete,
+		connect.WithSchema(petServiceDeleteMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	petServiceListHandler := connect.NewUnaryHandler(
+		PetServiceListProcedure,
+		svc.List,
+		connect.WithSchema(petServiceListMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	petServiceBatchCreateHandler := connect.NewUnaryHandler(
+		PetServiceBatchCreateProcedure,
+		svc.BatchCreate,
+		connect.WithSchema(petServiceBatchCreateMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/entpb.PetService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case PetServiceCreateProcedure:
+			petServiceCreateHandler.ServeHTTP(w, r)
+		case PetServiceGetProcedure:
+			petServiceGetHandler.ServeHTTP(w, r)
+		case PetServiceUpdateProcedure:
+			petServiceUpdateHandler.ServeHTTP(w, r)
+		case PetServiceDeleteProcedure:
+			petServiceDeleteHandler.ServeHTTP(w, r)
+		case PetServiceListProcedure:
+			petServiceListHandler.ServeHTTP(w, r)
+		case PetServiceBatchCreateProcedure:
+			petServiceBatchCreateHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedPetServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedPetServiceHandler struct{}
+
+func (UnimplementedPetServiceHandler) Create(context.Context, *connect.Request[entpb.CreatePetRequest]) (*connect.Response[entpb.Pet], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("entpb.PetService.Create is not implemented"))
+}
+
+func (UnimplementedPetServiceHandler) Get(context.Context, *connect.Request[entpb.GetPetRequest]) (*connect.Response[entpb.Pet], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("entpb.PetService.Get is not implemented"))
+}
+
+func (UnimplementedPetServiceHandler) Update(context.Context, *connect.Request[entpb.UpdatePetRequest]) (*connect.Response[entpb.Pet], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("entpb.PetService.Update is not implemented"))
+}
+
+func (UnimplementedPetServiceHandler) Delete(context.Context, *connect.Request[entpb.DeletePetRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("entpb.PetService.Delete is not implemented"))
+}
+
+func (UnimplementedPetServiceHandler) List(context.Context, *connect.Request[entpb.ListPetRequest]) (*connect.Response[entpb.ListPetResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("entpb.PetService.List is not implemented"))
+}
+
+func (UnimplementedPetServiceHandler) BatchCreate(context.Context, *connect.Request[entpb.BatchCreatePetsRequest]) (*connect.Response[entpb.BatchCreatePetsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("entpb.PetService.BatchCreate is not implemented"))
+}
+
+// PonyServiceClient is a client for the entpb.PonyService service.
+type PonyServiceClient interface {
+	BatchCreate(context.Context, *connect.Request[entpb.BatchCreatePoniesRequest]) (*connect.Response[entpb.BatchCreatePoniesResponse], error)
+}
+
+// NewPonyServiceClient constructs a client for the entpb.PonyService service. By default, it uses
+// the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewPonyServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) PonyServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &ponyServiceClient{
+		batchCreate: connect.NewClient[entpb.BatchCreatePoniesRequest, entpb.BatchCreatePoniesResponse](
+			httpClient,
+			baseURL+PonyServiceBatchCreateProcedure,
+			connect.WithSchema(ponyServiceBatchCreateMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// ponyServiceClient implements PonyServiceClient.
+type ponyServiceClient struct {
+	batchCreate *connect.Client[entpb.BatchCreatePoniesRequest, entpb.BatchCreatePoniesResponse]
+}
+
+// BatchCreate calls entpb.PonyService.BatchCreate.
+func (c *ponyServiceClient) BatchCreate(ctx context.Context, req *connect.Request[entpb.BatchCreatePoniesRequest]) (*connect.Response[entpb.BatchCreatePoniesResponse], error) {
+	return c.batchCreate.CallUnary(ctx, req)
+}
+
+// PonyServiceHandler is an implementation of the entpb.PonyService service.
+type PonyServiceHandler interface {
+	BatchCreate(context.Context, *connect.Request[entpb.BatchCreatePoniesRequest]) (*connect.Response[entpb.BatchCreatePoniesResponse], error)
+}
+
+// NewPonyServiceHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewPonyServiceHandler(svc PonyServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	ponyServiceBatchCreateHandler := connect.NewUnaryHandler(
+		PonyServiceBatchCreateProcedure,
+		svc.BatchCreate,
+		connect.WithSchema(ponyServiceBatchCreateMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/entpb.PonyService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case PonyServiceBatchCreateProcedure:
+			ponyServiceBatchCreateHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedPonyServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedPonyServiceHandler struct{}
+
+func (UnimplementedPonyServiceHandler) BatchCreate(context.Context, *connect.Request[entpb.BatchCreatePoniesRequest]) (*connect.Response[entpb.BatchCreatePoniesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("entpb.PonyService.BatchCreate is not implemented"))
+}
+
+// UserServiceClient is a client for the entpb.UserService service.
+type UserServiceClient interface {
+	Create(context.Context, *connect.Request[entpb.CreateUserRequest]) (*connect.Response[entpb.User], error)
+	Get(context.Context, *connect.Request[entpb.GetUserRequest]) (*connect.Response[entpb.User], error)
+	Update(context.Context, *connect.Request[entpb.UpdateUserRequest]) (*connect.Response[entpb.User], error)
+	Delete(context.Context, *connect.Request[entpb.DeleteUserRequest]) (*connect.Response[emptypb.Empty], error)
+	List(context.Context, *connect.Request[entpb.ListUserRequest]) (*connect.Response[entpb.ListUserResponse], error)
+	BatchCreate(context.Context, *connect.Request[entpb.BatchCreateUsersRequest]) (*connect.Response[entpb.BatchCreateUsersResponse], error)
+}
+
+// NewUserServiceClient constructs a client for the entpb.UserService service. By default, it uses
+// the Connect protocol with the binary Protobuf Codec, asks for gzipped responses, and sends
+// uncompressed requests. To use the gRPC or gRPC-Web protocols, supply the connect.WithGRPC() or
+// connect.WithGRPCWeb() options.
+//
+// The URL supplied here should be the base URL for the Connect or gRPC server (for example,
+// http://api.acme.com or https://acme.com/grpc).
+func NewUserServiceClient(httpClient connect.HTTPClient, baseURL string, opts ...connect.ClientOption) UserServiceClient {
+	baseURL = strings.TrimRight(baseURL, "/")
+	return &userServiceClient{
+		create: connect.NewClient[entpb.CreateUserRequest, entpb.User](
+			httpClient,
+			baseURL+UserServiceCreateProcedure,
+			connect.WithSchema(userServiceCreateMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		get: connect.NewClient[entpb.GetUserRequest, entpb.User](
+			httpClient,
+			baseURL+UserServiceGetProcedure,
+			connect.WithSchema(userServiceGetMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		update: connect.NewClient[entpb.UpdateUserRequest, entpb.User](
+			httpClient,
+			baseURL+UserServiceUpdateProcedure,
+			connect.WithSchema(userServiceUpdateMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		delete: connect.NewClient[entpb.DeleteUserRequest, emptypb.Empty](
+			httpClient,
+			baseURL+UserServiceDeleteProcedure,
+			connect.WithSchema(userServiceDeleteMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		list: connect.NewClient[entpb.ListUserRequest, entpb.ListUserResponse](
+			httpClient,
+			baseURL+UserServiceListProcedure,
+			connect.WithSchema(userServiceListMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		batchCreate: connect.NewClient[entpb.BatchCreateUsersRequest, entpb.BatchCreateUsersResponse](
+			httpClient,
+			baseURL+UserServiceBatchCreateProcedure,
+			connect.WithSchema(userServiceBatchCreateMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// userServiceClient implements UserServiceClient.
+type userServiceClient struct {
+	create      *connect.Client[entpb.CreateUserRequest, entpb.User]
+	get         *connect.Client[entpb.GetUserRequest, entpb.User]
+	update      *connect.Client[entpb.UpdateUserRequest, entpb.User]
+	delete      *connect.Client[entpb.DeleteUserRequest, emptypb.Empty]
+	list        *connect.Client[entpb.ListUserRequest, entpb.ListUserResponse]
+	batchCreate *connect.Client[entpb.BatchCreateUsersRequest, entpb.BatchCreateUsersResponse]
+}
+
+// Create calls entpb.UserService.Create.
+func (c *userServiceClient) Create(ctx context.Context, req *connect.Request[entpb.CreateUserRequest]) (*connect.Response[entpb.User], error) {
+	return c.create.CallUnary(ctx, req)
+}
+
+// Get calls entpb.UserService.Get.
+func (c *userServiceClient) Get(ctx context.Context, req *connect.Request[entpb.GetUserRequest]) (*connect.Response[entpb.User], error) {
+	return c.get.CallUnary(ctx, req)
+}
+
+// Update calls entpb.UserService.Update.
+func (c *userServiceClient) Update(ctx context.Context, req *connect.Request[entpb.UpdateUserRequest]) (*connect.Response[entpb.User], error) {
+	return c.update.CallUnary(ctx, req)
+}
+
+// Delete calls entpb.UserService.Delete.
+func (c *userServiceClient) Delete(ctx context.Context, req *connect.Request[entpb.DeleteUserRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.delete.CallUnary(ctx, req)
+}
+
+// List calls entpb.UserService.List.
+func (c *userServiceClient) List(ctx context.Context, req *connect.Request[entpb.ListUserRequest]) (*connect.Response[entpb.ListUserResponse], error) {
+	return c.list.CallUnary(ctx, req)
+}
+
+// BatchCreate calls entpb.UserService.BatchCreate.
+func (c *userServiceClient) BatchCreate(ctx context.Context, req *connect.Request[entpb.BatchCreateUsersRequest]) (*connect.Response[entpb.BatchCreateUsersResponse], error) {
+	return c.batchCreate.CallUnary(ctx, req)
+}
+
+// UserServiceHandler is an implementation of the entpb.UserService service.
+type UserServiceHandler interface {
+	Create(context.Context, *connect.Request[entpb.CreateUserRequest]) (*connect.Response[entpb.User], error)
+	Get(context.Context, *connect.Request[entpb.GetUserRequest]) (*connect.Response[entpb.User], error)
+	Update(context.Context, *connect.Request[entpb.UpdateUserRequest]) (*connect.Response[entpb.User], error)
+	Delete(context.Context, *connect.Request[entpb.DeleteUserRequest]) (*connect.Response[emptypb.Empty], error)
+	List(context.Context, *connect.Request[entpb.ListUserRequest]) (*connect.Response[entpb.ListUserResponse], error)
+	BatchCreate(context.Context, *connect.Request[entpb.BatchCreateUsersRequest]) (*connect.Response[entpb.BatchCreateUsersResponse], error)
+}
+
+// NewUserServiceHandler builds an HTTP handler from the service implementation. It returns the path
+// on which to mount the handler and the handler itself.
+//
+// By default, handlers support the Connect, gRPC, and gRPC-Web protocols with the binary Protobuf
+// and JSON codecs. They also support gzip compression.
+func NewUserServiceHandler(svc UserServiceHandler, opts ...connect.HandlerOption) (string, http.Handler) {
+	userServiceCreateHandler := connect.NewUnaryHandler(
+		UserServiceCreateProcedure,
+		svc.Create,
+		connect.WithSchema(userServiceCreateMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceGetHandler := connect.NewUnaryHandler(
+		UserServiceGetProcedure,
+		svc.Get,
+		connect.WithSchema(userServiceGetMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceUpdateHandler := connect.NewUnaryHandler(
+		UserServiceUpdateProcedure,
+		svc.Update,
+		connect.WithSchema(userServiceUpdateMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceDeleteHandler := connect.NewUnaryHandler(
+		UserServiceDeleteProcedure,
+		svc.Delete,
+		connect.WithSchema(userServiceDeleteMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceListHandler := connect.NewUnaryHandler(
+		UserServiceListProcedure,
+		svc.List,
+		connect.WithSchema(userServiceListMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	userServiceBatchCreateHandler := connect.NewUnaryHandler(
+		UserServiceBatchCreateProcedure,
+		svc.BatchCreate,
+		connect.WithSchema(userServiceBatchCreateMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	return "/entpb.UserService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case UserServiceCreateProcedure:
+			userServiceCreateHandler.ServeHTTP(w, r)
+		case UserServiceGetProcedure:
+			userServiceGetHandler.ServeHTTP(w, r)
+		case UserServiceUpdateProcedure:
+			userServiceUpdateHandler.ServeHTTP(w, r)
+		case UserServiceDeleteProcedure:
+			userServiceDeleteHandler.ServeHTTP(w, r)
+		case UserServiceListProcedure:
+			userServiceListHandler.ServeHTTP(w, r)
+		case UserServiceBatchCreateProcedure:
+			userServiceBatchCreateHandler.ServeHTTP(w, r)
+		default:
+			http.NotFound(w, r)
+		}
+	})
+}
+
+// UnimplementedUserServiceHandler returns CodeUnimplemented from all methods.
+type UnimplementedUserServiceHandler struct{}
+
+func (UnimplementedUserServiceHandler) Create(context.Context, *connect.Request[entpb.CreateUserRequest]) (*connect.Response[entpb.User], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("entpb.UserService.Create is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) Get(context.Context, *connect.Request[entpb.GetUserRequest]) (*connect.Response[entpb.User], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("entpb.UserService.Get is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) Update(context.Context, *connect.Request[entpb.UpdateUserRequest]) (*connect.Response[entpb.User], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("entpb.UserService.Update is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) Delete(context.Context, *connect.Request[entpb.DeleteUserRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("entpb.UserService.Delete is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) List(context.Context, *connect.Request[entpb.ListUserRequest]) (*connect.Response[entpb.ListUserResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("entpb.UserService.List is not implemented"))
+}
+
+func (UnimplementedUserServiceHandler) BatchCreate(context.Context, *connect.Request[entpb.BatchCreateUsersRequest]) (*connect.Response[entpb.BatchCreateUsersResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("entpb.UserService.BatchCreate is not implemented"))
+}
@@ -0,0 +1,95 @@
+// Code generated by protoc-gen-entgrpc. DO NOT EDIT.
+package entpbconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	ent "entgo.io/contrib/entproto/internal/todo/ent"
+	entpb "entgo.io/contrib/entproto/internal/todo/ent/proto/entpb"
+	errors "errors"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// AttachmentService implements AttachmentServiceHandler by delegating to entpb.AttachmentService.
+// The returned gRPC status errors are translated to their Connect equivalents, and the
+// handler can serve the Connect, gRPC and gRPC-Web protocols:
+//
+//	mux.Handle(NewAttachmentServiceHandler(NewAttachmentService(client)))
+type AttachmentService struct {
+	svc *entpb.AttachmentService
+}
+
+var _ AttachmentServiceHandler = (*AttachmentService)(nil)
+
+// NewAttachmentService returns a new AttachmentService. The options configure
+// the delegated entpb.AttachmentService, like its page tokens.
+func NewAttachmentService(client *ent.Client, opts ...entpb.AttachmentServiceOption) *AttachmentService {
+	return &AttachmentService{
+		svc: entpb.NewAttachmentService(client, opts...),
+	}
+}
+
+// Create implements AttachmentServiceHandler.Create
+func (h *AttachmentService) Create(ctx context.Context, req *connect.Request[entpb.CreateAttachmentRequest]) (*connect.Response[entpb.Attachment], error) {
+	res, err := h.svc.Create(ctx, req.Msg)
+	if err != nil {
+		return nil, h.error(err)
+	}
+	return connect.NewResponse(res), nil
+}
+
+// Get implements AttachmentServiceHandler.Get
+func (h *AttachmentService) Get(ctx context.Context, req *connect.Request[entpb.GetAttachmentRequest]) (*connect.Response[entpb.Attachment], error) {
+	res, err := h.svc.Get(ctx, req.Msg)
+	if err != nil {
+		return nil, h.error(err)
+	}
+	return connect.NewResponse(res), nil
+}
+
+// Update implements AttachmentServiceHandler.Update
+func (h *AttachmentService) Update(ctx context.Context, req *connect.Request[entpb.UpdateAttachmentRequest]) (*connect.Response[entpb.Attachment], error) {
+	res, err := h.svc.Update(ctx, req.Msg)
+	if err != nil {
+		return nil, h.error(err)
+	}
+	return connect.NewResponse(res), nil
+}
+
+// Delete implements AttachmentServiceHandler.Delete
+func (h *AttachmentService) Delete(ctx context.Context, req *connect.Request[entpb.DeleteAttachmentRequest]) (*connect.Response[emptypb.Empty], error) {
+	res, err := h.svc.Delete(ctx, req.Msg)
+	if err != nil {
+		return nil, h.error(err)
+	}
+	return connect.NewResponse(res), nil
+}
+
+// List implements AttachmentServiceHandler.List
+func (h *AttachmentService) List(ctx context.Context, req *connect.Request[entpb.ListAttachmentRequest]) (*connect.Response[entpb.ListAttachmentResponse], error) {
+	res, err := h.svc.List(ctx, req.Msg)
+	if err != nil {
+		return nil, h.error(err)
+	}
+	return connect.NewResponse(res), nil
+}
+
+// BatchCreate implements AttachmentServiceHandler.BatchCreate
+func (h *AttachmentService) BatchCreate(ctx context.Context, req *connect.Request[entpb.BatchCreateAttachmentsRequest]) (*connect.Response[entpb.BatchCreateAttachmentsResponse], error) {
+	res, err := h.svc.BatchCreate(ctx, req.Msg)
+	if err != nil {
+		return nil, h.error(err)
+	}
+	return connect.NewResponse(res), nil
+}
+
+// error converts the gRPC status error returned by the service to a Connect error.
+// Both protocols share the same numeric codes.
+func (h *AttachmentService) error(err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	return connect.NewError(connect.Code(st.Code()), errors.New(st.Message()))
+}
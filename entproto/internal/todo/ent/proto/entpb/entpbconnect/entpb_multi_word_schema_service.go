@@ -0,0 +1,95 @@
+// Code generated by protoc-gen-entgrpc. DO NOT EDIT.
+package entpbconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	ent "entgo.io/contrib/entproto/internal/todo/ent"
+	entpb "entgo.io/contrib/entproto/internal/todo/ent/proto/entpb"
+	errors "errors"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// MultiWordSchemaService implements MultiWordSchemaServiceHandler by delegating to entpb.MultiWordSchemaService.
+// The returned gRPC status errors are translated to their Connect equivalents, and the
+// handler can serve the Connect, gRPC and gRPC-Web protocols:
+//
+//	mux.Handle(NewMultiWordSchemaServiceHandler(NewMultiWordSchemaService(client)))
+type MultiWordSchemaService struct {
+	svc *entpb.MultiWordSchemaService
+}
+
+var _ MultiWordSchemaServiceHandler = (*MultiWordSchemaService)(nil)
+
+// NewMultiWordSchemaService returns a new MultiWordSchemaService. The options configure
+// the delegated entpb.MultiWordSchemaService, like its page tokens.
+func NewMultiWordSchemaService(client *ent.Client, opts ...entpb.MultiWordSchemaServiceOption) *MultiWordSchemaService {
+	return &MultiWordSchemaService{
+		svc: entpb.NewMultiWordSchemaService(client, opts...),
+	}
+}
+
+// Create implements MultiWordSchemaServiceHandler.Create
+func (h *MultiWordSchemaService) Create(ctx context.Context, req *connect.Request[entpb.CreateMultiWordSchemaRequest]) (*connect.Response[entpb.MultiWordSchema], error) {
+	res, err := h.svc.Create(ctx, req.Msg)
+	if err != nil {
+		return nil, h.error(err)
+	}
+	return connect.NewResponse(res), nil
+}
+
+// Get implements MultiWordSchemaServiceHandler.Get
+func (h *MultiWordSchemaService) Get(ctx context.Context, req *connect.Request[entpb.GetMultiWordSchemaRequest]) (*connect.Response[entpb.MultiWordSchema], error) {
+	res, err := h.svc.Get(ctx, req.Msg)
+	if err != nil {
+		return nil, h.error(err)
+	}
+	return connect.NewResponse(res), nil
+}
+
+// Update implements MultiWordSchemaServiceHandler.Update
+func (h *MultiWordSchemaService) Update(ctx context.Context, req *connect.Request[entpb.UpdateMultiWordSchemaRequest]) (*connect.Response[entpb.MultiWordSchema], error) {
+	res, err := h.svc.Update(ctx, req.Msg)
+	if err != nil {
+		return nil, h.error(err)
+	}
+	return connect.NewResponse(res), nil
+}
+
+// Delete implements MultiWordSchemaServiceHandler.Delete
+func (h *MultiWordSchemaService) Delete(ctx context.Context, req *connect.Request[entpb.DeleteMultiWordSchemaRequest]) (*connect.Response[emptypb.Empty], error) {
+	res, err := h.svc.Delete(ctx, req.Msg)
+	if err != nil {
+		return nil, h.error(err)
+	}
+	return connect.NewResponse(res), nil
+}
+
+// List implements MultiWordSchemaServiceHandler.List
+func (h *MultiWordSchemaService) List(ctx context.Context, req *connect.Request[entpb.ListMultiWordSchemaRequest]) (*connect.Response[entpb.ListMultiWordSchemaResponse], error) {
+	res, err := h.svc.List(ctx, req.Msg)
+	if err != nil {
+		return nil, h.error(err)
+	}
+	return connect.NewResponse(res), nil
+}
+
+// BatchCreate implements MultiWordSchemaServiceHandler.BatchCreate
+func (h *MultiWordSchemaService) BatchCreate(ctx context.Context, req *connect.Request[entpb.BatchCreateMultiWordSchemasRequest]) (*connect.Response[entpb.BatchCreateMultiWordSchemasResponse], error) {
+	res, err := h.svc.BatchCreate(ctx, req.Msg)
+	if err != nil {
+		return nil, h.error(err)
+	}
+	return connect.NewResponse(res), nil
+}
+
+// error converts the gRPC status error returned by the service to a Connect error.
+// Both protocols share the same numeric codes.
+func (h *MultiWordSchemaService) error(err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	return connect.NewError(connect.Code(st.Code()), errors.New(st.Message()))
+}
@@ -0,0 +1,95 @@
+// Code generated by protoc-gen-entgrpc. DO NOT EDIT.
+package entpbconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	ent "entgo.io/contrib/entproto/internal/todo/ent"
+	entpb "entgo.io/contrib/entproto/internal/todo/ent/proto/entpb"
+	errors "errors"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// NilExampleService implements NilExampleServiceHandler by delegating to entpb.NilExampleService.
+// The returned gRPC status errors are translated to their Connect equivalents, and the
+// handler can serve the Connect, gRPC and gRPC-Web protocols:
+//
+//	mux.Handle(NewNilExampleServiceHandler(NewNilExampleService(client)))
+type NilExampleService struct {
+	svc *entpb.NilExampleService
+}
+
+var _ NilExampleServiceHandler = (*NilExampleService)(nil)
+
+// NewNilExampleService returns a new NilExampleService. The options configure
+// the delegated entpb.NilExampleService, like its page tokens.
+func NewNilExampleService(client *ent.Client, opts ...entpb.NilExampleServiceOption) *NilExampleService {
+	return &NilExampleService{
+		svc: entpb.NewNilExampleService(client, opts...),
+	}
+}
+
+// Create implements NilExampleServiceHandler.Create
+func (h *NilExampleService) Create(ctx context.Context, req *connect.Request[entpb.CreateNilExampleRequest]) (*connect.Response[entpb.NilExample], error) {
+	res, err := h.svc.Create(ctx, req.Msg)
+	if err != nil {
+		return nil, h.error(err)
+	}
+	return connect.NewResponse(res), nil
+}
+
+// Get implements NilExampleServiceHandler.Get
+func (h *NilExampleService) Get(ctx context.Context, req *connect.Request[entpb.GetNilExampleRequest]) (*connect.Response[entpb.NilExample], error) {
+	res, err := h.svc.Get(ctx, req.Msg)
+	if err != nil {
+		return nil, h.error(err)
+	}
+	return connect.NewResponse(res), nil
+}
+
+// Update implements NilExampleServiceHandler.Update
+func (h *NilExampleService) Update(ctx context.Context, req *connect.Request[entpb.UpdateNilExampleRequest]) (*connect.Response[entpb.NilExample], error) {
+	res, err := h.svc.Update(ctx, req.Msg)
+	if err != nil {
+		return nil, h.error(err)
+	}
+	return connect.NewResponse(res), nil
+}
+
+// Delete implements NilExampleServiceHandler.Delete
+func (h *NilExampleService) Delete(ctx context.Context, req *connect.Request[entpb.DeleteNilExampleRequest]) (*connect.Response[emptypb.Empty], error) {
+	res, err := h.svc.Delete(ctx, req.Msg)
+	if err != nil {
+		return nil, h.error(err)
+	}
+	return connect.NewResponse(res), nil
+}
+
+// List implements NilExampleServiceHandler.List
+func (h *NilExampleService) List(ctx context.Context, req *connect.Request[entpb.ListNilExampleRequest]) (*connect.Response[entpb.ListNilExampleResponse], error) {
+	res, err := h.svc.List(ctx, req.Msg)
+	if err != nil {
+		return nil, h.error(err)
+	}
+	return connect.NewResponse(res), nil
+}
+
+// BatchCreate implements NilExampleServiceHandler.BatchCreate
+func (h *NilExampleService) BatchCreate(ctx context.Context, req *connect.Request[entpb.BatchCreateNilExamplesRequest]) (*connect.Response[entpb.BatchCreateNilExamplesResponse], error) {
+	res, err := h.svc.BatchCreate(ctx, req.Msg)
+	if err != nil {
+		return nil, h.error(err)
+	}
+	return connect.NewResponse(res), nil
+}
+
+// error converts the gRPC status error returned by the service to a Connect error.
+// Both protocols share the same numeric codes.
+func (h *NilExampleService) error(err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	return connect.NewError(connect.Code(st.Code()), errors.New(st.Message()))
+}
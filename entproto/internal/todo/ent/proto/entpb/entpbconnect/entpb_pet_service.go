@@ -0,0 +1,95 @@
+// Code generated by protoc-gen-entgrpc. DO NOT EDIT.
+package entpbconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	ent "entgo.io/contrib/entproto/internal/todo/ent"
+	entpb "entgo.io/contrib/entproto/internal/todo/ent/proto/entpb"
+	errors "errors"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// PetService implements PetServiceHandler by delegating to entpb.PetService.
+// The returned gRPC status errors are translated to their Connect equivalents, and the
+// handler can serve the Connect, gRPC and gRPC-Web protocols:
+//
+//	mux.Handle(NewPetServiceHandler(NewPetService(client)))
+type PetService struct {
+	svc *entpb.PetService
+}
+
+var _ PetServiceHandler = (*PetService)(nil)
+
+// NewPetService returns a new PetService. The options configure
+// the delegated entpb.PetService, like its page tokens.
+func NewPetService(client *ent.Client, opts ...entpb.PetServiceOption) *PetService {
+	return &PetService{
+		svc: entpb.NewPetService(client, opts...),
+	}
+}
+
+// Create implements PetServiceHandler.Create
+func (h *PetService) Create(ctx context.Context, req *connect.Request[entpb.CreatePetRequest]) (*connect.Response[entpb.Pet], error) {
+	res, err := h.svc.Create(ctx, req.Msg)
+	if err != nil {
+		return nil, h.error(err)
+	}
+	return connect.NewResponse(res), nil
+}
+
+// Get implements PetServiceHandler.Get
+func (h *PetService) Get(ctx context.Context, req *connect.Request[entpb.GetPetRequest]) (*connect.Response[entpb.Pet], error) {
+	res, err := h.svc.Get(ctx, req.Msg)
+	if err != nil {
+		return nil, h.error(err)
+	}
+	return connect.NewResponse(res), nil
+}
+
+// Update implements PetServiceHandler.Update
+func (h *PetService) Update(ctx context.Context, req *connect.Request[entpb.UpdatePetRequest]) (*connect.Response[entpb.Pet], error) {
+	res, err := h.svc.Update(ctx, req.Msg)
+	if err != nil {
+		return nil, h.error(err)
+	}
+	return connect.NewResponse(res), nil
+}
+
+// Delete implements PetServiceHandler.Delete
+func (h *PetService) Delete(ctx context.Context, req *connect.Request[entpb.DeletePetRequest]) (*connect.Response[emptypb.Empty], error) {
+	res, err := h.svc.Delete(ctx, req.Msg)
+	if err != nil {
+		return nil, h.error(err)
+	}
+	return connect.NewResponse(res), nil
+}
+
+// List implements PetServiceHandler.List
+func (h *PetService) List(ctx context.Context, req *connect.Request[entpb.ListPetRequest]) (*connect.Response[entpb.ListPetResponse], error) {
+	res, err := h.svc.List(ctx, req.Msg)
+	if err != nil {
+		return nil, h.error(err)
+	}
+	return connect.NewResponse(res), nil
+}
+
+// BatchCreate implements PetServiceHandler.BatchCreate
+func (h *PetService) BatchCreate(ctx context.Context, req *connect.Request[entpb.BatchCreatePetsRequest]) (*connect.Response[entpb.BatchCreatePetsResponse], error) {
+	res, err := h.svc.BatchCreate(ctx, req.Msg)
+	if err != nil {
+		return nil, h.error(err)
+	}
+	return connect.NewResponse(res), nil
+}
+
+// error converts the gRPC status error returned by the service to a Connect error.
+// Both protocols share the same numeric codes.
+func (h *PetService) error(err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	return connect.NewError(connect.Code(st.Code()), errors.New(st.Message()))
+}
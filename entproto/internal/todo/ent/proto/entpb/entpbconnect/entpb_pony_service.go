@@ -0,0 +1,49 @@
+// Code generated by protoc-gen-entgrpc. DO NOT EDIT.
+package entpbconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	ent "entgo.io/contrib/entproto/internal/todo/ent"
+	entpb "entgo.io/contrib/entproto/internal/todo/ent/proto/entpb"
+	errors "errors"
+	status "google.golang.org/grpc/status"
+)
+
+// PonyService implements PonyServiceHandler by delegating to entpb.PonyService.
+// The returned gRPC status errors are translated to their Connect equivalents, and the
+// handler can serve the Connect, gRPC and gRPC-Web protocols:
+//
+//	mux.Handle(NewPonyServiceHandler(NewPonyService(client)))
+type PonyService struct {
+	svc *entpb.PonyService
+}
+
+var _ PonyServiceHandler = (*PonyService)(nil)
+
+// NewPonyService returns a new PonyService. The options configure
+// the delegated entpb.PonyService, like its page tokens.
+func NewPonyService(client *ent.Client, opts ...entpb.PonyServiceOption) *PonyService {
+	return &PonyService{
+		svc: entpb.NewPonyService(client, opts...),
+	}
+}
+
+// BatchCreate implements PonyServiceHandler.BatchCreate
+func (h *PonyService) BatchCreate(ctx context.Context, req *connect.Request[entpb.BatchCreatePoniesRequest]) (*connect.Response[entpb.BatchCreatePoniesResponse], error) {
+	res, err := h.svc.BatchCreate(ctx, req.Msg)
+	if err != nil {
+		return nil, h.error(err)
+	}
+	return connect.NewResponse(res), nil
+}
+
+// error converts the gRPC status error returned by the service to a Connect error.
+// Both protocols share the same numeric codes.
+func (h *PonyService) error(err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	return connect.NewError(connect.Code(st.Code()), errors.New(st.Message()))
+}
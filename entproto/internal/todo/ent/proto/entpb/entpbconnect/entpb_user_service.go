@@ -0,0 +1,95 @@
+// Code generated by protoc-gen-entgrpc. DO NOT EDIT.
+package entpbconnect
+
+import (
+	connect "connectrpc.com/connect"
+	context "context"
+	ent "entgo.io/contrib/entproto/internal/todo/ent"
+	entpb "entgo.io/contrib/entproto/internal/todo/ent/proto/entpb"
+	errors "errors"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// UserService implements UserServiceHandler by delegating to entpb.UserService.
+// The returned gRPC status errors are translated to their Connect equivalents, and the
+// handler can serve the Connect, gRPC and gRPC-Web protocols:
+//
+//	mux.Handle(NewUserServiceHandler(NewUserService(client)))
+type UserService struct {
+	svc *entpb.UserService
+}
+
+var _ UserServiceHandler = (*UserService)(nil)
+
+// NewUserService returns a new UserService. The options configure
+// the delegated entpb.UserService, like its page tokens.
+func NewUserService(client *ent.Client, opts ...entpb.UserServiceOption) *UserService {
+	return &UserService{
+		svc: entpb.NewUserService(client, opts...),
+	}
+}
+
+// Create implements UserServiceHandler.Create
+func (h *UserService) Create(ctx context.Context, req *connect.Request[entpb.CreateUserRequest]) (*connect.Response[entpb.User], error) {
+	res, err := h.svc.Create(ctx, req.Msg)
+	if err != nil {
+		return nil, h.error(err)
+	}
+	return connect.NewResponse(res), nil
+}
+
+// Get implements UserServiceHandler.Get
+func (h *UserService) Get(ctx context.Context, req *connect.Request[entpb.GetUserRequest]) (*connect.Response[entpb.User], error) {
+	res, err := h.svc.Get(ctx, req.Msg)
+	if err != nil {
+		return nil, h.error(err)
+	}
+	return connect.NewResponse(res), nil
+}
+
+// Update implements UserServiceHandler.Update
+func (h *UserService) Update(ctx context.Context, req *connect.Request[entpb.UpdateUserRequest]) (*connect.Response[entpb.User], error) {
+	res, err := h.svc.Update(ctx, req.Msg)
+	if err != nil {
+		return nil, h.error(err)
+	}
+	return connect.NewResponse(res), nil
+}
+
+// Delete implements UserServiceHandler.Delete
+func (h *UserService) Delete(ctx context.Context, req *connect.Request[entpb.DeleteUserRequest]) (*connect.Response[emptypb.Empty], error) {
+	res, err := h.svc.Delete(ctx, req.Msg)
+	if err != nil {
+		return nil, h.error(err)
+	}
+	return connect.NewResponse(res), nil
+}
+
+// List implements UserServiceHandler.List
+func (h *UserService) List(ctx context.Context, req *connect.Request[entpb.ListUserRequest]) (*connect.Response[entpb.ListUserResponse], error) {
+	res, err := h.svc.List(ctx, req.Msg)
+	if err != nil {
+		return nil, h.error(err)
+	}
+	return connect.NewResponse(res), nil
+}
+
+// BatchCreate implements UserServiceHandler.BatchCreate
+func (h *UserService) BatchCreate(ctx context.Context, req *connect.Request[entpb.BatchCreateUsersRequest]) (*connect.Response[entpb.BatchCreateUsersResponse], error) {
+	res, err := h.svc.BatchCreate(ctx, req.Msg)
+	if err != nil {
+		return nil, h.error(err)
+	}
+	return connect.NewResponse(res), nil
+}
+
+// error converts the gRPC status error returned by the service to a Connect error.
+// Both protocols share the same numeric codes.
+func (h *UserService) error(err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	return connect.NewError(connect.Code(st.Code()), errors.New(st.Message()))
+}
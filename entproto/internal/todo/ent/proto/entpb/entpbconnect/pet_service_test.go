@@ -0,0 +1,85 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entpbconnect
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	"entgo.io/contrib/entproto/internal/todo/ent/enttest"
+	"entgo.io/contrib/entproto/internal/todo/ent/proto/entpb"
+	"entgo.io/contrib/entproto/runtime"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPetService(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:connect?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	mux := http.NewServeMux()
+	mux.Handle(NewPetServiceHandler(NewPetService(client)))
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	ctx := context.Background()
+	for _, opts := range [][]connect.ClientOption{nil, {connect.WithProtoJSON()}, {connect.WithGRPCWeb()}} {
+		pets := NewPetServiceClient(srv.Client(), srv.URL, opts...)
+		created, err := pets.Create(ctx, connect.NewRequest(&entpb.CreatePetRequest{Pet: &entpb.Pet{}}))
+		require.NoError(t, err)
+		got, err := pets.Get(ctx, connect.NewRequest(&entpb.GetPetRequest{Id: created.Msg.GetId()}))
+		require.NoError(t, err)
+		require.Equal(t, created.Msg.GetId(), got.Msg.GetId())
+
+		_, err = pets.Delete(ctx, connect.NewRequest(&entpb.DeletePetRequest{Id: created.Msg.GetId()}))
+		require.NoError(t, err)
+		_, err = pets.Get(ctx, connect.NewRequest(&entpb.GetPetRequest{Id: created.Msg.GetId()}))
+		require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+	}
+}
+
+func TestPetService_PageTokens(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:connect-tokens?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		client.Pet.Create().SaveX(ctx)
+	}
+	mux := http.NewServeMux()
+	mux.Handle(NewPetServiceHandler(NewPetService(client, entpb.WithPetServicePageTokens(&runtime.PageTokenCodec{
+		Secret: []byte("secret"),
+	}))))
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	pets := NewPetServiceClient(srv.Client(), srv.URL)
+	resp, err := pets.List(ctx, connect.NewRequest(&entpb.ListPetRequest{PageSize: 2}))
+	require.NoError(t, err)
+	require.Len(t, resp.Msg.GetPetList(), 2)
+	require.NotEmpty(t, resp.Msg.GetNextPageToken())
+
+	// Tokens that were not signed by the service are rejected.
+	forged, err := (&runtime.PageTokenCodec{}).Encode("1", "")
+	require.NoError(t, err)
+	_, err = pets.List(ctx, connect.NewRequest(&entpb.ListPetRequest{PageToken: forged}))
+	require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+
+	next, err := pets.List(ctx, connect.NewRequest(&entpb.ListPetRequest{PageToken: resp.Msg.GetNextPageToken(), PageSize: 2}))
+	require.NoError(t, err)
+	require.Len(t, next.Msg.GetPetList(), 1)
+	require.Empty(t, next.Msg.GetNextPageToken())
+}
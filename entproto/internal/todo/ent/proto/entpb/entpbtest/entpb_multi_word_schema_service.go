@@ -0,0 +1,74 @@
+// Code generated by protoc-gen-entgrpc. DO NOT EDIT.
+package entpbtest
+
+import (
+	context "context"
+	ent "entgo.io/contrib/entproto/internal/todo/ent"
+	enttest "entgo.io/contrib/entproto/internal/todo/ent/enttest"
+	entpb "entgo.io/contrib/entproto/internal/todo/ent/proto/entpb"
+	fmt "fmt"
+	_ "github.com/mattn/go-sqlite3"
+	grpc "google.golang.org/grpc"
+	insecure "google.golang.org/grpc/credentials/insecure"
+	bufconn "google.golang.org/grpc/test/bufconn"
+	net "net"
+	url "net/url"
+	testing "testing"
+)
+
+// MultiWordSchemaServiceTestServer is an in-memory MultiWordSchemaService server for tests. It is served over
+// bufconn and backed by an ent client connected to an in-memory SQLite database.
+type MultiWordSchemaServiceTestServer struct {
+	entpb.MultiWordSchemaServiceClient
+	// Client is the ent client used by the server. It can be used
+	// to seed or assert the state of the database.
+	Client *ent.Client
+	// Conn is the client connection to the server.
+	Conn   *grpc.ClientConn
+	server *grpc.Server
+}
+
+// NewMultiWordSchemaServiceTestServer starts a new MultiWordSchemaServiceTestServer and registers its teardown with t.Cleanup.
+// The given seed functions are executed in order, before the server starts serving.
+func NewMultiWordSchemaServiceTestServer(t testing.TB, seed ...func(context.Context, *ent.Client) error) *MultiWordSchemaServiceTestServer {
+	t.Helper()
+	srv := &MultiWordSchemaServiceTestServer{}
+	// The address of srv makes the database name unique when a test starts more than one server.
+	dsn := fmt.Sprintf("file:%s?mode=memory&cache=shared&_fk=1", url.PathEscape(fmt.Sprintf("%s-%p", t.Name(), srv)))
+	client := enttest.Open(t, "sqlite3", dsn)
+	ctx := context.Background()
+	for _, s := range seed {
+		if err := s(ctx, client); err != nil {
+			client.Close()
+			t.Fatalf("seeding MultiWordSchemaService: %v", err)
+		}
+	}
+	lis := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	entpb.RegisterMultiWordSchemaServiceServer(server, entpb.NewMultiWordSchemaService(client))
+	go func() {
+		_ = server.Serve(lis)
+	}()
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		server.Stop()
+		client.Close()
+		t.Fatalf("dialing MultiWordSchemaService: %v", err)
+	}
+	srv.MultiWordSchemaServiceClient = entpb.NewMultiWordSchemaServiceClient(conn)
+	srv.Client, srv.Conn, srv.server = client, conn, server
+	t.Cleanup(srv.close)
+	return srv
+}
+
+// close stops the server and releases its resources.
+func (s *MultiWordSchemaServiceTestServer) close() {
+	s.Conn.Close()
+	s.server.Stop()
+	s.Client.Close()
+}
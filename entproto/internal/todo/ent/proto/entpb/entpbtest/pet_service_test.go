@@ -0,0 +1,54 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entpbtest
+
+import (
+	"context"
+	"testing"
+
+	"entgo.io/contrib/entproto/internal/todo/ent"
+	"entgo.io/contrib/entproto/internal/todo/ent/proto/entpb"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPetServiceTestServer(t *testing.T) {
+	srv := NewPetServiceTestServer(t, func(ctx context.Context, client *ent.Client) error {
+		return client.Pet.Create().Exec(ctx)
+	})
+	ctx := context.Background()
+	list, err := srv.List(ctx, &entpb.ListPetRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.PetList, 1)
+
+	created, err := srv.Create(ctx, &entpb.CreatePetRequest{Pet: &entpb.Pet{}})
+	require.NoError(t, err)
+	require.Equal(t, 2, srv.Client.Pet.Query().CountX(ctx))
+
+	_, err = srv.Delete(ctx, &entpb.DeletePetRequest{Id: created.GetId()})
+	require.NoError(t, err)
+	_, err = srv.Get(ctx, &entpb.GetPetRequest{Id: created.GetId()})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestPetServiceTestServer_Isolated(t *testing.T) {
+	ctx := context.Background()
+	first := NewPetServiceTestServer(t)
+	second := NewPetServiceTestServer(t)
+	_, err := first.Create(ctx, &entpb.CreatePetRequest{Pet: &entpb.Pet{}})
+	require.NoError(t, err)
+	require.Zero(t, second.Client.Pet.Query().CountX(ctx))
+}
@@ -14,4 +14,4 @@
 
 package entpb
 
-//go:generate protoc -I=.. --go_out=.. --go-grpc_out=.. --go_opt=paths=source_relative --entgrpc_out=.. --entgrpc_opt=paths=source_relative,schema_path=../../schema --go-grpc_opt=paths=source_relative entpb/entpb.proto entpb/ext.proto
+//go:generate protoc -I=.. --go_out=.. --go-grpc_out=.. --connect-go_out=.. --go_opt=paths=source_relative --entgrpc_out=.. --entgrpc_opt=paths=source_relative,schema_path=../../schema,test_server=true,connect=true,instrument=true --connect-go_opt=paths=source_relative --go-grpc_opt=paths=source_relative entpb/entpb.proto entpb/ext.proto
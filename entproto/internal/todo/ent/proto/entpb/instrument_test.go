@@ -0,0 +1,100 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entpb
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"entgo.io/contrib/entproto/internal/todo/ent/enttest"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func TestPetService_Instrument(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:instrument?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	rec := &spanRecorder{}
+	svc := NewPetService(client, WithPetServiceTracerProvider(rec))
+	ctx := context.Background()
+
+	created, err := svc.Create(ctx, &CreatePetRequest{Pet: &Pet{}})
+	require.NoError(t, err)
+	_, err = svc.List(ctx, &ListPetRequest{})
+	require.NoError(t, err)
+	_, err = svc.Get(ctx, &GetPetRequest{Id: created.GetId() + 1})
+	require.Error(t, err)
+
+	require.Len(t, rec.spans, 3)
+	require.Equal(t, "entpb.PetService/Create", rec.spans[0].name)
+	require.Equal(t, attribute.IntValue(1), rec.spans[0].attrs["ent.rows"])
+	require.Equal(t, attribute.StringValue("Pet"), rec.spans[0].attrs["ent.type"])
+	require.Equal(t, "entpb.PetService/List", rec.spans[1].name)
+	require.Equal(t, attribute.IntValue(1), rec.spans[1].attrs["ent.rows"])
+	require.Equal(t, "entpb.PetService/Get", rec.spans[2].name)
+	require.Equal(t, attribute.IntValue(0), rec.spans[2].attrs["ent.rows"])
+	require.Equal(t, codes.Error, rec.spans[2].status)
+	for _, s := range rec.spans {
+		require.True(t, s.ended)
+	}
+}
+
+// spanRecorder is a trace.TracerProvider that records the spans started by its tracers.
+type spanRecorder struct {
+	noop.TracerProvider
+	mu    sync.Mutex
+	spans []*recordedSpan
+}
+
+func (r *spanRecorder) Tracer(string, ...trace.TracerOption) trace.Tracer {
+	return &recordingTracer{rec: r}
+}
+
+type recordingTracer struct {
+	noop.Tracer
+	rec *spanRecorder
+}
+
+func (t *recordingTracer) Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	s := &recordedSpan{name: name, attrs: make(map[attribute.Key]attribute.Value)}
+	cfg := trace.NewSpanStartConfig(opts...)
+	s.SetAttributes(cfg.Attributes()...)
+	t.rec.mu.Lock()
+	t.rec.spans = append(t.rec.spans, s)
+	t.rec.mu.Unlock()
+	return trace.ContextWithSpan(ctx, s), s
+}
+
+type recordedSpan struct {
+	noop.Span
+	name   string
+	attrs  map[attribute.Key]attribute.Value
+	status codes.Code
+	ended  bool
+}
+
+func (s *recordedSpan) SetAttributes(kv ...attribute.KeyValue) {
+	for _, a := range kv {
+		s.attrs[a.Key] = a.Value
+	}
+}
+
+func (s *recordedSpan) SetStatus(code codes.Code, _ string) { s.status = code }
+func (s *recordedSpan) End(...trace.SpanEndOption)          { s.ended = true }
@@ -27,11 +27,13 @@ import (
 
 	"entgo.io/contrib/entproto/internal/todo/ent"
 	"entgo.io/contrib/entproto/internal/todo/ent/enttest"
+	"entgo.io/contrib/entproto/runtime"
 	"github.com/google/uuid"
 	_ "github.com/mattn/go-sqlite3"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -311,6 +313,17 @@ func TestUserService_List(t *testing.T) {
 	respStatus, ok = status.FromError(err)
 	require.True(t, ok, "expected a gRPC status error")
 	require.EqualValues(t, respStatus.Code(), codes.InvalidArgument)
+
+	// Page token out of the range of the ID type (uint32)
+	filter, err := runtime.FilterHash(&ListUserRequest{}, "page_size", "page_token")
+	require.NoError(t, err)
+	token, err := (&runtime.PageTokenCodec{}).Encode("4294967296", filter)
+	require.NoError(t, err)
+	resp, err = svc.List(ctx, &ListUserRequest{
+		PageToken: token,
+	})
+	require.Nil(t, resp)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
 }
 
 func TestUserService_BatchCreate(t *testing.T) {
@@ -363,3 +376,97 @@ func TestUserService_BatchCreate(t *testing.T) {
 	require.True(t, ok, "expected a gRPC status error")
 	require.EqualValues(t, respStatus.Code(), codes.InvalidArgument)
 }
+
+func TestUserService_ReadMask(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewUserService(client)
+	ctx := context.Background()
+	group := client.Group.Create().SetName("managers").SaveX(ctx)
+	created := client.User.Create().
+		SetUserName("rotemtam").
+		SetJoined(time.Now()).
+		SetPoints(10).
+		SetExp(1000).
+		SetStatus("pending").
+		SetExternalID(1).
+		SetCrmID(uuid.New()).
+		SetCustomPb(1).
+		SetLabels([]string{"on", "off"}).
+		SetOmitPrefix(user.OmitPrefixBar).
+		SetMimeType(user.MimeTypeSvg).
+		SetGroup(group).
+		SaveX(ctx)
+	mask := &fieldmaskpb.FieldMask{Paths: []string{"user_name", "group"}}
+
+	get, err := svc.Get(ctx, &GetUserRequest{
+		Id:       created.ID,
+		View:     GetUserRequest_WITH_EDGE_IDS,
+		ReadMask: mask,
+	})
+	require.NoError(t, err)
+	require.EqualValues(t, created.ID, get.Id)
+	require.EqualValues(t, created.UserName, get.UserName)
+	require.EqualValues(t, group.ID, get.GetGroup().GetId())
+	require.Zero(t, get.Exp)
+	require.Nil(t, get.Joined)
+	require.Empty(t, get.Labels)
+
+	list, err := svc.List(ctx, &ListUserRequest{
+		ReadMask: mask,
+	})
+	require.NoError(t, err)
+	require.Len(t, list.UserList, 1)
+	require.EqualValues(t, created.UserName, list.UserList[0].UserName)
+	require.Zero(t, list.UserList[0].Points)
+	require.Nil(t, list.UserList[0].Group)
+
+	_, err = svc.Get(ctx, &GetUserRequest{
+		Id:       created.ID,
+		ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"unknown"}},
+	})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestUserService_ListPageTokens(t *testing.T) {
+	client := enttest.Open(t, "sqlite3", "file:ent?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	svc := NewUserService(client, WithUserServicePageTokens(&runtime.PageTokenCodec{
+		Secret: []byte("secret"),
+	}))
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		client.User.Create().
+			SetUserName(fmt.Sprintf("User%d", i)).
+			SetExternalID(i).
+			SetJoined(time.Now()).
+			SetExp(1000).
+			SetPoints(10).
+			SetStatus("pending").
+			SetCrmID(uuid.New()).
+			SetCustomPb(1).
+			SetOmitPrefix(user.OmitPrefixBar).
+			SetMimeType(user.MimeTypeSvg).
+			SaveX(ctx)
+	}
+	resp, err := svc.List(ctx, &ListUserRequest{PageSize: 2})
+	require.NoError(t, err)
+	require.Len(t, resp.UserList, 2)
+	require.NotEmpty(t, resp.NextPageToken)
+
+	// The token is valid only for the same filters.
+	_, err = svc.List(ctx, &ListUserRequest{PageToken: resp.NextPageToken, View: ListUserRequest_WITH_EDGE_IDS})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Tokens that were not signed by the service are rejected.
+	forged, err := (&runtime.PageTokenCodec{}).Encode("1", "")
+	require.NoError(t, err)
+	_, err = svc.List(ctx, &ListUserRequest{PageToken: forged})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	next, err := svc.List(ctx, &ListUserRequest{PageToken: resp.NextPageToken, PageSize: 2})
+	require.NoError(t, err)
+	require.Len(t, next.UserList, 1)
+	require.Equal(t, "User0", next.UserList[0].UserName)
+	require.Empty(t, next.NextPageToken)
+}
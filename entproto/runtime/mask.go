@@ -0,0 +1,44 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// ApplyReadMask clears the fields of m that are not included in the read mask. Only the top-level
+// field of each path is considered, and the fields listed in keep are always retained. An empty
+// read mask is a no-op.
+func ApplyReadMask(m proto.Message, mask *fieldmaskpb.FieldMask, keep ...string) {
+	if len(mask.GetPaths()) == 0 {
+		return
+	}
+	include := make(map[protoreflect.Name]struct{}, len(mask.GetPaths())+len(keep))
+	for _, p := range append(mask.GetPaths(), keep...) {
+		name, _, _ := strings.Cut(p, ".")
+		include[protoreflect.Name(name)] = struct{}{}
+	}
+	r := m.ProtoReflect()
+	r.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		if _, ok := include[fd.Name()]; !ok {
+			r.Clear(fd)
+		}
+		return true
+	})
+}
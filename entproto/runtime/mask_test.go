@@ -0,0 +1,44 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/apipb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/sourcecontextpb"
+)
+
+func TestApplyReadMask(t *testing.T) {
+	newAPI := func() *apipb.Api {
+		return &apipb.Api{
+			Name:          "name",
+			Version:       "v1",
+			SourceContext: &sourcecontextpb.SourceContext{FileName: "api.proto"},
+			Methods:       []*apipb.Method{{Name: "Get"}},
+		}
+	}
+	m := newAPI()
+	ApplyReadMask(m, nil)
+	require.Equal(t, newAPI().String(), m.String())
+
+	ApplyReadMask(m, &fieldmaskpb.FieldMask{Paths: []string{"version", "source_context.file_name"}}, "name")
+	require.Equal(t, "name", m.GetName())
+	require.Equal(t, "v1", m.GetVersion())
+	require.Equal(t, "api.proto", m.GetSourceContext().GetFileName())
+	require.Empty(t, m.GetMethods())
+}
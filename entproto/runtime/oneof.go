@@ -0,0 +1,53 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"entgo.io/ent"
+)
+
+// OneOfHook returns a hook that enforces the exclusivity of fields generated from the members of a
+// protobuf oneof. Mutations that set more than one of the fields are rejected, and updates that set
+// one of them clear the others.
+func OneOfHook(oneof string, fields ...string) ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			var set []string
+			for _, f := range fields {
+				if _, ok := m.Field(f); ok {
+					set = append(set, f)
+				}
+			}
+			switch {
+			case len(set) > 1:
+				return nil, fmt.Errorf("entproto: fields %s of oneof %q are mutually exclusive", strings.Join(set, ", "), oneof)
+			case len(set) == 1 && m.Op().Is(ent.OpUpdate|ent.OpUpdateOne):
+				for _, f := range fields {
+					if f == set[0] {
+						continue
+					}
+					if err := m.ClearField(f); err != nil {
+						return nil, err
+					}
+				}
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+}
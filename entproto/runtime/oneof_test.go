@@ -0,0 +1,45 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"entgo.io/contrib/entproto/internal/todo/ent/enttest"
+	"entgo.io/contrib/entproto/runtime"
+	_ "github.com/mattn/go-sqlite3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOneOfHook(t *testing.T) {
+	ctx := context.Background()
+	client := enttest.Open(t, "sqlite3", "file:oneof?mode=memory&cache=shared&_fk=1")
+	defer client.Close()
+	client.NilExample.Use(runtime.OneOfHook("value", "str_nil", "time_nil"))
+
+	_, err := client.NilExample.Create().SetStrNil("a").SetTimeNil(time.Now()).Save(ctx)
+	require.EqualError(t, err, `entproto: fields str_nil, time_nil of oneof "value" are mutually exclusive`)
+
+	ex, err := client.NilExample.Create().SetStrNil("a").Save(ctx)
+	require.NoError(t, err)
+	require.Nil(t, ex.TimeNil)
+
+	ex, err = ex.Update().SetTimeNil(time.Now()).Save(ctx)
+	require.NoError(t, err)
+	require.NotNil(t, ex.TimeNil)
+	require.Nil(t, ex.StrNil)
+}
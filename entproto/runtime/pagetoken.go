@@ -0,0 +1,132 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ErrInvalidPageToken is returned when a page token is malformed, was tampered with,
+// has expired, or was issued for a request with different filters.
+var ErrInvalidPageToken = errors.New("entproto: invalid page token")
+
+type (
+	// PageTokenCodec encodes and decodes the opaque page tokens returned by List methods.
+	// The zero value encodes unsigned tokens that never expire.
+	PageTokenCodec struct {
+		// Secret, if set, is used to sign the tokens with HMAC-SHA256,
+		// and tokens without a valid signature are rejected.
+		Secret []byte
+		// TTL, if set, limits the lifetime of the issued tokens.
+		TTL time.Duration
+		// Now returns the current time. Defaults to time.Now.
+		Now func() time.Time
+	}
+
+	// pageToken is the payload of the page tokens.
+	pageToken struct {
+		// Key is the sort key of the first entry in the next page.
+		Key string `json:"k"`
+		// Filter is the hash of the request filters the token was issued for.
+		Filter string `json:"f,omitempty"`
+		// ExpiresAt is the unix time the token expires at.
+		ExpiresAt int64 `json:"e,omitempty"`
+	}
+)
+
+// Encode returns a page token that points to the given sort key,
+// and is valid only for requests with the given filter hash.
+func (c *PageTokenCodec) Encode(key, filter string) (string, error) {
+	t := pageToken{Key: key, Filter: filter}
+	if c.TTL > 0 {
+		t.ExpiresAt = c.now().Add(c.TTL).Unix()
+	}
+	payload, err := json.Marshal(t)
+	if err != nil {
+		return "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(payload)
+	if len(c.Secret) > 0 {
+		token += "." + base64.RawURLEncoding.EncodeToString(c.sign(payload))
+	}
+	return token, nil
+}
+
+// Decode validates the page token against the given filter hash and returns its sort key.
+func (c *PageTokenCodec) Decode(token, filter string) (string, error) {
+	enc, sig, signed := strings.Cut(token, ".")
+	if signed != (len(c.Secret) > 0) {
+		return "", ErrInvalidPageToken
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(enc)
+	if err != nil {
+		return "", ErrInvalidPageToken
+	}
+	if signed {
+		mac, err := base64.RawURLEncoding.DecodeString(sig)
+		if err != nil || !hmac.Equal(mac, c.sign(payload)) {
+			return "", ErrInvalidPageToken
+		}
+	}
+	var t pageToken
+	if err := json.Unmarshal(payload, &t); err != nil {
+		return "", ErrInvalidPageToken
+	}
+	if t.Filter != filter || t.ExpiresAt > 0 && c.now().Unix() > t.ExpiresAt {
+		return "", ErrInvalidPageToken
+	}
+	return t.Key, nil
+}
+
+func (c *PageTokenCodec) sign(payload []byte) []byte {
+	h := hmac.New(sha256.New, c.Secret)
+	h.Write(payload)
+	return h.Sum(nil)
+}
+
+func (c *PageTokenCodec) now() time.Time {
+	if c.Now != nil {
+		return c.Now()
+	}
+	return time.Now()
+}
+
+// FilterHash returns a hash of the request, excluding the given fields (e.g. the page
+// size and token). Page tokens carry the hash of the request they were issued for, to
+// reject their reuse with different filters.
+func FilterHash(req proto.Message, exclude ...protoreflect.Name) (string, error) {
+	req = proto.Clone(req)
+	r := req.ProtoReflect()
+	for _, name := range exclude {
+		if fd := r.Descriptor().Fields().ByName(name); fd != nil {
+			r.Clear(fd)
+		}
+	}
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return base64.RawURLEncoding.EncodeToString(sum[:12]), nil
+}
@@ -0,0 +1,83 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package runtime
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/apipb"
+)
+
+func TestPageTokenCodec(t *testing.T) {
+	var c PageTokenCodec
+	token, err := c.Encode("9223372036854775807", "f")
+	require.NoError(t, err)
+	require.NotContains(t, token, "9223372036854775807")
+	key, err := c.Decode(token, "f")
+	require.NoError(t, err)
+	require.Equal(t, "9223372036854775807", key)
+	_, err = c.Decode(token, "g")
+	require.ErrorIs(t, err, ErrInvalidPageToken)
+	_, err = c.Decode("invalid", "f")
+	require.ErrorIs(t, err, ErrInvalidPageToken)
+}
+
+func TestPageTokenCodec_Signed(t *testing.T) {
+	c := PageTokenCodec{Secret: []byte("secret")}
+	token, err := c.Encode("1", "f")
+	require.NoError(t, err)
+	key, err := c.Decode(token, "f")
+	require.NoError(t, err)
+	require.Equal(t, "1", key)
+
+	// Forged payload with the original signature.
+	forged, err := (&PageTokenCodec{}).Encode("2", "f")
+	require.NoError(t, err)
+	_, sig, _ := strings.Cut(token, ".")
+	_, err = c.Decode(forged+"."+sig, "f")
+	require.ErrorIs(t, err, ErrInvalidPageToken)
+	// Unsigned token.
+	_, err = c.Decode(forged, "f")
+	require.ErrorIs(t, err, ErrInvalidPageToken)
+	// Different secret.
+	_, err = (&PageTokenCodec{Secret: []byte("other")}).Decode(token, "f")
+	require.ErrorIs(t, err, ErrInvalidPageToken)
+}
+
+func TestPageTokenCodec_TTL(t *testing.T) {
+	now := time.Now()
+	c := PageTokenCodec{TTL: time.Minute, Now: func() time.Time { return now }}
+	token, err := c.Encode("1", "")
+	require.NoError(t, err)
+	_, err = c.Decode(token, "")
+	require.NoError(t, err)
+	now = now.Add(2 * time.Minute)
+	_, err = c.Decode(token, "")
+	require.ErrorIs(t, err, ErrInvalidPageToken)
+}
+
+func TestFilterHash(t *testing.T) {
+	h1, err := FilterHash(&apipb.Api{Name: "a", Version: "v1"}, "version")
+	require.NoError(t, err)
+	h2, err := FilterHash(&apipb.Api{Name: "a", Version: "v2"}, "version")
+	require.NoError(t, err)
+	require.Equal(t, h1, h2)
+	h3, err := FilterHash(&apipb.Api{Name: "b", Version: "v1"}, "version")
+	require.NoError(t, err)
+	require.NotEqual(t, h1, h3)
+}
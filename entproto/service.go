@@ -65,9 +65,19 @@ func Methods(methods Method) ServiceOption {
 	}
 }
 
+// ReadMask adds a `read_mask` field of type google.protobuf.FieldMask to the Get and List
+// requests of the entproto.Service. The generated service selects only the requested fields
+// from the database, and populates only them in the response.
+func ReadMask() ServiceOption {
+	return func(s *service) {
+		s.ReadMask = true
+	}
+}
+
 type service struct {
 	Generate bool
 	Methods  Method
+	ReadMask bool
 }
 
 func (service) Name() string {
@@ -92,7 +102,7 @@ func Service(opts ...ServiceOption) schema.Annotation {
 	return s
 }
 
-func (a *Adapter) createServiceResources(genType *gen.Type, methods Method) (serviceResources, error) {
+func (a *Adapter) createServiceResources(genType *gen.Type, svc *service) (serviceResources, error) {
 	name := genType.Name
 	serviceFqn := fmt.Sprintf("%sService", name)
 
@@ -103,11 +113,11 @@ func (a *Adapter) createServiceResources(genType *gen.Type, methods Method) (ser
 	}
 
 	for _, m := range []Method{MethodCreate, MethodGet, MethodUpdate, MethodDelete, MethodList, MethodBatchCreate} {
-		if !methods.Is(m) {
+		if !svc.Methods.Is(m) {
 			continue
 		}
 
-		resources, err := a.genMethodProtos(genType, m)
+		resources, err := a.genMethodProtos(genType, m, svc.ReadMask)
 		if err != nil {
 			return serviceResources{}, err
 		}
@@ -121,7 +131,7 @@ func (a *Adapter) createServiceResources(genType *gen.Type, methods Method) (ser
 
 var plural = gen.Funcs["plural"].(func(string) string)
 
-func (a *Adapter) genMethodProtos(genType *gen.Type, m Method) (methodResources, error) {
+func (a *Adapter) genMethodProtos(genType *gen.Type, m Method, readMask bool) (methodResources, error) {
 	input := &descriptorpb.DescriptorProto{}
 	idField, err := toProtoFieldDescriptor(genType.ID)
 	if err != nil {
@@ -136,6 +146,14 @@ func (a *Adapter) genMethodProtos(genType *gen.Type, m Method) (methodResources,
 		Type:     &protoMessageFieldType,
 		TypeName: &genType.Name,
 	}
+	readMaskField := func(num int32) *descriptorpb.FieldDescriptorProto {
+		return &descriptorpb.FieldDescriptorProto{
+			Name:     strptr("read_mask"),
+			Number:   int32ptr(num),
+			Type:     &protoMessageFieldType,
+			TypeName: strptr("google.protobuf.FieldMask"),
+		}
+	}
 	repeatedMessageField := &descriptorpb.FieldDescriptorProto{
 		Name:     strptr(snake(plural(genType.Name))),
 		Number:   int32ptr(1),
@@ -168,6 +186,9 @@ func (a *Adapter) genMethodProtos(genType *gen.Type, m Method) (methodResources,
 				{Number: int32ptr(2), Name: strptr("WITH_EDGE_IDS")},
 			},
 		})
+		if readMask {
+			input.Field = append(input.Field, readMaskField(3))
+		}
 		outputName = genType.Name
 		messages = append(messages, input)
 	case MethodCreate:
@@ -224,6 +245,9 @@ func (a *Adapter) genMethodProtos(genType *gen.Type, m Method) (methodResources,
 				{Number: int32ptr(2), Name: strptr("WITH_EDGE_IDS")},
 			},
 		})
+		if readMask {
+			input.Field = append(input.Field, readMaskField(4))
+		}
 		outputName = fmt.Sprintf("List%sResponse", genType.Name)
 		output := &descriptorpb.DescriptorProto{
 			Name: &outputName,
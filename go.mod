@@ -3,6 +3,7 @@ module entgo.io/contrib
 go 1.23.4
 
 require (
+	connectrpc.com/connect v1.16.2
 	entgo.io/ent v0.14.4
 	github.com/99designs/gqlgen v0.17.68
 	github.com/AlekSi/pointer v1.1.0
@@ -20,12 +21,15 @@ require (
 	github.com/stretchr/testify v1.10.0
 	github.com/vektah/gqlparser/v2 v2.5.23
 	github.com/vmihailenco/msgpack/v5 v5.3.5
+	go.opentelemetry.io/otel v1.28.0
+	go.opentelemetry.io/otel/metric v1.28.0
+	go.opentelemetry.io/otel/trace v1.28.0
 	go.uber.org/multierr v1.9.0
 	go.uber.org/zap v1.24.0
 	golang.org/x/exp v0.0.0-20221230185412-738e83a70c30
 	golang.org/x/sync v0.12.0
 	golang.org/x/tools v0.31.0
-	google.golang.org/grpc v1.52.3
+	google.golang.org/grpc v1.64.0
 	google.golang.org/protobuf v1.36.5
 )
 
@@ -46,6 +50,8 @@ require (
 	github.com/go-faster/errors v0.6.1 // indirect
 	github.com/go-faster/jx v0.40.0 // indirect
 	github.com/go-faster/yamlx v0.4.1 // indirect
+	github.com/go-logr/logr v1.4.2 // indirect
+	github.com/go-logr/stdr v1.2.2 // indirect
 	github.com/google/go-cmp v0.6.0 // indirect
 	github.com/gorilla/websocket v1.5.0 // indirect
 	github.com/hashicorp/errwrap v1.0.0 // indirect
@@ -17,6 +17,7 @@ package schemast
 import (
 	"fmt"
 	"go/ast"
+	"go/types"
 	"sort"
 
 	"entgo.io/contrib/entproto"
@@ -43,11 +44,13 @@ type Annotator func(schema.Annotation) (ast.Expr, bool, error)
 // invokes that Annotator if found.
 func Annotation(annot schema.Annotation) (ast.Expr, bool, error) {
 	annotators := map[string]Annotator{
-		entproto.MessageAnnotation: protoMsg,
-		entproto.ServiceAnnotation: protoSvc,
-		entproto.FieldAnnotation:   protoField,
-		entproto.EnumAnnotation:    protoEnum,
-		"EntSQL":                   entSQL,
+		entproto.MessageAnnotation:   protoMsg,
+		entproto.ServiceAnnotation:   protoSvc,
+		entproto.FieldAnnotation:     protoField,
+		entproto.EnumAnnotation:      protoEnum,
+		entproto.GeneratedAnnotation: protoGenerated,
+		entproto.SkipAnnotation:      protoSkip,
+		"EntSQL":                     entSQL,
 	}
 	fn, ok := annotators[annot.Name()]
 	if !ok {
@@ -120,7 +123,8 @@ func protoField(annot schema.Annotation) (ast.Expr, bool, error) {
 
 func protoEnum(annot schema.Annotation) (ast.Expr, bool, error) {
 	var m struct {
-		Options map[string]int32
+		Options         map[string]int32
+		OmitFieldPrefix bool
 	}
 	if err := mapstructure.Decode(annot, &m); err != nil {
 		return nil, false, err
@@ -131,16 +135,35 @@ func protoEnum(annot schema.Annotation) (ast.Expr, bool, error) {
 			Value: ast.NewIdent("int32"),
 		},
 	}
-	for k, v := range m.Options {
+	keys := make([]string, 0, len(m.Options))
+	for k := range m.Options {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if m.Options[keys[i]] != m.Options[keys[j]] {
+			return m.Options[keys[i]] < m.Options[keys[j]]
+		}
+		return keys[i] < keys[j]
+	})
+	for _, k := range keys {
 		opts.Elts = append(opts.Elts, &ast.KeyValueExpr{
 			Key:   strLit(k),
-			Value: intLit(int(v)),
+			Value: intLit(int(m.Options[k])),
 		})
 	}
-	sort.Slice(opts.Elts, func(i, j int) bool {
-		return opts.Elts[i].(*ast.KeyValueExpr).Value.(*ast.BasicLit).Value < opts.Elts[j].(*ast.KeyValueExpr).Value.(*ast.BasicLit).Value
-	})
-	return fnCall(selectorLit("entproto", "Enum"), opts), true, nil
+	c := fnCall(selectorLit("entproto", "Enum"), opts)
+	if m.OmitFieldPrefix {
+		c.Args = append(c.Args, fnCall(selectorLit("entproto", "OmitFieldPrefix")))
+	}
+	return c, true, nil
+}
+
+func protoSkip(schema.Annotation) (ast.Expr, bool, error) {
+	return fnCall(selectorLit("entproto", "Skip")), true, nil
+}
+
+func protoGenerated(schema.Annotation) (ast.Expr, bool, error) {
+	return fnCall(selectorLit("entproto", "Generated")), true, nil
 }
 
 func entSQL(annot schema.Annotation) (ast.Expr, bool, error) {
@@ -186,6 +209,33 @@ func entSQL(annot schema.Annotation) (ast.Expr, bool, error) {
 	return c, true, nil
 }
 
+// annotationNames maps the constructors of the annotations printed by Annotation to the names of the annotations.
+var annotationNames = map[string]string{
+	"entproto.Message":   entproto.MessageAnnotation,
+	"entproto.SkipGen":   entproto.MessageAnnotation,
+	"entproto.Service":   entproto.ServiceAnnotation,
+	"entproto.Field":     entproto.FieldAnnotation,
+	"entproto.Enum":      entproto.EnumAnnotation,
+	"entproto.Generated": entproto.GeneratedAnnotation,
+	"entproto.Skip":      entproto.SkipAnnotation,
+	"entsql.Annotation":  "EntSQL",
+}
+
+// annotationName returns the name of the annotation built by expr, if it is printed by Annotation, or an
+// empty string otherwise.
+func annotationName(expr ast.Expr) (string, error) {
+	switch e := expr.(type) {
+	case *ast.CallExpr:
+		return annotationNames[types.ExprString(e.Fun)], nil
+	case *ast.CompositeLit:
+		return annotationNames[types.ExprString(e.Type)], nil
+	case *ast.UnaryExpr:
+		return annotationName(e.X)
+	default:
+		return "", nil
+	}
+}
+
 func toAnnotASTs(annots []schema.Annotation) ([]ast.Expr, error) {
 	out := make([]ast.Expr, 0, len(annots))
 	for _, annot := range annots {
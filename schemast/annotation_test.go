@@ -86,6 +86,27 @@ func TestAnnotation(t *testing.T) {
 			expectedOk: true,
 			expected:   `entproto.Enum(map[string]int32{"unspecified": 0, "active": 1})`,
 		},
+		{
+			name: "proto enum omit prefix",
+			annot: entproto.Enum(map[string]int32{
+				"HIGH": 10,
+				"LOW":  2,
+			}, entproto.OmitFieldPrefix()),
+			expectedOk: true,
+			expected:   `entproto.Enum(map[string]int32{"LOW": 2, "HIGH": 10}, entproto.OmitFieldPrefix())`,
+		},
+		{
+			name:       "proto skip field",
+			annot:      entproto.Skip(),
+			expectedOk: true,
+			expected:   `entproto.Skip()`,
+		},
+		{
+			name:       "proto generated",
+			annot:      entproto.Generated(),
+			expectedOk: true,
+			expected:   `entproto.Generated()`,
+		},
 		{
 			name: "entsql annotation table",
 			annot: entsql.Annotation{
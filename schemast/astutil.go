@@ -17,7 +17,9 @@ package schemast
 import (
 	"fmt"
 	"go/ast"
+	"go/parser"
 	"go/token"
+	"reflect"
 	"sort"
 	"strconv"
 
@@ -69,6 +71,31 @@ func strMapLit(m map[string]string) ast.Expr {
 	return c
 }
 
+// parseExpr parses x and clears the positions of the resulting nodes. Positions from the parsed
+// expression are unrelated to the file it's printed into, and confuse the printer's line breaking.
+func parseExpr(x string) (ast.Expr, error) {
+	expr, err := parser.ParseExpr(x)
+	if err != nil {
+		return nil, err
+	}
+	posType := reflect.TypeOf(token.NoPos)
+	ast.Inspect(expr, func(n ast.Node) bool {
+		// Braces of empty field lists are kept, so that struct{} and interface{} are printed on one line.
+		if _, ok := n.(*ast.FieldList); ok {
+			return true
+		}
+		if v := reflect.ValueOf(n); v.Kind() == reflect.Pointer && !v.IsNil() && v.Elem().Kind() == reflect.Struct {
+			for i, v := 0, v.Elem(); i < v.NumField(); i++ {
+				if f := v.Field(i); f.Type() == posType && f.CanSet() {
+					f.SetInt(int64(token.NoPos))
+				}
+			}
+		}
+		return true
+	})
+	return expr, nil
+}
+
 func strLit(lit string) ast.Expr {
 	return &ast.BasicLit{
 		Kind:  token.STRING,
@@ -131,7 +131,7 @@ func extractEdgeName(fd *ast.CallExpr) (string, error) {
 		return "", fmt.Errorf("schemast: expected edge constructor to have at least name arg")
 	}
 	name, ok := fd.Args[0].(*ast.BasicLit)
-	if !ok && name.Kind == token.STRING {
+	if !ok || name.Kind != token.STRING {
 		return "", fmt.Errorf("schemast: expected edge name to be a string literal")
 	}
 	return strconv.Unquote(name.Value)
@@ -18,31 +18,49 @@ import (
 	"errors"
 	"fmt"
 	"go/ast"
-	"go/parser"
 	"go/token"
 	"reflect"
+	"regexp"
 	"runtime"
 	"strconv"
 	"strings"
 
+	"entgo.io/ent"
 	"entgo.io/ent/schema/field"
 )
 
 // Field converts a *field.Descriptor back into an *ast.CallExpr of the ent field package that can be used
 // to construct it.
 func Field(desc *field.Descriptor) (*ast.CallExpr, error) {
+	return fieldExpr(desc, nil)
+}
+
+// fieldFrom converts an ent.Field back into an *ast.CallExpr, including
+// the validators of fields wrapped with WithValidators.
+func fieldFrom(fld ent.Field) (*ast.CallExpr, error) {
+	var vs []Validator
+	if v, ok := fld.(*validatedField); ok {
+		vs = v.validators
+	}
+	return fieldExpr(fld.Descriptor(), vs)
+}
+
+func fieldExpr(desc *field.Descriptor, vs []Validator) (*ast.CallExpr, error) {
 	switch t := desc.Info.Type; {
 	case t.Numeric(), t == field.TypeString, t == field.TypeBool, t == field.TypeTime, t == field.TypeBytes:
-		return fromSimpleType(desc)
+		return fromSimpleType(desc, vs)
 	case t == field.TypeUUID:
 		return fromComplexType(
 			desc,
+			vs,
 			structLit(
 				&ast.SelectorExpr{
 					X:   ast.NewIdent("uuid"),
 					Sel: ast.NewIdent("UUID"),
 				},
 			))
+	case t == field.TypeJSON && desc.Info.RType != nil && sliceConstructors[desc.Info.RType.Ident] != "":
+		return fromSimpleType(desc, vs)
 	case t == field.TypeJSON:
 		expr := "struct{}{}"
 		if desc.Info != nil && desc.Info.RType != nil {
@@ -51,7 +69,7 @@ func Field(desc *field.Descriptor) (*ast.CallExpr, error) {
 				expr = "&" + expr
 			}
 		}
-		exp, err := parser.ParseExpr(expr)
+		exp, err := parseExpr(expr)
 		if err != nil {
 			return nil, fmt.Errorf("schemast: json field %s generation error %w", desc.Name, err)
 		}
@@ -65,10 +83,11 @@ func Field(desc *field.Descriptor) (*ast.CallExpr, error) {
 		}
 		return fromComplexType(
 			desc,
+			vs,
 			exp,
 		)
 	case t == field.TypeEnum:
-		return fromEnumType(desc)
+		return fromEnumType(desc, vs)
 	default:
 		return nil, fmt.Errorf("schemast: unsupported type %s", t.ConstName())
 	}
@@ -124,8 +143,8 @@ func newFieldCall(desc *field.Descriptor) *builderCall {
 	}
 }
 
-func fromEnumType(desc *field.Descriptor) (*ast.CallExpr, error) {
-	call, err := fromSimpleType(desc)
+func fromEnumType(desc *field.Descriptor, vs []Validator) (*ast.CallExpr, error) {
+	call, err := fromSimpleType(desc, vs)
 	if err != nil {
 		return nil, err
 	}
@@ -143,13 +162,30 @@ func fromEnumType(desc *field.Descriptor) (*ast.CallExpr, error) {
 			args = append(args, strLit(pair.V))
 		}
 	}
-	builder := &builderCall{curr: call}
-	builder.method(modifier, args...)
-	return builder.curr, nil
+	// Values are set right after the constructor, before any other modifier.
+	base := &builderCall{curr: call}
+	var parent *ast.SelectorExpr
+	for {
+		sel, ok := base.curr.Fun.(*ast.SelectorExpr)
+		if !ok {
+			break
+		}
+		inner, ok := sel.X.(*ast.CallExpr)
+		if !ok {
+			break
+		}
+		parent, base.curr = sel, inner
+	}
+	base.method(modifier, args...)
+	if parent == nil {
+		return base.curr, nil
+	}
+	parent.X = base.curr
+	return call, nil
 }
 
-func fromComplexType(desc *field.Descriptor, filedType ast.Expr) (*ast.CallExpr, error) {
-	call, err := fromSimpleType(desc)
+func fromComplexType(desc *field.Descriptor, vs []Validator, filedType ast.Expr) (*ast.CallExpr, error) {
+	call, err := fromSimpleType(desc, vs)
 	if err != nil {
 		return nil, err
 	}
@@ -172,8 +208,15 @@ func fromComplexType(desc *field.Descriptor, filedType ast.Expr) (*ast.CallExpr,
 	return call, nil
 }
 
-func fromSimpleType(desc *field.Descriptor) (*ast.CallExpr, error) {
+func fromSimpleType(desc *field.Descriptor, vs []Validator) (*ast.CallExpr, error) {
 	builder := newFieldCall(desc)
+	if t := desc.Info.Type; desc.Info.RType != nil && (t.Numeric() || t == field.TypeString || t == field.TypeBool) {
+		expr, err := goTypeExpr(desc.Info.RType)
+		if err != nil {
+			return nil, err
+		}
+		builder.method("GoType", expr)
+	}
 	if desc.Nillable {
 		builder.method("Nillable")
 	}
@@ -201,19 +244,30 @@ func fromSimpleType(desc *field.Descriptor) (*ast.CallExpr, error) {
 	if len(desc.SchemaType) > 0 {
 		builder.method("SchemaType", strMapLit(desc.SchemaType))
 	}
-	if len(desc.Annotations) != 0 {
-		annots, err := toAnnotASTs(desc.Annotations)
+	if desc.Default != nil {
+		expr, err := defaultExpr(desc.Default)
 		if err != nil {
 			return nil, err
 		}
-		builder.annotate(annots...)
+		builder.method("Default", expr)
 	}
-	if desc.Default != nil {
-		expr, err := defaultExpr(desc.Default)
+	for _, v := range vs {
+		args := make([]ast.Expr, 0, len(v.Args))
+		for _, arg := range v.Args {
+			expr, err := validatorArg(arg)
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, expr)
+		}
+		builder.method(v.Method, args...)
+	}
+	if len(desc.Annotations) != 0 {
+		annots, err := toAnnotASTs(desc.Annotations)
 		if err != nil {
 			return nil, err
 		}
-		builder.method("Default", expr)
+		builder.annotate(annots...)
 	}
 	// Unsupported features
 	var unsupported error
@@ -229,7 +283,69 @@ func fromSimpleType(desc *field.Descriptor) (*ast.CallExpr, error) {
 	return builder.curr, nil
 }
 
+// Validator is a field validator that can be printed by schemast. The validators of the field package are
+// functions that can't be converted back to code, so fields are wrapped with their printable validators
+// using WithValidators instead.
+type Validator struct {
+	// Method is the name of the builder method, for example "MaxLen".
+	Method string
+	// Args are the method arguments. Strings, numbers and *regexp.Regexp values are supported.
+	Args []any
+}
+
+// validatedField is a field wrapped with its printable validators.
+type validatedField struct {
+	ent.Field
+	validators []Validator
+}
+
+// WithValidators returns the field wrapped with the given validators, which are printed
+// by the UpsertSchema mutator. The descriptor of the field is left unchanged.
+func WithValidators(fld ent.Field, vs ...Validator) ent.Field {
+	if v, ok := fld.(*validatedField); ok {
+		fld, vs = v.Field, append(append([]Validator{}, v.validators...), vs...)
+	}
+	return &validatedField{Field: fld, validators: vs}
+}
+
+// MinLen returns a Validator printed as MinLen(n).
+func MinLen(n int) Validator {
+	return Validator{Method: "MinLen", Args: []any{n}}
+}
+
+// MaxLen returns a Validator printed as MaxLen(n).
+func MaxLen(n int) Validator {
+	return Validator{Method: "MaxLen", Args: []any{n}}
+}
+
+// Match returns a Validator printed as Match(regexp.MustCompile(re)).
+func Match(re *regexp.Regexp) Validator {
+	return Validator{Method: "Match", Args: []any{re}}
+}
+
+// Range returns a Validator printed as Range(min, max).
+func Range(min, max any) Validator {
+	return Validator{Method: "Range", Args: []any{min, max}}
+}
+
+func validatorArg(arg any) (ast.Expr, error) {
+	if re, ok := arg.(*regexp.Regexp); ok {
+		return fnCall(selectorLit("regexp", "MustCompile"), strLit(re.String())), nil
+	}
+	return defaultExpr(arg)
+}
+
+// sliceConstructors maps the JSON types that have a dedicated builder in the field package to its name.
+var sliceConstructors = map[string]string{
+	"[]string":  "Strings",
+	"[]int":     "Ints",
+	"[]float64": "Floats",
+}
+
 func fieldConstructor(dsc *field.Descriptor) string {
+	if rt := dsc.Info.RType; dsc.Info.Type == field.TypeJSON && rt != nil && sliceConstructors[rt.Ident] != "" {
+		return sliceConstructors[rt.Ident]
+	}
 	cn := dsc.Info.ConstName()
 	if dsc.Info.Type == field.TypeFloat64 {
 		cn = strings.TrimSuffix(cn, "64")
@@ -237,6 +353,24 @@ func fieldConstructor(dsc *field.Descriptor) string {
 	return strings.TrimPrefix(cn, "Type")
 }
 
+// goTypeExpr returns a conversion of the zero value to the given named type, for example time.Duration(0).
+func goTypeExpr(rt *field.RType) (ast.Expr, error) {
+	var zero string
+	switch rt.Kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		zero = "0"
+	case reflect.String:
+		zero = `""`
+	case reflect.Bool:
+		zero = "false"
+	default:
+		return nil, fmt.Errorf("schemast: unsupported GoType kind: %q", rt.Kind)
+	}
+	return parseExpr(rt.Ident + "(" + zero + ")")
+}
+
 func defaultExpr(d interface{}) (ast.Expr, error) {
 	v := reflect.ValueOf(d)
 	switch v.Kind() {
@@ -263,6 +397,8 @@ func defaultExpr(d interface{}) (ast.Expr, error) {
 		return lit, nil
 	case reflect.Func:
 		f := runtime.FuncForPC(v.Pointer()).Name()
+		// Strip the package path, e.g. github.com/google/uuid.New.
+		f = f[strings.LastIndex(f, "/")+1:]
 		parts := strings.Split(f, ".")
 		if len(parts) != 2 {
 			return nil, errors.New("schemast: only selector exprs are supported for default func")
@@ -288,7 +424,7 @@ func extractFieldName(fd *ast.CallExpr) (string, error) {
 		return "", fmt.Errorf("schemast: expected field constructor to have at least name arg")
 	}
 	name, ok := fd.Args[0].(*ast.BasicLit)
-	if !ok && name.Kind == token.STRING {
+	if !ok || name.Kind != token.STRING {
 		return "", fmt.Errorf("schemast: expected field name to be a string literal")
 	}
 	return strconv.Unquote(name.Value)
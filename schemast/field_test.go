@@ -18,6 +18,7 @@ import (
 	"bytes"
 	"go/printer"
 	"go/token"
+	"regexp"
 	"testing"
 	"time"
 
@@ -51,11 +52,42 @@ func TestFromFieldDescriptor(t *testing.T) {
 			field:    field.Int64("x"),
 			expected: `field.Int64("x")`,
 		},
+		{
+			name:     "int64 go type",
+			field:    field.Int64("x").GoType(time.Duration(0)).Optional(),
+			expected: `field.Int64("x").GoType(time.Duration(0)).Optional()`,
+		},
 		{
 			name:     "json",
 			field:    field.JSON("json_field", struct{}{}),
 			expected: `field.JSON("json_field", struct{}{})`,
 		},
+		{
+			name: "schemast validators",
+			field: WithValidators(field.String("x").Optional(),
+				MaxLen(10), Match(regexp.MustCompile(`^\w+$`))),
+			expected: `field.String("x").Optional().MaxLen(10).Match(regexp.MustCompile("^\\w+$"))`,
+		},
+		{
+			name:     "uuid default",
+			field:    field.UUID("id", uuid.UUID{}).Default(uuid.New),
+			expected: `field.UUID("id", uuid.UUID{}).Default(uuid.New)`,
+		},
+		{
+			name:     "enum modifiers",
+			field:    field.Enum("x").Values("a", "b").Optional().Default("a"),
+			expected: `field.Enum("x").Values("a", "b").Optional().Default("a")`,
+		},
+		{
+			name:     "strings",
+			field:    field.Strings("tags").Optional(),
+			expected: `field.Strings("tags").Optional()`,
+		},
+		{
+			name:     "json slice",
+			field:    field.JSON("flags", []bool{}),
+			expected: `field.JSON("flags", []bool{})`,
+		},
 		{
 			name:     "json complex",
 			field:    field.JSON("json_complex_field", &entproto.Adapter{}).Optional().Comment("test comment"),
@@ -162,7 +194,7 @@ func TestFromFieldDescriptor(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			r, err := Field(tt.field.Descriptor())
+			r, err := fieldFrom(tt.field)
 			if tt.expectedErrMsg != "" {
 				require.EqualError(t, err, tt.expectedErrMsg)
 				return
@@ -0,0 +1,36 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schemast
+
+import (
+	"go/ast"
+)
+
+// Hook describes a hook returned by the Hooks method of a schema. Hooks are functions that can't be converted
+// back to code, so they are described by the expression that creates them.
+type Hook struct {
+	// Expr is the expression that returns the ent.Hook, for example a call to a hook constructor.
+	Expr ast.Expr
+	// Imports are the import paths of the packages used by Expr.
+	Imports []string
+}
+
+// AppendHook adds a hook to the returned values of the Hooks method of type typeName.
+func (c *Context) AppendHook(typeName string, hook Hook) error {
+	for _, path := range hook.Imports {
+		c.appendImport(typeName, path)
+	}
+	return c.appendReturnItem(kindHook, typeName, hook.Expr)
+}
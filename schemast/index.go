@@ -15,7 +15,10 @@
 package schemast
 
 import (
+	"fmt"
 	"go/ast"
+	"go/types"
+	"strings"
 
 	"entgo.io/ent"
 	"entgo.io/ent/schema/index"
@@ -38,6 +41,13 @@ func Index(desc *index.Descriptor) (*ast.CallExpr, error) {
 		}
 		idx.method("Edges", edges...)
 	}
+	if len(desc.Annotations) != 0 {
+		annots, err := toAnnotASTs(desc.Annotations)
+		if err != nil {
+			return nil, err
+		}
+		idx.annotate(annots...)
+	}
 	return idx.curr, nil
 }
 
@@ -50,6 +60,39 @@ func (c *Context) AppendIndex(typeName string, idx ent.Index) error {
 	return c.appendReturnItem(kindIndex, typeName, newIdx)
 }
 
+// extractIndexName returns the fields and edges of the index built by fd, which identify the index.
+func extractIndexName(fd *ast.CallExpr) (string, error) {
+	var fields, edges []string
+	for call := fd; call != nil; {
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return "", fmt.Errorf("schemast: unexpected type %T", call.Fun)
+		}
+		switch sel.Sel.Name {
+		case "Fields":
+			fields = argsString(call.Args)
+		case "Edges":
+			edges = argsString(call.Args)
+		}
+		inner, ok := sel.X.(*ast.CallExpr)
+		if !ok {
+			if final, ok := sel.X.(*ast.Ident); !ok || final.Name != "index" {
+				return "", fmt.Errorf(`schemast: expected index AST to be of form index.Fields(...)`)
+			}
+		}
+		call = inner
+	}
+	return strings.Join(fields, ",") + ";" + strings.Join(edges, ","), nil
+}
+
+func argsString(args []ast.Expr) []string {
+	out := make([]string, len(args))
+	for i, arg := range args {
+		out[i] = types.ExprString(arg)
+	}
+	return out
+}
+
 func newIndexCall(desc *index.Descriptor) *builderCall {
 	var fields []ast.Expr
 	for _, fld := range desc.Fields {
@@ -20,6 +20,7 @@ import (
 	"go/token"
 	"testing"
 
+	"entgo.io/contrib/entproto"
 	"entgo.io/ent"
 	"entgo.io/ent/schema/index"
 	"github.com/stretchr/testify/require"
@@ -62,6 +63,11 @@ func TestFromIndexDescriptor(t *testing.T) {
 			index:    index.Fields("cat_id").Edges("edge", "other_edge"),
 			expected: `index.Fields("cat_id").Edges("edge", "other_edge")`,
 		},
+		{
+			name:     "annotations",
+			index:    index.Fields("cat_id").Annotations(entproto.Generated()),
+			expected: `index.Fields("cat_id").Annotations(entproto.Generated())`,
+		},
 	}
 
 	for _, tt := range tests {
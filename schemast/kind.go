@@ -45,4 +45,8 @@ var (
 		methodName:    "Indexes",
 		ifaceSelector: selectorLit("ent", "Index"),
 	}
+	kindHook = kind{
+		methodName:    "Hooks",
+		ifaceSelector: selectorLit("ent", "Hook"),
+	}
 )
@@ -0,0 +1,252 @@
+// Copyright 2019-present Facebook
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package schemast
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+)
+
+// namedItem is a field, an edge, an index, a hook or an annotation to be merged into the return statement of a schema method.
+type namedItem struct {
+	name string
+	expr ast.Expr
+}
+
+func (u *UpsertSchema) merge(ctx *Context) error {
+	marker, ok, err := Annotation(u.Marker)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("schemast: marker annotation %q is not printed", u.Marker.Name())
+	}
+	fields := make([]namedItem, 0, len(u.Fields))
+	for _, fld := range u.Fields {
+		desc := fld.Descriptor()
+		desc.Annotations = append(desc.Annotations, u.Marker)
+		expr, err := fieldFrom(fld)
+		if err != nil {
+			return err
+		}
+		fields = append(fields, namedItem{name: desc.Name, expr: expr})
+		ctx.appendFieldImports(u.Name, desc)
+	}
+	if err := ctx.mergeReturnItems(kindField, u.Name, markedBy(marker), callName(extractFieldName), fields); err != nil {
+		return err
+	}
+	edges := make([]namedItem, 0, len(u.Edges))
+	for _, edg := range u.Edges {
+		desc := edg.Descriptor()
+		desc.Annotations = append(desc.Annotations, u.Marker)
+		expr, err := Edge(desc)
+		if err != nil {
+			return err
+		}
+		edges = append(edges, namedItem{name: desc.Name, expr: expr})
+	}
+	if err := ctx.mergeReturnItems(kindEdge, u.Name, markedBy(marker), callName(extractEdgeName), edges); err != nil {
+		return err
+	}
+	annots := make([]namedItem, 0, len(u.Annotations))
+	for _, annot := range u.Annotations {
+		expr, ok, err := Annotation(annot)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+		annots = append(annots, namedItem{name: annot.Name(), expr: expr})
+	}
+	if err := ctx.mergeReturnItems(kindAnnot, u.Name, u.generatedAnnotation(annots), annotationName, annots); err != nil {
+		return err
+	}
+	indexes := make([]namedItem, 0, len(u.Indexes))
+	for _, idx := range u.Indexes {
+		desc := idx.Descriptor()
+		desc.Annotations = append(desc.Annotations, u.Marker)
+		expr, err := Index(desc)
+		if err != nil {
+			return err
+		}
+		name, err := extractIndexName(expr)
+		if err != nil {
+			return err
+		}
+		indexes = append(indexes, namedItem{name: name, expr: expr})
+	}
+	if err := ctx.mergeReturnItems(kindIndex, u.Name, markedBy(marker), callName(extractIndexName), indexes); err != nil {
+		return err
+	}
+	if len(u.Indexes) > 0 {
+		ctx.appendImport(u.Name, "entgo.io/ent/schema/index")
+	}
+	hooks := make([]namedItem, 0, len(u.Hooks))
+	for _, h := range u.Hooks {
+		for _, path := range h.Imports {
+			ctx.appendImport(u.Name, path)
+		}
+		hooks = append(hooks, namedItem{name: types.ExprString(h.Expr), expr: h.Expr})
+	}
+	hookName := func(expr ast.Expr) (string, error) {
+		return types.ExprString(expr), nil
+	}
+	return ctx.mergeReturnItems(kindHook, u.Name, u.generatedHook, hookName, hooks)
+}
+
+// generatedHook reports whether expr is a call to one of the MarkedHooks functions.
+func (u *UpsertSchema) generatedHook(expr ast.Expr) bool {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+	fn := types.ExprString(call.Fun)
+	for _, name := range u.MarkedHooks {
+		if name == fn {
+			return true
+		}
+	}
+	return false
+}
+
+// generatedAnnotation returns a function that reports whether an existing annotation is generated, that is,
+// if it has the name of one of the given annotations or of the MarkedAnnotations.
+func (u *UpsertSchema) generatedAnnotation(annots []namedItem) func(ast.Expr) bool {
+	names := make(map[string]bool, len(annots)+len(u.MarkedAnnotations))
+	for _, a := range annots {
+		names[a.name] = true
+	}
+	for _, name := range u.MarkedAnnotations {
+		names[name] = true
+	}
+	return func(expr ast.Expr) bool {
+		name, _ := annotationName(expr)
+		return name != "" && names[name]
+	}
+}
+
+// callName adapts a function extracting the name of an item built by a call to one accepting any expression.
+// Expressions that are not calls have no name.
+func callName(nameOf func(*ast.CallExpr) (string, error)) func(ast.Expr) (string, error) {
+	return func(expr ast.Expr) (string, error) {
+		call, ok := expr.(*ast.CallExpr)
+		if !ok {
+			return "", nil
+		}
+		return nameOf(call)
+	}
+}
+
+// markedBy returns a function that reports whether an expression contains the marker expression.
+func markedBy(marker ast.Expr) func(ast.Expr) bool {
+	return func(expr ast.Expr) bool {
+		return containsExpr(expr, marker)
+	}
+}
+
+// mergeReturnItems merges items into the returned values of the method of kind k. Existing items reported by
+// marked are replaced by the item of the same name or removed if there is none. Other existing items
+// are kept, and take precedence over items of the same name. The remaining items are appended.
+func (c *Context) mergeReturnItems(k kind, typeName string, marked func(ast.Expr) bool, nameOf func(ast.Expr) (string, error), items []namedItem) error {
+	existing, err := c.returnItems(k, typeName)
+	if err != nil {
+		return err
+	}
+	byName := make(map[string]ast.Expr, len(items))
+	for _, it := range items {
+		byName[it.name] = it.expr
+	}
+	var (
+		merged []ast.Expr
+		placed = make(map[string]bool)
+	)
+	for _, expr := range existing {
+		// Items that are not built by the ent packages have no name, and are kept.
+		name, _ := nameOf(expr)
+		if !marked(expr) {
+			merged = append(merged, expr)
+			placed[name] = true
+			continue
+		}
+		if item, ok := byName[name]; ok && !placed[name] {
+			merged = append(merged, item)
+			placed[name] = true
+		}
+	}
+	for _, it := range items {
+		if !placed[it.name] {
+			merged = append(merged, it.expr)
+			placed[it.name] = true
+		}
+	}
+	return c.setReturnItems(k, typeName, merged)
+}
+
+// returnItems returns the items returned by the method of kind k, or nil if the method is not defined.
+func (c *Context) returnItems(k kind, typeName string) ([]ast.Expr, error) {
+	if _, ok := c.lookupMethod(typeName, k.methodName); !ok {
+		return nil, nil
+	}
+	stmt, err := c.returnStmt(typeName, k.methodName)
+	if err != nil {
+		return nil, err
+	}
+	switch r := stmt.Results[0].(type) {
+	case *ast.Ident:
+		if r.Name != "nil" {
+			return nil, fmt.Errorf("schemast: unexpected ident. expected nil got %s", r.Name)
+		}
+		return nil, nil
+	case *ast.CompositeLit:
+		return r.Elts, nil
+	default:
+		return nil, fmt.Errorf("schemast: unexpected AST component type %T", r)
+	}
+}
+
+func (c *Context) setReturnItems(k kind, typeName string, items []ast.Expr) error {
+	if _, ok := c.lookupMethod(typeName, k.methodName); !ok {
+		if len(items) == 0 {
+			return nil
+		}
+		if err := c.appendMethod(typeName, k.methodName, k.ifaceSelector); err != nil {
+			return err
+		}
+	}
+	stmt, err := c.returnStmt(typeName, k.methodName)
+	if err != nil {
+		return err
+	}
+	if len(items) == 0 {
+		stmt.Results = []ast.Expr{ast.NewIdent("nil")}
+		return nil
+	}
+	stmt.Results = []ast.Expr{sliceWith(k.ifaceSelector, items...)}
+	return nil
+}
+
+// containsExpr reports whether the AST of expr contains a node equal to sub.
+func containsExpr(expr, sub ast.Expr) bool {
+	want, found := types.ExprString(sub), false
+	ast.Inspect(expr, func(n ast.Node) bool {
+		if e, ok := n.(ast.Expr); ok && !found && types.ExprString(e) == want {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
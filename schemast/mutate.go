@@ -16,11 +16,14 @@ package schemast
 
 import (
 	"go/ast"
-	"go/token"
+	"path"
+	"strings"
+	"unicode"
 
 	"entgo.io/ent"
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/field"
+	"golang.org/x/tools/go/ast/astutil"
 )
 
 // Mutator changes a Context.
@@ -39,13 +42,26 @@ func Mutate(ctx *Context, mutations ...Mutator) error {
 }
 
 // UpsertSchema implements Mutator. UpsertSchema will add to the Context the type named Name if not present and rewrite
-// the type's Fields and Edges methods to return the desired fields and edges.
+// the type's Fields and Edges methods to return the desired fields and edges. The Hooks method is only rewritten if
+// Hooks is not empty.
 type UpsertSchema struct {
 	Name        string
 	Fields      []ent.Field
 	Edges       []ent.Edge
 	Indexes     []ent.Index
 	Annotations []schema.Annotation
+	Hooks       []Hook
+	// Marker switches UpsertSchema to merge mode if set. In merge mode, the fields, edges and indexes are added
+	// with the Marker annotation, and only existing fields, edges and indexes annotated with Marker are updated
+	// or removed. Existing annotations are replaced by the annotations of the same name. Other items and methods
+	// are left untouched.
+	Marker schema.Annotation
+	// MarkedHooks are the functions creating the hooks in merge mode, for example "runtime.OneOfHook". Hooks can't
+	// be annotated, so existing hooks created by a call to one of them are the ones updated or removed.
+	MarkedHooks []string
+	// MarkedAnnotations are the names of the schema annotations generated in merge mode, for example
+	// entproto.ServiceAnnotation. Existing annotations of these names that are not in Annotations are removed.
+	MarkedAnnotations []string
 }
 
 // Mutate applies the UpsertSchema mutation to the Context.
@@ -55,22 +71,21 @@ func (u *UpsertSchema) Mutate(ctx *Context) error {
 			return err
 		}
 	}
+	if u.Marker != nil {
+		return u.merge(ctx)
+	}
 	if err := resetMethods(ctx, u.Name); err != nil {
 		return err
 	}
 	for _, fld := range u.Fields {
-		if err := ctx.AppendField(u.Name, fld.Descriptor()); err != nil {
+		expr, err := fieldFrom(fld)
+		if err != nil {
 			return err
 		}
-		if fld.Descriptor().Info.Type == field.TypeUUID {
-			ctx.appendImport(u.Name, "github.com/google/uuid")
-		}
-		// Append any imported struct for JSON fields
-		if fld.Descriptor().Info.Type == field.TypeJSON {
-			if fld.Descriptor().Info.RType != nil && fld.Descriptor().Info.RType.PkgPath != "" {
-				ctx.appendImport(u.Name, fld.Descriptor().Info.RType.PkgPath)
-			}
+		if err := ctx.appendReturnItem(kindField, u.Name, expr); err != nil {
+			return err
 		}
+		ctx.appendFieldImports(u.Name, fld.Descriptor())
 	}
 	for _, edg := range u.Edges {
 		if err := ctx.AppendEdge(u.Name, edg.Descriptor()); err != nil {
@@ -86,24 +101,42 @@ func (u *UpsertSchema) Mutate(ctx *Context) error {
 		if err := ctx.AppendIndex(u.Name, idx); err != nil {
 			return err
 		}
+		ctx.appendImport(u.Name, "entgo.io/ent/schema/index")
+	}
+	if len(u.Hooks) > 0 {
+		if err := resetMethod(ctx, u.Name, "Hooks"); err != nil {
+			return err
+		}
+	}
+	for _, h := range u.Hooks {
+		if err := ctx.AppendHook(u.Name, h); err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
 func resetMethods(ctx *Context, typeName string) error {
 	for _, m := range []string{"Fields", "Edges", "Annotations", "Indexes"} {
-		if _, ok := ctx.lookupMethod(typeName, m); !ok {
-			continue
-		}
-		stmt, err := ctx.returnStmt(typeName, m)
-		if err != nil {
+		if err := resetMethod(ctx, typeName, m); err != nil {
 			return err
 		}
-		stmt.Results = []ast.Expr{ast.NewIdent("nil")}
 	}
 	return nil
 }
 
+func resetMethod(ctx *Context, typeName, method string) error {
+	if _, ok := ctx.lookupMethod(typeName, method); !ok {
+		return nil
+	}
+	stmt, err := ctx.returnStmt(typeName, method)
+	if err != nil {
+		return err
+	}
+	stmt.Results = []ast.Expr{ast.NewIdent("nil")}
+	return nil
+}
+
 func (c *Context) appendReturnItem(k kind, typeName string, item ast.Expr) error {
 	if _, ok := c.lookupMethod(typeName, k.methodName); !ok {
 		if err := c.appendMethod(typeName, k.methodName, k.ifaceSelector); err != nil {
@@ -117,8 +150,45 @@ func (c *Context) appendReturnItem(k kind, typeName string, item ast.Expr) error
 	return appendToReturn(stmt, k.ifaceSelector, item)
 }
 
+func (c *Context) appendFieldImports(typeName string, desc *field.Descriptor) {
+	if desc.Info.Type == field.TypeUUID {
+		c.appendImport(typeName, "github.com/google/uuid")
+	}
+	// Append any imported type for JSON fields and custom GoTypes
+	if desc.Info.Type != field.TypeUUID {
+		if rt := desc.Info.RType; rt != nil && rt.PkgPath != "" {
+			c.appendNamedImport(typeName, pkgQualifier(rt.Ident), rt.PkgPath)
+		}
+	}
+}
+
 func (c *Context) appendImport(typeName, pkgPath string) {
-	if f, ok := c.newTypes[typeName]; ok {
-		f.Imports = append(f.Imports, &ast.ImportSpec{Path: &ast.BasicLit{Value: pkgPath, Kind: token.STRING}})
+	if f, _, ok := c.lookupTypeDecl(typeName); ok {
+		astutil.AddImport(c.SchemaPackage.Fset, f, pkgPath)
+	}
+}
+
+// appendNamedImport appends an import of the given package, named explicitly
+// in case the package name is not the last element of its path.
+func (c *Context) appendNamedImport(typeName, name, pkgPath string) {
+	if name == "" || name == path.Base(pkgPath) {
+		c.appendImport(typeName, pkgPath)
+		return
+	}
+	if f, _, ok := c.lookupTypeDecl(typeName); ok {
+		astutil.AddNamedImport(c.SchemaPackage.Fset, f, name, pkgPath)
+	}
+}
+
+// pkgQualifier returns the package name that qualifies the given type
+// identifier, for example, "pb" for "map[string]*pb.T".
+func pkgQualifier(ident string) string {
+	i := strings.LastIndexByte(ident, '.')
+	if i == -1 {
+		return ""
 	}
+	j := strings.LastIndexFunc(ident[:i], func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_'
+	})
+	return ident[j+1 : i]
 }
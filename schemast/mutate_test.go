@@ -15,10 +15,12 @@
 package schemast
 
 import (
+	"reflect"
 	"testing"
 
 	"entgo.io/contrib/entproto"
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
@@ -59,6 +61,12 @@ func TestUpsert(t *testing.T) {
 			Indexes: []ent.Index{
 				index.Fields("name"),
 			},
+			Hooks: []Hook{
+				{
+					Expr:    fnCall(selectorLit("runtime", "OneOfHook"), strLit("title"), strLit("name")),
+					Imports: []string{"entgo.io/contrib/entproto/runtime"},
+				},
+			},
 		},
 	}
 	err = Mutate(tt.ctx, mutations...)
@@ -71,6 +79,8 @@ func TestUpsert(t *testing.T) {
 	require.Len(t, team.Edges, 1)
 	require.Len(t, team.Annotations, 1)
 	require.Len(t, team.Indexes, 1)
+	require.Equal(t, 1, team.NumHooks())
+	require.Contains(t, tt.contents("team.go"), `"entgo.io/contrib/entproto/runtime"`)
 	user := tt.getType("User")
 	require.NotNil(t, user)
 	require.Len(t, user.Fields, 1)
@@ -79,6 +89,102 @@ func TestUpsert(t *testing.T) {
 	require.Len(t, user.Indexes, 1)
 }
 
+func TestUpsert_NamedImport(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+	fld := field.JSON("main", struct{}{})
+	info := fld.Descriptor().Info
+	info.Ident, info.PkgPath = "recipepb.Ingredient", "example.com/jsonpb"
+	info.RType = &field.RType{Ident: info.Ident, Kind: reflect.Pointer, PkgPath: info.PkgPath}
+	err = Mutate(tt.ctx, &UpsertSchema{
+		Name:   "Recipe",
+		Fields: []ent.Field{fld, field.JSON("extras", []*entproto.Adapter{})},
+	})
+	require.NoError(t, err)
+	require.NoError(t, tt.print())
+	contents := tt.contents("recipe.go")
+	require.Contains(t, contents, `recipepb "example.com/jsonpb"`)
+	require.Contains(t, contents, `field.JSON("main", &recipepb.Ingredient{})`)
+	require.Contains(t, contents, `"entgo.io/contrib/entproto"`)
+	require.NotContains(t, contents, `entproto "entgo.io/contrib/entproto"`)
+}
+
+func TestUpsertMerge(t *testing.T) {
+	tt, err := newPrintTest(t)
+	require.NoError(t, err)
+	err = Mutate(tt.ctx, &UpsertSchema{
+		Name: "User",
+		Fields: []ent.Field{
+			field.String("name"),
+			field.Int("age"),
+		},
+		Indexes: []ent.Index{
+			index.Fields("age"),
+		},
+		Hooks: []Hook{
+			{
+				Expr:    fnCall(selectorLit("runtime", "OneOfHook"), strLit("contact"), strLit("name"), strLit("age")),
+				Imports: []string{"entgo.io/contrib/entproto/runtime"},
+			},
+		},
+		Annotations: []schema.Annotation{
+			entproto.Message(entproto.PackageName("users")),
+			entproto.Service(),
+			entsql.Annotation{Table: "users"},
+		},
+		Marker:            entproto.Generated(),
+		MarkedHooks:       []string{"runtime.OneOfHook"},
+		MarkedAnnotations: []string{entproto.MessageAnnotation, entproto.ServiceAnnotation, "EntSQL"},
+	})
+	require.NoError(t, err)
+	// A field added by hand, which isn't annotated with the marker.
+	require.NoError(t, tt.ctx.AppendField("User", field.String("nickname").Descriptor()))
+	require.NoError(t, tt.ctx.AppendIndex("User", index.Fields("nickname")))
+	require.NoError(t, tt.print())
+
+	tt.ctx, err = Load(tt.schemaDir())
+	require.NoError(t, err)
+	err = Mutate(tt.ctx, &UpsertSchema{
+		Name: "User",
+		Fields: []ent.Field{
+			field.String("name").Optional(),
+			field.String("nickname"),
+			field.String("email"),
+		},
+		Indexes: []ent.Index{
+			index.Fields("email").Unique(),
+		},
+		Annotations: []schema.Annotation{
+			entproto.Message(),
+			entsql.Annotation{Table: "people"},
+		},
+		Marker:            entproto.Generated(),
+		MarkedHooks:       []string{"runtime.OneOfHook"},
+		MarkedAnnotations: []string{entproto.MessageAnnotation, entproto.ServiceAnnotation, "EntSQL"},
+	})
+	require.NoError(t, err)
+	require.NoError(t, tt.print())
+	require.NoError(t, tt.load())
+
+	contents := tt.contents("user.go")
+	require.Contains(t, contents, `field.String("name").Optional().Annotations(entproto.Generated())`)
+	require.Contains(t, contents, `field.String("nickname"),`)
+	require.Contains(t, contents, `field.String("email").Annotations(entproto.Generated())`)
+	require.Contains(t, contents, `index.Fields("email").Unique().Annotations(entproto.Generated())`)
+	require.Contains(t, contents, `index.Fields("nickname")`)
+	require.NotContains(t, contents, `"age"`)
+	require.NotContains(t, contents, "OneOfHook")
+	require.Contains(t, contents, `entproto.Message(), entsql.Annotation{Table: "people"}`)
+	require.NotContains(t, contents, `"users"`)
+	require.NotContains(t, contents, "entproto.Service()")
+	user := tt.getType("User")
+	require.NotNil(t, user)
+	require.Len(t, user.Fields, 3)
+	require.Len(t, user.Indexes, 2)
+	require.Len(t, user.Annotations, 2)
+	require.Zero(t, user.NumHooks())
+}
+
 func WithType(e ent.Edge, typeName string) ent.Edge {
 	e.Descriptor().Type = typeName
 	return e